@@ -11,6 +11,7 @@ import (
 	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/web"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/storage"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/workers"
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
 	"github.com/tapiaw38/auth-api-be/internal/platform/config"
@@ -53,10 +54,11 @@ func run() error {
 		return err
 	}
 
-	mq, err := queue.NewRabbitMQ(&configService)
+	broker, err := queue.NewBroker(&configService, db)
 	if err != nil {
 		return err
 	}
+	defer broker.Close()
 
 	if configService.ServerConfig.GinMode == config.DebugMode {
 		gin.SetMode(gin.DebugMode)
@@ -65,6 +67,10 @@ func run() error {
 	}
 
 	app := gin.Default()
+	if err := app.SetTrustedProxies(configService.ServerConfig.TrustedProxies); err != nil {
+		return err
+	}
+
 	ginConfig := cors.DefaultConfig()
 	ginConfig.AllowOrigins = []string{"*"}
 	ginConfig.AllowCredentials = true
@@ -73,7 +79,7 @@ func run() error {
 	ginConfig.ExposeHeaders = []string{"*"}
 	app.Use(cors.New(ginConfig))
 
-	if err := bootstrap(app, db, mq, &configService); err != nil {
+	if err := bootstrap(app, db, broker, &configService); err != nil {
 		return err
 	}
 
@@ -83,13 +89,13 @@ func run() error {
 func bootstrap(
 	app *gin.Engine,
 	db *sql.DB,
-	mq *queue.RabbitMQ,
+	broker queue.Broker,
 	configService *config.ConfigurationService,
 ) error {
 	datasources := datasources.CreateDatasources(db)
 	integrations := integrations.CreateIntegration(configService)
 
-	contextFactory := appcontext.NewFactory(datasources, integrations, mq, configService)
+	contextFactory := appcontext.NewFactory(datasources, integrations, broker, configService)
 	useCases := usecases.CreateUsecases(contextFactory)
 
 	if !configService.InitConfig.EnsureDefaultRoles {
@@ -101,9 +107,13 @@ func bootstrap(
 		return err
 	}
 
-	web.RegisterApplicationRoutes(app, useCases)
+	if configService.Storage.Backend == storage.BackendLocal {
+		app.Static("/uploads", configService.Storage.LocalPath)
+	}
+
+	web.RegisterApplicationRoutes(app, useCases, broker)
 
-	if err := workers.RegisterWorkers(context.Background(), mq, contextFactory); err != nil {
+	if err := workers.RegisterWorkers(context.Background(), broker, contextFactory); err != nil {
 		log.Fatalf("Failed to register workers: %v", err)
 		return err
 	}
@@ -0,0 +1,28 @@
+package audit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// Actor resolves username, the caller of an admin action, to the user ID
+// recorded in the audit log. The caller is returned as well so its roles
+// can be checked; an empty username is the system and has no caller.
+func Actor(ctx context.Context, users user.Repository, username string) (domain.EventActor, *domain.User, error) {
+	if username == "" {
+		return domain.SystemActor(), nil, nil
+	}
+
+	caller, err := users.Get(ctx, user.GetFilterOptions{Username: username})
+	if err != nil {
+		return domain.EventActor{}, nil, err
+	}
+	if caller == nil {
+		return domain.EventActor{}, nil, errors.New("actor not found")
+	}
+
+	return domain.UserActor(caller.ID), caller, nil
+}
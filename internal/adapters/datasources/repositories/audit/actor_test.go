@@ -0,0 +1,63 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"go.uber.org/mock/gomock"
+)
+
+func TestActor(t *testing.T) {
+	caller := &domain.User{ID: "user-1", Username: "admin"}
+
+	tests := map[string]struct {
+		username     string
+		prepare      func(users *mock_user.MockRepository)
+		expectActor  domain.EventActor
+		expectCaller *domain.User
+		expectErr    error
+	}{
+		"when there is no username the system is the actor": {
+			expectActor: domain.SystemActor(),
+		},
+		"when the caller exists": {
+			username: "admin",
+			prepare: func(users *mock_user.MockRepository) {
+				users.EXPECT().Get(gomock.Any(), user.GetFilterOptions{Username: "admin"}).Return(caller, nil)
+			},
+			expectActor:  domain.UserActor("user-1"),
+			expectCaller: caller,
+		},
+		"when the caller does not exist": {
+			username: "ghost",
+			prepare: func(users *mock_user.MockRepository) {
+				users.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectErr: errors.New("actor not found"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			users := mock_user.NewMockRepository(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(users)
+			}
+
+			actor, caller, err := audit.Actor(context.Background(), users, tt.username)
+
+			assert.Equal(t, tt.expectErr, err)
+			assert.Equal(t, tt.expectActor, actor)
+			assert.Equal(t, tt.expectCaller, caller)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package audit
+
+import (
+	"context"
+)
+
+func (r *repository) Count(ctx context.Context, filters ListFilterOptions) (int, error) {
+	where, args := buildWhere(filters)
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM audit_events`+where, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
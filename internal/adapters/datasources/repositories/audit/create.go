@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// chainLockKey is the advisory lock that serialises writers so every row
+// is chained to the one committed before it.
+const chainLockKey = 7_301_202
+
+// Create appends event to the log, chaining it to the latest row. It must
+// run inside a transaction: the advisory lock is released on commit, and
+// outside a transaction two writers could read the same previous hash.
+func (r *repository) Create(ctx context.Context, event domain.AuditEvent) (int64, error) {
+	if _, err := r.db.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, chainLockKey); err != nil {
+		return 0, err
+	}
+
+	prevHash, err := r.lastHash(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	event.PrevHash = prevHash
+	event.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+	if event.Metadata == nil {
+		event.Metadata = map[string]any{}
+	}
+
+	event.Hash, err = event.ComputeHash()
+	if err != nil {
+		return 0, err
+	}
+
+	row, err := r.executeCreateQuery(ctx, event)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	if err := row.Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *repository) lastHash(ctx context.Context) (string, error) {
+	var hash string
+	err := r.db.QueryRowContext(ctx, `SELECT hash FROM audit_events ORDER BY id DESC LIMIT 1`).Scan(&hash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return hash, nil
+}
+
+func (r *repository) executeCreateQuery(ctx context.Context, event domain.AuditEvent) (*sql.Row, error) {
+	metadata, err := json.Marshal(event.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `INSERT INTO audit_events (action, actor_type, actor_id, target_type, target_id,
+				ip, user_agent, request_id, metadata, prev_hash, hash, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+			RETURNING id`
+
+	args := []any{
+		string(event.Action),
+		string(event.Actor.Type),
+		event.Actor.ID,
+		string(event.Target.Type),
+		event.Target.ID,
+		event.IP,
+		event.UserAgent,
+		event.RequestID,
+		metadata,
+		event.PrevHash,
+		event.Hash,
+		event.CreatedAt,
+	}
+
+	row := r.db.QueryRowContext(ctx, query, args...)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return row, nil
+}
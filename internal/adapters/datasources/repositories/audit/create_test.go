@@ -0,0 +1,117 @@
+package audit_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// storeHash captures the inserted hash so chainedCreatedAt can check it.
+type storeHash struct {
+	hash *string
+}
+
+func (s storeHash) Match(v driver.Value) bool {
+	hash, ok := v.(string)
+	*s.hash = hash
+	return ok
+}
+
+// chainedCreatedAt matches the created_at argument and checks that the
+// captured hash covers the inserted row chained to prevHash.
+type chainedCreatedAt struct {
+	prevHash string
+	hash     *string
+}
+
+func (c chainedCreatedAt) Match(v driver.Value) bool {
+	createdAt, ok := v.(time.Time)
+	if !ok {
+		return false
+	}
+
+	event := domain.NewAuditEvent(domain.AuditActionLogin, domain.UserActor("user-1"), domain.UserTarget("user-1"), nil)
+	event.IP = "10.0.0.1"
+	event.PrevHash = c.prevHash
+	event.CreatedAt = createdAt
+
+	expected, err := event.ComputeHash()
+	return err == nil && expected == *c.hash
+}
+
+func TestRepository_Create(t *testing.T) {
+	event := domain.NewAuditEvent(domain.AuditActionLogin, domain.UserActor("user-1"), domain.UserTarget("user-1"), nil)
+	event.IP = "10.0.0.1"
+
+	tests := map[string]struct {
+		prepare   func(mock sqlmock.Sqlmock)
+		expect    int64
+		expectErr error
+	}{
+		"when the row is chained to the previous one": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`SELECT pg_advisory_xact_lock\(\$1\)`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT hash FROM audit_events ORDER BY id DESC LIMIT 1`).
+					WillReturnRows(sqlmock.NewRows([]string{"hash"}).AddRow("previous-hash"))
+
+				var hash string
+				mock.ExpectQuery(`INSERT INTO audit_events`).
+					WithArgs(
+						"auth.login", "user", "user-1", "user", "user-1",
+						"10.0.0.1", "", "", []byte(`{}`), "previous-hash",
+						storeHash{hash: &hash}, chainedCreatedAt{prevHash: "previous-hash", hash: &hash},
+					).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(2)))
+			},
+			expect: 2,
+		},
+		"when it is the first row": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`SELECT pg_advisory_xact_lock`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`SELECT hash FROM audit_events`).
+					WillReturnRows(sqlmock.NewRows([]string{"hash"}))
+				mock.ExpectQuery(`INSERT INTO audit_events`).
+					WithArgs(
+						sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+						sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "",
+						sqlmock.AnyArg(), sqlmock.AnyArg(),
+					).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(1)))
+			},
+			expect: 1,
+		},
+		"when the lock cannot be taken": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`SELECT pg_advisory_xact_lock`).WillReturnError(errors.New("database error"))
+			},
+			expectErr: errors.New("database error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tt.prepare(mock)
+
+			repository := audit.NewRepository(db)
+			id, err := repository.Create(context.Background(), event)
+
+			assert.Equal(t, tt.expect, id)
+			assert.Equal(t, tt.expectErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+const defaultListLimit = 50
+
+func (r *repository) List(ctx context.Context, filters ListFilterOptions) ([]domain.AuditEvent, error) {
+	rows, err := r.executeListQuery(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	return scanEvents(rows)
+}
+
+func (r *repository) executeListQuery(ctx context.Context, filters ListFilterOptions) (*sql.Rows, error) {
+	where, args := buildWhere(filters)
+
+	limit := filters.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+
+	query := `SELECT ` + selectColumns + ` FROM audit_events` + where + ` ORDER BY id DESC`
+
+	args = append(args, limit)
+	query += fmt.Sprintf(` LIMIT $%d`, len(args))
+
+	if filters.Offset > 0 {
+		args = append(args, filters.Offset)
+		query += fmt.Sprintf(` OFFSET $%d`, len(args))
+	}
+
+	return r.db.QueryContext(ctx, query, args...)
+}
+
+func buildWhere(filters ListFilterOptions) (string, []any) {
+	where := ` WHERE 1=1`
+	args := []any{}
+
+	if filters.ActorID != "" {
+		args = append(args, filters.ActorID)
+		where += fmt.Sprintf(` AND actor_id = $%d`, len(args))
+	}
+
+	if filters.TargetID != "" {
+		args = append(args, filters.TargetID)
+		where += fmt.Sprintf(` AND target_id = $%d`, len(args))
+	}
+
+	if filters.Subject != "" {
+		args = append(args, filters.Subject)
+		where += fmt.Sprintf(` AND (actor_id = $%d OR target_id = $%d)`, len(args), len(args))
+	}
+
+	if filters.Action != "" {
+		args = append(args, string(filters.Action))
+		where += fmt.Sprintf(` AND action = $%d`, len(args))
+	}
+
+	if !filters.From.IsZero() {
+		args = append(args, filters.From.UTC())
+		where += fmt.Sprintf(` AND created_at >= $%d`, len(args))
+	}
+
+	if !filters.To.IsZero() {
+		args = append(args, filters.To.UTC())
+		where += fmt.Sprintf(` AND created_at < $%d`, len(args))
+	}
+
+	return where, args
+}
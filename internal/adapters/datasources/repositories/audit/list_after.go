@@ -0,0 +1,30 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// ListAfter returns up to limit events with an ID greater than afterID in
+// chain order. It is used to walk the whole log when verifying hashes.
+func (r *repository) ListAfter(ctx context.Context, afterID int64, limit int) ([]domain.AuditEvent, error) {
+	rows, err := r.executeListAfterQuery(ctx, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	return scanEvents(rows)
+}
+
+func (r *repository) executeListAfterQuery(ctx context.Context, afterID int64, limit int) (*sql.Rows, error) {
+	query := `SELECT ` + selectColumns + ` FROM audit_events
+			WHERE id > $1
+			ORDER BY id
+			LIMIT $2`
+
+	return r.db.QueryContext(ctx, query, afterID, limit)
+}
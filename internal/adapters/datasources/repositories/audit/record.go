@@ -0,0 +1,20 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/requestinfo"
+)
+
+// Record fills event with the request info carried by ctx and appends it
+// through repository, which must be bound to a transaction.
+func Record(ctx context.Context, repository Repository, event domain.AuditEvent) error {
+	info := requestinfo.FromContext(ctx)
+	event.IP = info.IP
+	event.UserAgent = info.UserAgent
+	event.RequestID = info.RequestID
+
+	_, err := repository.Create(ctx, event)
+	return err
+}
@@ -0,0 +1,43 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// Redact replaces the user ID with the pseudonym wherever it appears as
+// actor or target, and clears the IP, user agent and metadata of those rows
+// and of the failed logins and authorizations that name the user's email or
+// username. The hashes are left alone: they cannot be recomputed without
+// breaking the chain, so verification treats redacted rows as opaque links.
+func (r *repository) Redact(ctx context.Context, input RedactInput) (int64, error) {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE audit_events SET
+			actor_id = CASE WHEN actor_id = $1 THEN $4 ELSE actor_id END,
+			target_id = CASE WHEN target_id = $1 THEN $4 ELSE target_id END,
+			ip = '',
+			user_agent = '',
+			metadata = '{}',
+			redacted_at = $5
+		WHERE redacted_at IS NULL AND (
+			actor_id = $1 OR target_id = $1
+			OR (action = $6 AND $2 <> '' AND metadata->>'username' = $2)
+			OR (action = $7 AND $3 <> '' AND lower(metadata->>'email') = lower($3))
+		)`,
+		input.UserID,
+		input.Username,
+		input.Email,
+		input.Pseudonym,
+		time.Now().UTC(),
+		string(domain.AuditActionAuthorizationFailed),
+		string(domain.AuditActionLoginFailed),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
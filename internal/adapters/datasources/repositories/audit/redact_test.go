@@ -0,0 +1,63 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+)
+
+func TestRepository_Redact(t *testing.T) {
+	input := audit.RedactInput{
+		UserID:    "user-1",
+		Username:  "jdoe",
+		Email:     "john@example.com",
+		Pseudonym: "erased:1",
+	}
+
+	tests := map[string]struct {
+		prepare   func(mock sqlmock.Sqlmock)
+		expect    int64
+		expectErr error
+	}{
+		"when rows naming the user or their login are redacted": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE audit_events SET .+ metadata->>'username' = \$2\) .+ lower\(metadata->>'email'\) = lower\(\$3\)`).
+					WithArgs(
+						"user-1", "jdoe", "john@example.com", "erased:1", sqlmock.AnyArg(),
+						"auth.authorization_failed", "auth.login_failed",
+					).
+					WillReturnResult(sqlmock.NewResult(0, 4))
+			},
+			expect: 4,
+		},
+		"when the update fails": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE audit_events`).WillReturnError(errors.New("database error"))
+			},
+			expectErr: errors.New("database error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tt.prepare(mock)
+
+			repository := audit.NewRepository(db)
+			redacted, err := repository.Redact(context.Background(), input)
+
+			assert.Equal(t, tt.expect, redacted)
+			assert.Equal(t, tt.expectErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
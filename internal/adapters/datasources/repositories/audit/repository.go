@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	Repository interface {
+		Create(context.Context, domain.AuditEvent) (int64, error)
+		List(context.Context, ListFilterOptions) ([]domain.AuditEvent, error)
+		Count(context.Context, ListFilterOptions) (int, error)
+		ListAfter(context.Context, int64, int) ([]domain.AuditEvent, error)
+		Redact(context.Context, RedactInput) (int64, error)
+	}
+
+	repository struct {
+		db datasources.DBTX
+	}
+
+	// ListFilterOptions filters the log. Subject matches events where the
+	// user is either the actor or the target. Results are newest first.
+	ListFilterOptions struct {
+		ActorID  string
+		TargetID string
+		Subject  string
+		Action   domain.AuditAction
+		From     time.Time
+		To       time.Time
+		Limit    int
+		Offset   int
+	}
+
+	// RedactInput identifies an erased user. Rows naming UserID as actor or
+	// target are redacted, as are failed logins recorded against Email and
+	// failed authorizations recorded against Username.
+	RedactInput struct {
+		UserID    string
+		Username  string
+		Email     string
+		Pseudonym string
+	}
+)
+
+func NewRepository(db datasources.DBTX) Repository {
+	return &repository{
+		db: db,
+	}
+}
@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+const selectColumns = `id, action, actor_type, actor_id, target_type, target_id,
+		ip, user_agent, request_id, metadata, prev_hash, hash, created_at, redacted_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanEvent(row scanner) (*domain.AuditEvent, error) {
+	var (
+		id                         int64
+		action, actorType, actorID string
+		targetType, targetID       string
+		ip, userAgent, requestID   string
+		metadataJSON               []byte
+		prevHash, hash             string
+		createdAt                  time.Time
+		redactedAt                 *time.Time
+	)
+
+	if err := row.Scan(
+		&id,
+		&action,
+		&actorType,
+		&actorID,
+		&targetType,
+		&targetID,
+		&ip,
+		&userAgent,
+		&requestID,
+		&metadataJSON,
+		&prevHash,
+		&hash,
+		&createdAt,
+		&redactedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]any{}
+	if len(metadataJSON) > 0 {
+		if err := json.Unmarshal(metadataJSON, &metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	return &domain.AuditEvent{
+		ID:     id,
+		Action: domain.AuditAction(action),
+		Actor: domain.EventActor{
+			Type: domain.ActorType(actorType),
+			ID:   actorID,
+		},
+		Target: domain.AuditTarget{
+			Type: domain.AuditTargetType(targetType),
+			ID:   targetID,
+		},
+		IP:         ip,
+		UserAgent:  userAgent,
+		RequestID:  requestID,
+		Metadata:   metadata,
+		PrevHash:   prevHash,
+		Hash:       hash,
+		CreatedAt:  createdAt,
+		RedactedAt: redactedAt,
+	}, nil
+}
+
+func scanEvents(rows interface {
+	scanner
+	Next() bool
+	Err() error
+}) ([]domain.AuditEvent, error) {
+	var events []domain.AuditEvent
+	for rows.Next() {
+		event, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, *event)
+	}
+
+	return events, rows.Err()
+}
@@ -0,0 +1,50 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Create(ctx context.Context, message domain.OutboxMessage) (string, error) {
+	row, err := r.executeCreateQuery(ctx, message)
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	if err := row.Scan(&id); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (r *repository) executeCreateQuery(ctx context.Context, message domain.OutboxMessage) (*sql.Row, error) {
+	kind := message.Kind
+	if kind == "" {
+		kind = domain.OutboxKindJob
+	}
+
+	query := `INSERT INTO outbox (id, kind, topic, payload, status, created_at, next_attempt_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $6)
+			RETURNING id`
+
+	args := []any{
+		message.ID,
+		string(kind),
+		message.Topic,
+		[]byte(message.Payload),
+		string(domain.OutboxStatusPending),
+		time.Now().UTC(),
+	}
+
+	row := r.db.QueryRowContext(ctx, query, args...)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return row, nil
+}
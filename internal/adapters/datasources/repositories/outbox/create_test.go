@@ -0,0 +1,61 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func TestRepository_Create(t *testing.T) {
+	message := domain.OutboxMessage{
+		ID:      "message-1",
+		Topic:   "send_email",
+		Payload: []byte(`{"to":"john@example.com"}`),
+	}
+
+	tests := map[string]struct {
+		prepare   func(mock sqlmock.Sqlmock)
+		expect    string
+		expectErr error
+	}{
+		"when the message is created successfully": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO outbox").
+					WithArgs("message-1", "job", "send_email", []byte(`{"to":"john@example.com"}`), "pending", sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("message-1"))
+			},
+			expect: "message-1",
+		},
+		"when the insert fails": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO outbox").
+					WillReturnError(errors.New("database error"))
+			},
+			expectErr: errors.New("database error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tt.prepare(mock)
+
+			repository := outbox.NewRepository(db)
+			result, err := repository.Create(context.Background(), message)
+
+			assert.Equal(t, tt.expect, result)
+			assert.Equal(t, tt.expectErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
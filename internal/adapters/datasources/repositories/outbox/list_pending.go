@@ -0,0 +1,69 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// ListPending locks the oldest pending messages whose next attempt is due.
+// It must run inside a transaction so concurrent relays skip the rows
+// already claimed.
+func (r *repository) ListPending(ctx context.Context, options ListPendingOptions) ([]domain.OutboxMessage, error) {
+	rows, err := r.executeListPendingQuery(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var messages []domain.OutboxMessage
+	for rows.Next() {
+		var (
+			id, kind, topic, status string
+			payload                 []byte
+			attempts                int
+			lastError               *string
+			createdAt               time.Time
+			sentAt                  *time.Time
+		)
+
+		if err := rows.Scan(&id, &kind, &topic, &payload, &status, &attempts, &lastError, &createdAt, &sentAt); err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, domain.OutboxMessage{
+			ID:        id,
+			Kind:      domain.OutboxKind(kind),
+			Topic:     topic,
+			Payload:   json.RawMessage(payload),
+			Status:    domain.OutboxStatus(status),
+			Attempts:  attempts,
+			LastError: lastError,
+			CreatedAt: createdAt,
+			SentAt:    sentAt,
+		})
+	}
+
+	return messages, rows.Err()
+}
+
+func (r *repository) executeListPendingQuery(ctx context.Context, options ListPendingOptions) (*sql.Rows, error) {
+	query := `SELECT id, kind, topic, payload, status, attempts, last_error, created_at, sent_at
+			FROM outbox
+			WHERE status = $1 AND next_attempt_at <= $2
+			ORDER BY next_attempt_at, created_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED`
+
+	args := []any{
+		string(domain.OutboxStatusPending),
+		time.Now().UTC(),
+		options.Limit,
+	}
+
+	return r.db.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,31 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// MarkFailed records a failed delivery attempt. The message stays pending,
+// and is not listed again before NextAttemptAt, until it reaches
+// MaxAttempts.
+func (r *repository) MarkFailed(ctx context.Context, input MarkFailedInput) error {
+	query := `UPDATE outbox
+			SET
+				attempts = attempts + 1,
+				last_error = $1,
+				status = CASE WHEN attempts + 1 >= $2 THEN $3 ELSE status END,
+				next_attempt_at = $4
+			WHERE id = $5`
+
+	args := []any{
+		input.Error,
+		input.MaxAttempts,
+		string(domain.OutboxStatusFailed),
+		input.NextAttemptAt,
+		input.ID,
+	}
+
+	_, err := r.db.ExecContext(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,15 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) MarkSent(ctx context.Context, id string) error {
+	query := `UPDATE outbox SET status = $1, sent_at = $2, last_error = NULL WHERE id = $3`
+
+	_, err := r.db.ExecContext(ctx, query, string(domain.OutboxStatusSent), time.Now().UTC(), id)
+	return err
+}
@@ -0,0 +1,55 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// NewMessage builds a pending outbox message with payload encoded as JSON.
+func NewMessage(topic string, payload any) (domain.OutboxMessage, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return domain.OutboxMessage{}, err
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return domain.OutboxMessage{}, err
+	}
+
+	return domain.OutboxMessage{
+		ID:      id.String(),
+		Kind:    domain.OutboxKindJob,
+		Topic:   topic,
+		Payload: body,
+		Status:  domain.OutboxStatusPending,
+	}, nil
+}
+
+// NewEventMessage builds a pending outbox message for a domain event. The
+// event type is used as the routing key when the message is relayed.
+func NewEventMessage(event domain.Event) (domain.OutboxMessage, error) {
+	message, err := NewMessage(string(event.Type), event)
+	if err != nil {
+		return domain.OutboxMessage{}, err
+	}
+
+	message.Kind = domain.OutboxKindEvent
+
+	return message, nil
+}
+
+// CreateEvent stores event in the outbox through repository, which should be
+// bound to the transaction that performs the change being announced.
+func CreateEvent(ctx context.Context, repository Repository, event domain.Event) error {
+	message, err := NewEventMessage(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = repository.Create(ctx, message)
+	return err
+}
@@ -0,0 +1,41 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	Repository interface {
+		Create(context.Context, domain.OutboxMessage) (string, error)
+		ListPending(context.Context, ListPendingOptions) ([]domain.OutboxMessage, error)
+		MarkSent(context.Context, string) error
+		MarkFailed(context.Context, MarkFailedInput) error
+	}
+
+	repository struct {
+		db datasources.DBTX
+	}
+
+	ListPendingOptions struct {
+		Limit int
+	}
+
+	// MarkFailedInput records a failed publish. The message is retried at
+	// NextAttemptAt unless it has reached MaxAttempts.
+	MarkFailedInput struct {
+		ID            string
+		Error         string
+		MaxAttempts   int
+		NextAttemptAt time.Time
+	}
+)
+
+func NewRepository(db datasources.DBTX) Repository {
+	return &repository{
+		db: db,
+	}
+}
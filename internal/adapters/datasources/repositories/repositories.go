@@ -1,30 +1,109 @@
 package repositories
 
 import (
+	"context"
+	"database/sql"
+
 	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/scim"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	user_data_export "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/data_export"
+	user_deletion "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/deletion"
+	user_device "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/device"
+	user_email_change "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/email_change"
+	user_import_job "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/import_job"
+	user_password_history "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/password_history"
+	user_password_reset_request "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/password_reset_request"
+	user_phone_verification "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/phone_verification"
 	user_role "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/role"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook"
+	webhook_delivery "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook/delivery"
 	"github.com/tapiaw38/auth-api-be/internal/platform/config"
 )
 
 type Repositories struct {
-	User     user.Repository
-	Role     role.Repository
-	UserRole user_role.Repository
+	User              user.Repository
+	Role              role.Repository
+	UserRole          user_role.Repository
+	Outbox            outbox.Repository
+	Webhook           webhook.Repository
+	WebhookDelivery   webhook_delivery.Repository
+	Audit             audit.Repository
+	EmailChange       user_email_change.Repository
+	PhoneVerification user_phone_verification.Repository
+	DataExport        user_data_export.Repository
+	Deletion          user_deletion.Repository
+	ImportJob         user_import_job.Repository
+	SCIMToken         scim.Repository
+	PasswordHistory   user_password_history.Repository
+	PasswordReset     user_password_reset_request.Repository
+	Device            user_device.Repository
 }
 
 type Factory func() *Repositories
 
+type (
+	// Transactor runs fn with repositories bound to a single transaction.
+	// The transaction is committed when fn returns nil and rolled back
+	// otherwise.
+	Transactor interface {
+		WithTransaction(ctx context.Context, fn func(*Repositories) error) error
+	}
+
+	transactor struct {
+		db *sql.DB
+	}
+)
+
 func NewFactory(
 	datasources *datasources.Datasources,
 	configService *config.ConfigurationService,
 ) func() *Repositories {
 	return func() *Repositories {
-		return &Repositories{
-			User:     user.NewRepository(datasources.DB),
-			Role:     role.NewRepository(datasources.DB),
-			UserRole: user_role.NewRepository(datasources.DB),
-		}
+		return newRepositories(datasources.DB)
+	}
+}
+
+func NewTransactor(datasources *datasources.Datasources) Transactor {
+	return &transactor{
+		db: datasources.DB,
+	}
+}
+
+func (t *transactor) WithTransaction(ctx context.Context, fn func(*Repositories) error) error {
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(newRepositories(tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func newRepositories(db datasources.DBTX) *Repositories {
+	return &Repositories{
+		User:              user.NewRepository(db),
+		Role:              role.NewRepository(db),
+		UserRole:          user_role.NewRepository(db),
+		Outbox:            outbox.NewRepository(db),
+		Webhook:           webhook.NewRepository(db),
+		WebhookDelivery:   webhook_delivery.NewRepository(db),
+		Audit:             audit.NewRepository(db),
+		EmailChange:       user_email_change.NewRepository(db),
+		PhoneVerification: user_phone_verification.NewRepository(db),
+		DataExport:        user_data_export.NewRepository(db),
+		Deletion:          user_deletion.NewRepository(db),
+		ImportJob:         user_import_job.NewRepository(db),
+		SCIMToken:         scim.NewRepository(db),
+		PasswordHistory:   user_password_history.NewRepository(db),
+		PasswordReset:     user_password_reset_request.NewRepository(db),
+		Device:            user_device.NewRepository(db),
 	}
 }
@@ -3,6 +3,7 @@ package role
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/tapiaw38/auth-api-be/internal/domain"
 )
@@ -45,6 +46,11 @@ func (r *repository) executeGetQuery(ctx context.Context, filters GetFilterOptio
 		args = append(args, filters.Name)
 	}
 
+	if filters.SCIMTenant != "" {
+		args = append(args, filters.SCIMTenant)
+		query += fmt.Sprintf(` AND scim_tenant = $%d`, len(args))
+	}
+
 	row := r.db.QueryRowContext(ctx, query, args...)
 	if row.Err() != nil {
 		return nil, row.Err()
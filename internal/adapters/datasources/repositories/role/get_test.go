@@ -74,6 +74,25 @@ func TestRepository_Get(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "get by ID within a SCIM tenant",
+			filters: GetFilterOptions{
+				ID:         "role-123",
+				SCIMTenant: "acme",
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name"}).
+					AddRow("role-123", "editors")
+				mock.ExpectQuery(`SELECT id, name FROM roles WHERE 1=1  AND id = \$1 AND scim_tenant = \$2`).
+					WithArgs("role-123", "acme").
+					WillReturnRows(rows)
+			},
+			expectedRole: &domain.Role{
+				ID:   "role-123",
+				Name: "editors",
+			},
+			expectedError: false,
+		},
 		{
 			name: "get with both ID and name filters",
 			filters: GetFilterOptions{
@@ -3,6 +3,7 @@ package role
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/tapiaw38/auth-api-be/internal/domain"
 )
@@ -47,6 +48,11 @@ func (r *repository) executeListQuery(ctx context.Context, filters ListFilterOpt
 		args = append(args, filters.Name)
 	}
 
+	if filters.SCIMTenant != "" {
+		args = append(args, filters.SCIMTenant)
+		query += fmt.Sprintf(` AND scim_tenant = $%d`, len(args))
+	}
+
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
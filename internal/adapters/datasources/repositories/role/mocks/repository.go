@@ -101,6 +101,20 @@ func (mr *MockRepositoryMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), arg0, arg1)
 }
 
+// SetSCIMTenant mocks base method.
+func (m *MockRepository) SetSCIMTenant(ctx context.Context, id, tenant string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSCIMTenant", ctx, id, tenant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSCIMTenant indicates an expected call of SetSCIMTenant.
+func (mr *MockRepositoryMockRecorder) SetSCIMTenant(ctx, id, tenant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSCIMTenant", reflect.TypeOf((*MockRepository)(nil).SetSCIMTenant), ctx, id, tenant)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(arg0 context.Context, arg1 string, arg2 *domain.Role) (string, error) {
 	m.ctrl.T.Helper()
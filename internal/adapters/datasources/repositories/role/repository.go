@@ -2,8 +2,8 @@ package role
 
 import (
 	"context"
-	"database/sql"
 
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
 	"github.com/tapiaw38/auth-api-be/internal/domain"
 )
 
@@ -14,23 +14,30 @@ type (
 		Update(context.Context, string, *domain.Role) (string, error)
 		Delete(context.Context, string) error
 		List(context.Context, ListFilterOptions) ([]domain.Role, error)
+		SetSCIMTenant(ctx context.Context, id string, tenant string) error
 	}
 
 	repository struct {
-		db *sql.DB
+		db datasources.DBTX
 	}
 
 	GetFilterOptions struct {
 		ID   string
 		Name string
+		// SCIMTenant only matches roles created through SCIM by that
+		// tenant.
+		SCIMTenant string
 	}
 
 	ListFilterOptions struct {
 		Name string
+		// SCIMTenant only matches roles created through SCIM by that
+		// tenant.
+		SCIMTenant string
 	}
 )
 
-func NewRepository(db *sql.DB) Repository {
+func NewRepository(db datasources.DBTX) Repository {
 	return &repository{
 		db: db,
 	}
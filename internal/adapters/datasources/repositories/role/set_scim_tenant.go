@@ -0,0 +1,26 @@
+package role
+
+import (
+	"context"
+	"database/sql"
+)
+
+// SetSCIMTenant records the SCIM tenant that created the role. It returns
+// sql.ErrNoRows when the role does not exist.
+func (r *repository) SetSCIMTenant(ctx context.Context, id string, tenant string) error {
+	result, err := r.db.ExecContext(ctx, `UPDATE roles SET scim_tenant = $1 WHERE id = $2`, tenant, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package scim
+
+import (
+	"context"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Create(ctx context.Context, token domain.SCIMToken) (string, error) {
+	query := `INSERT INTO scim_tokens (id, name, tenant, token_hash, created_by, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id`
+
+	var id string
+	err := r.db.QueryRowContext(ctx, query, token.ID, token.Name, token.Tenant, token.TokenHash, token.CreatedBy, time.Now().UTC()).Scan(&id)
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
@@ -0,0 +1,23 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// GetByTokenHash returns the token with the given hash, revoked or not.
+func (r *repository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.SCIMToken, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+selectColumns+` FROM scim_tokens WHERE token_hash = $1`, tokenHash)
+
+	token, err := scanToken(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return token, nil
+}
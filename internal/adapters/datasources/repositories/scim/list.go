@@ -0,0 +1,28 @@
+package scim
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) List(ctx context.Context) ([]domain.SCIMToken, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+selectColumns+` FROM scim_tokens ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var tokens []domain.SCIMToken
+	for rows.Next() {
+		token, err := scanToken(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, *token)
+	}
+
+	return tokens, rows.Err()
+}
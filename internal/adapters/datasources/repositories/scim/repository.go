@@ -0,0 +1,28 @@
+package scim
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	Repository interface {
+		Create(ctx context.Context, token domain.SCIMToken) (string, error)
+		GetByTokenHash(ctx context.Context, tokenHash string) (*domain.SCIMToken, error)
+		List(ctx context.Context) ([]domain.SCIMToken, error)
+		Revoke(ctx context.Context, id string) error
+		Touch(ctx context.Context, id string) error
+	}
+
+	repository struct {
+		db datasources.DBTX
+	}
+)
+
+func NewRepository(db datasources.DBTX) Repository {
+	return &repository{
+		db: db,
+	}
+}
@@ -0,0 +1,32 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Revoke disables a token. It returns sql.ErrNoRows when the token does
+// not exist or was already revoked.
+func (r *repository) Revoke(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE scim_tokens SET revoked_at = $1 WHERE id = $2 AND revoked_at IS NULL`,
+		time.Now().UTC(),
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_Revoke(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "token is revoked",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE scim_tokens SET revoked_at = \$1 WHERE id = \$2 AND revoked_at IS NULL`).
+					WithArgs(sqlmock.AnyArg(), "token-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "token is missing or already revoked",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE scim_tokens SET revoked_at = \$1 WHERE id = \$2 AND revoked_at IS NULL`).
+					WithArgs(sqlmock.AnyArg(), "token-1").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectedError: sql.ErrNoRows,
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE scim_tokens SET revoked_at = \$1 WHERE id = \$2 AND revoked_at IS NULL`).
+					WithArgs(sqlmock.AnyArg(), "token-1").
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			repo := NewRepository(db)
+			tt.mockSetup(mock)
+
+			err = repo.Revoke(context.Background(), "token-1")
+
+			assert.Equal(t, tt.expectedError, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package scim
+
+import (
+	"database/sql"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+const selectColumns = `id, name, tenant, token_hash, created_by, created_at, last_used_at, revoked_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanToken(row scanner) (*domain.SCIMToken, error) {
+	var (
+		token               domain.SCIMToken
+		lastUsedAt, revoked sql.NullTime
+	)
+
+	if err := row.Scan(&token.ID, &token.Name, &token.Tenant, &token.TokenHash, &token.CreatedBy, &token.CreatedAt, &lastUsedAt, &revoked); err != nil {
+		return nil, err
+	}
+
+	if lastUsedAt.Valid {
+		token.LastUsedAt = &lastUsedAt.Time
+	}
+	if revoked.Valid {
+		token.RevokedAt = &revoked.Time
+	}
+
+	return &token, nil
+}
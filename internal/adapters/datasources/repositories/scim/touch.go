@@ -0,0 +1,12 @@
+package scim
+
+import (
+	"context"
+	"time"
+)
+
+// Touch records that the token was just used.
+func (r *repository) Touch(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE scim_tokens SET last_used_at = $1 WHERE id = $2`, time.Now().UTC(), id)
+	return err
+}
@@ -0,0 +1,20 @@
+package user
+
+import (
+	"context"
+	"time"
+)
+
+// ChangeEmail sets a confirmed email address. The address is marked as
+// verified because ownership was proven by the confirmation link.
+func (r *repository) ChangeEmail(ctx context.Context, id string, email string) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		`UPDATE users SET email = $1, verified_email = TRUE, updated_at = $2 WHERE id = $3`,
+		email,
+		time.Now().UTC(),
+		id,
+	)
+
+	return err
+}
@@ -0,0 +1,38 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// ConsumePasswordResetToken clears the reset token so it can't be used
+// again. It returns sql.ErrNoRows when tokenHash is no longer the user's
+// current, unexpired token, which makes concurrent uses of the same link
+// fail.
+func (r *repository) ConsumePasswordResetToken(ctx context.Context, id string, tokenHash string) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE users SET
+			password_reset_token = NULL,
+			password_reset_token_expiry = NULL
+		WHERE id = $1 AND password_reset_token = $2 AND password_reset_token_expiry > $3`,
+		id,
+		tokenHash,
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package user_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+)
+
+func TestRepository_ConsumePasswordResetToken(t *testing.T) {
+	tests := map[string]struct {
+		prepare   func(mock sqlmock.Sqlmock)
+		expectErr error
+	}{
+		"when the token is consumed": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE users SET\s+password_reset_token = NULL,.*WHERE id = \$1 AND password_reset_token = \$2 AND password_reset_token_expiry > \$3`).
+					WithArgs("user-123", "token-hash", sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"when the token was already used or expired": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE users SET`).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectErr: sql.ErrNoRows,
+		},
+		"when the query fails": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE users SET`).WillReturnError(errors.New("database error"))
+			},
+			expectErr: errors.New("database error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tt.prepare(mock)
+
+			repository := user.NewRepository(db)
+			err = repository.ConsumePasswordResetToken(context.Background(), "user-123", "token-hash")
+
+			assert.Equal(t, tt.expectErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+)
+
+// ConsumeVerifiedEmailToken clears the email verification token so the
+// link can't be used again. It returns sql.ErrNoRows when tokenHash is no
+// longer the user's current token.
+func (r *repository) ConsumeVerifiedEmailToken(ctx context.Context, id string, tokenHash string) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE users SET
+			verified_email_token = NULL,
+			verified_email_token_expiry = NULL
+		WHERE id = $1 AND verified_email_token = $2`,
+		id,
+		tokenHash,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
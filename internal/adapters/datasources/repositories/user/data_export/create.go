@@ -0,0 +1,45 @@
+package user_data_export
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Create(ctx context.Context, export domain.DataExport) (string, error) {
+	row, err := r.executeCreateQuery(ctx, export)
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	if err := row.Scan(&id); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (r *repository) executeCreateQuery(ctx context.Context, export domain.DataExport) (*sql.Row, error) {
+	query := `INSERT INTO data_exports (id, user_id, token_hash, payload, expires_at, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id`
+
+	args := []any{
+		export.ID,
+		export.UserID,
+		export.TokenHash,
+		export.Payload,
+		export.ExpiresAt.UTC(),
+		time.Now().UTC(),
+	}
+
+	row := r.db.QueryRowContext(ctx, query, args...)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return row, nil
+}
@@ -0,0 +1,8 @@
+package user_data_export
+
+import "context"
+
+func (r *repository) DeleteByUser(ctx context.Context, userID string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM data_exports WHERE user_id = $1`, userID)
+	return err
+}
@@ -0,0 +1,17 @@
+package user_data_export
+
+import (
+	"context"
+	"time"
+)
+
+// DeleteExpired removes exports whose download link has lapsed and returns
+// how many were removed.
+func (r *repository) DeleteExpired(ctx context.Context) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM data_exports WHERE expires_at <= $1`, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
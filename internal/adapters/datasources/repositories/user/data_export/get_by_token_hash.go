@@ -0,0 +1,52 @@
+package user_data_export
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// GetByTokenHash returns the export for a download token, expired or not.
+func (r *repository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.DataExport, error) {
+	row, err := r.executeGetByTokenHashQuery(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		id, userID           string
+		payload              []byte
+		expiresAt, createdAt time.Time
+	)
+
+	if err := row.Scan(&id, &userID, &payload, &expiresAt, &createdAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &domain.DataExport{
+		ID:        id,
+		UserID:    userID,
+		TokenHash: tokenHash,
+		Payload:   payload,
+		ExpiresAt: expiresAt,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+func (r *repository) executeGetByTokenHashQuery(ctx context.Context, tokenHash string) (*sql.Row, error) {
+	query := `SELECT id, user_id, payload, expires_at, created_at
+			FROM data_exports
+			WHERE token_hash = $1`
+
+	row := r.db.QueryRowContext(ctx, query, tokenHash)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return row, nil
+}
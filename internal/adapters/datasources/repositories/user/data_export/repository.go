@@ -0,0 +1,27 @@
+package user_data_export
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	Repository interface {
+		Create(context.Context, domain.DataExport) (string, error)
+		GetByTokenHash(ctx context.Context, tokenHash string) (*domain.DataExport, error)
+		DeleteByUser(ctx context.Context, userID string) error
+		DeleteExpired(ctx context.Context) (int64, error)
+	}
+
+	repository struct {
+		db datasources.DBTX
+	}
+)
+
+func NewRepository(db datasources.DBTX) Repository {
+	return &repository{
+		db: db,
+	}
+}
@@ -0,0 +1,26 @@
+package user_deletion
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Cancel removes a pending deletion. It returns sql.ErrNoRows when none is
+// scheduled.
+func (r *repository) Cancel(ctx context.Context, userID string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM account_deletions WHERE user_id = $1`, userID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package user_deletion
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Get(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	return r.get(ctx, `SELECT requested_at, scheduled_for FROM account_deletions WHERE user_id = $1`, userID)
+}
+
+// GetForUpdate is Get with a row lock, so a cancellation cannot race an
+// erasure that is already running.
+func (r *repository) GetForUpdate(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	return r.get(ctx, `SELECT requested_at, scheduled_for FROM account_deletions WHERE user_id = $1 FOR UPDATE`, userID)
+}
+
+func (r *repository) get(ctx context.Context, query string, userID string) (*domain.AccountDeletion, error) {
+	deletion := domain.AccountDeletion{UserID: userID}
+
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&deletion.RequestedAt, &deletion.ScheduledFor)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &deletion, nil
+}
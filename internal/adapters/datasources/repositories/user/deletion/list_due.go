@@ -0,0 +1,43 @@
+package user_deletion
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// ListDue returns deletions whose grace period has ended, oldest first.
+func (r *repository) ListDue(ctx context.Context, limit int) ([]domain.AccountDeletion, error) {
+	rows, err := r.executeListDueQuery(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deletions []domain.AccountDeletion
+	for rows.Next() {
+		var deletion domain.AccountDeletion
+		if err := rows.Scan(&deletion.UserID, &deletion.RequestedAt, &deletion.ScheduledFor); err != nil {
+			return nil, err
+		}
+		deletions = append(deletions, deletion)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deletions, nil
+}
+
+func (r *repository) executeListDueQuery(ctx context.Context, limit int) (*sql.Rows, error) {
+	query := `SELECT user_id, requested_at, scheduled_for
+			FROM account_deletions
+			WHERE scheduled_for <= $1
+			ORDER BY scheduled_for
+			LIMIT $2`
+
+	return r.db.QueryContext(ctx, query, time.Now().UTC(), limit)
+}
@@ -0,0 +1,29 @@
+package user_deletion
+
+import (
+	"context"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	Repository interface {
+		Schedule(ctx context.Context, userID string, scheduledFor time.Time) (*domain.AccountDeletion, error)
+		Get(ctx context.Context, userID string) (*domain.AccountDeletion, error)
+		GetForUpdate(ctx context.Context, userID string) (*domain.AccountDeletion, error)
+		Cancel(ctx context.Context, userID string) error
+		ListDue(ctx context.Context, limit int) ([]domain.AccountDeletion, error)
+	}
+
+	repository struct {
+		db datasources.DBTX
+	}
+)
+
+func NewRepository(db datasources.DBTX) Repository {
+	return &repository{
+		db: db,
+	}
+}
@@ -0,0 +1,39 @@
+package user_deletion
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// Schedule records a deletion request. Asking again while one is pending
+// keeps the original date so the grace period cannot be extended forever.
+func (r *repository) Schedule(ctx context.Context, userID string, scheduledFor time.Time) (*domain.AccountDeletion, error) {
+	row, err := r.executeScheduleQuery(ctx, userID, scheduledFor)
+	if err != nil {
+		return nil, err
+	}
+
+	deletion := domain.AccountDeletion{UserID: userID}
+	if err := row.Scan(&deletion.RequestedAt, &deletion.ScheduledFor); err != nil {
+		return nil, err
+	}
+
+	return &deletion, nil
+}
+
+func (r *repository) executeScheduleQuery(ctx context.Context, userID string, scheduledFor time.Time) (*sql.Row, error) {
+	query := `INSERT INTO account_deletions (user_id, requested_at, scheduled_for)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (user_id) DO UPDATE SET user_id = EXCLUDED.user_id
+			RETURNING requested_at, scheduled_for`
+
+	row := r.db.QueryRowContext(ctx, query, userID, time.Now().UTC(), scheduledFor.UTC())
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return row, nil
+}
@@ -0,0 +1,75 @@
+package user_deletion_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	user_deletion "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/deletion"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func TestRepository_Schedule(t *testing.T) {
+	requestedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	scheduledFor := requestedAt.Add(30 * 24 * time.Hour)
+
+	tests := map[string]struct {
+		prepare   func(mock sqlmock.Sqlmock)
+		expect    *domain.AccountDeletion
+		expectErr error
+	}{
+		"when the deletion is scheduled": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO account_deletions .* ON CONFLICT \(user_id\) DO UPDATE SET user_id = EXCLUDED.user_id RETURNING requested_at, scheduled_for`).
+					WithArgs("user-1", sqlmock.AnyArg(), scheduledFor).
+					WillReturnRows(sqlmock.NewRows([]string{"requested_at", "scheduled_for"}).AddRow(requestedAt, scheduledFor))
+			},
+			expect: &domain.AccountDeletion{
+				UserID:       "user-1",
+				RequestedAt:  requestedAt,
+				ScheduledFor: scheduledFor,
+			},
+		},
+		"when one is already pending the original date is kept": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				earlier := scheduledFor.Add(-24 * time.Hour)
+				mock.ExpectQuery(`INSERT INTO account_deletions`).
+					WithArgs("user-1", sqlmock.AnyArg(), scheduledFor).
+					WillReturnRows(sqlmock.NewRows([]string{"requested_at", "scheduled_for"}).AddRow(requestedAt.Add(-24*time.Hour), earlier))
+			},
+			expect: &domain.AccountDeletion{
+				UserID:       "user-1",
+				RequestedAt:  requestedAt.Add(-24 * time.Hour),
+				ScheduledFor: scheduledFor.Add(-24 * time.Hour),
+			},
+		},
+		"when the query fails": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO account_deletions`).WillReturnError(errors.New("database error"))
+			},
+			expectErr: errors.New("database error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tt.prepare(mock)
+
+			repository := user_deletion.NewRepository(db)
+			deletion, err := repository.Schedule(context.Background(), "user-1", scheduledFor)
+
+			assert.Equal(t, tt.expect, deletion)
+			assert.Equal(t, tt.expectErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
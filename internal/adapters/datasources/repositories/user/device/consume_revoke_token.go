@@ -0,0 +1,19 @@
+package user_device
+
+import (
+	"context"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// ConsumeRevokeToken forgets the device whose "this wasn't me" token
+// matches, so a later login from it counts as new again, and returns it.
+// It returns sql.ErrNoRows when no unexpired token matches.
+func (r *repository) ConsumeRevokeToken(ctx context.Context, tokenHash string) (*domain.UserDevice, error) {
+	query := `DELETE FROM user_devices
+			WHERE revoke_token_hash = $1 AND revoke_token_expires_at > $2
+			RETURNING ` + deviceColumns
+
+	return scanDevice(r.db.QueryRowContext(ctx, query, tokenHash, time.Now().UTC()))
+}
@@ -0,0 +1,74 @@
+package user_device_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	user_device "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/device"
+)
+
+func TestRepository_ConsumeRevokeToken(t *testing.T) {
+	columns := []string{
+		"id", "user_id", "fingerprint", "user_agent", "ip", "country", "region", "city",
+		"latitude", "longitude", "revoke_token_hash", "revoke_token_expires_at",
+		"first_seen_at", "last_seen_at",
+	}
+	now := time.Now()
+
+	tests := map[string]struct {
+		prepare        func(mock sqlmock.Sqlmock)
+		expectedUserID string
+		expectErr      error
+	}{
+		"when the token matches": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`DELETE FROM user_devices\s+WHERE revoke_token_hash = \$1 AND revoke_token_expires_at > \$2\s+RETURNING`).
+					WithArgs("token-hash", sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(
+						"device-1", "user-123", "fingerprint", "Firefox", "81.2.69.1", "GB", "England", "London",
+						nil, nil, "token-hash", now.Add(time.Hour), now, now,
+					))
+			},
+			expectedUserID: "user-123",
+		},
+		"when the token was already used or expired": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`DELETE FROM user_devices`).WillReturnRows(sqlmock.NewRows(columns))
+			},
+			expectErr: sql.ErrNoRows,
+		},
+		"when the query fails": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`DELETE FROM user_devices`).WillReturnError(errors.New("database error"))
+			},
+			expectErr: errors.New("database error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tt.prepare(mock)
+
+			repository := user_device.NewRepository(db)
+			device, err := repository.ConsumeRevokeToken(context.Background(), "token-hash")
+
+			assert.Equal(t, tt.expectErr, err)
+			if tt.expectErr == nil {
+				assert.Equal(t, tt.expectedUserID, device.UserID)
+				assert.Nil(t, device.Latitude)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
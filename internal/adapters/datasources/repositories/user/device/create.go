@@ -0,0 +1,37 @@
+package user_device
+
+import (
+	"context"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Create(ctx context.Context, device domain.UserDevice) error {
+	query := `INSERT INTO user_devices (
+				id, user_id, fingerprint, user_agent, ip, country, region, city,
+				latitude, longitude, revoke_token_hash, revoke_token_expires_at,
+				first_seen_at, last_seen_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $13)`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		device.ID,
+		device.UserID,
+		device.Fingerprint,
+		device.UserAgent,
+		device.IP,
+		device.Country,
+		device.Region,
+		device.City,
+		device.Latitude,
+		device.Longitude,
+		device.RevokeTokenHash,
+		device.RevokeTokenExpiresAt,
+		time.Now().UTC(),
+	)
+
+	return err
+}
@@ -0,0 +1,90 @@
+package user_device
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+const deviceColumns = `id, user_id, fingerprint, user_agent, ip, country, region, city,
+			latitude, longitude, revoke_token_hash, revoke_token_expires_at,
+			first_seen_at, last_seen_at`
+
+// GetByFingerprint returns nil when the user has never logged in from the
+// device.
+func (r *repository) GetByFingerprint(ctx context.Context, userID, fingerprint string) (*domain.UserDevice, error) {
+	query := `SELECT ` + deviceColumns + `
+			FROM user_devices
+			WHERE user_id = $1 AND fingerprint = $2`
+
+	return r.get(ctx, query, userID, fingerprint)
+}
+
+// GetLatest returns the device the user last logged in from, or nil when
+// they have none.
+func (r *repository) GetLatest(ctx context.Context, userID string) (*domain.UserDevice, error) {
+	query := `SELECT ` + deviceColumns + `
+			FROM user_devices
+			WHERE user_id = $1
+			ORDER BY last_seen_at DESC
+			LIMIT 1`
+
+	return r.get(ctx, query, userID)
+}
+
+func (r *repository) get(ctx context.Context, query string, args ...any) (*domain.UserDevice, error) {
+	device, err := scanDevice(r.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return device, nil
+}
+
+func scanDevice(row *sql.Row) (*domain.UserDevice, error) {
+	var (
+		device          domain.UserDevice
+		latitude        sql.NullFloat64
+		longitude       sql.NullFloat64
+		revokeTokenHash sql.NullString
+		revokeExpiresAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&device.ID,
+		&device.UserID,
+		&device.Fingerprint,
+		&device.UserAgent,
+		&device.IP,
+		&device.Country,
+		&device.Region,
+		&device.City,
+		&latitude,
+		&longitude,
+		&revokeTokenHash,
+		&revokeExpiresAt,
+		&device.FirstSeenAt,
+		&device.LastSeenAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if latitude.Valid && longitude.Valid {
+		device.Latitude = &latitude.Float64
+		device.Longitude = &longitude.Float64
+	}
+	if revokeTokenHash.Valid {
+		device.RevokeTokenHash = &revokeTokenHash.String
+	}
+	if revokeExpiresAt.Valid {
+		device.RevokeTokenExpiresAt = &revokeExpiresAt.Time
+	}
+
+	return &device, nil
+}
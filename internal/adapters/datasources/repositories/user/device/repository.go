@@ -0,0 +1,28 @@
+package user_device
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	Repository interface {
+		Create(context.Context, domain.UserDevice) error
+		GetByFingerprint(ctx context.Context, userID, fingerprint string) (*domain.UserDevice, error)
+		GetLatest(ctx context.Context, userID string) (*domain.UserDevice, error)
+		Touch(context.Context, domain.UserDevice) error
+		ConsumeRevokeToken(ctx context.Context, tokenHash string) (*domain.UserDevice, error)
+	}
+
+	repository struct {
+		db datasources.DBTX
+	}
+)
+
+func NewRepository(db datasources.DBTX) Repository {
+	return &repository{
+		db: db,
+	}
+}
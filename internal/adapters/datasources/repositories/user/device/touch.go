@@ -0,0 +1,37 @@
+package user_device
+
+import (
+	"context"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// Touch records a new login from a known device, updating its IP and
+// location.
+func (r *repository) Touch(ctx context.Context, device domain.UserDevice) error {
+	query := `UPDATE user_devices SET
+				ip = $2,
+				country = $3,
+				region = $4,
+				city = $5,
+				latitude = $6,
+				longitude = $7,
+				last_seen_at = $8
+			WHERE id = $1`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		device.ID,
+		device.IP,
+		device.Country,
+		device.Region,
+		device.City,
+		device.Latitude,
+		device.Longitude,
+		time.Now().UTC(),
+	)
+
+	return err
+}
@@ -0,0 +1,32 @@
+package user_email_change
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Confirm marks a pending change as applied. It returns sql.ErrNoRows when
+// the change does not exist or was already confirmed.
+func (r *repository) Confirm(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE email_changes SET confirmed_at = $1 WHERE id = $2 AND confirmed_at IS NULL`,
+		time.Now().UTC(),
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
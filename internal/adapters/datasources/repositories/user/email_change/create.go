@@ -0,0 +1,45 @@
+package user_email_change
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Create(ctx context.Context, change domain.EmailChange) (string, error) {
+	row, err := r.executeCreateQuery(ctx, change)
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	if err := row.Scan(&id); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (r *repository) executeCreateQuery(ctx context.Context, change domain.EmailChange) (*sql.Row, error) {
+	query := `INSERT INTO email_changes (id, user_id, new_email, token_hash, expires_at, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id`
+
+	args := []any{
+		change.ID,
+		change.UserID,
+		change.NewEmail,
+		change.TokenHash,
+		change.ExpiresAt.UTC(),
+		time.Now().UTC(),
+	}
+
+	row := r.db.QueryRowContext(ctx, query, args...)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return row, nil
+}
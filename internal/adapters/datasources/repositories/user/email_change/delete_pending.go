@@ -0,0 +1,17 @@
+package user_email_change
+
+import (
+	"context"
+)
+
+// DeletePending drops the unconfirmed requests of a user, so only the
+// latest link sent can be used.
+func (r *repository) DeletePending(ctx context.Context, userID string) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM email_changes WHERE user_id = $1 AND confirmed_at IS NULL`,
+		userID,
+	)
+
+	return err
+}
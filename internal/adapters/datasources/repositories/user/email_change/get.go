@@ -0,0 +1,66 @@
+package user_email_change
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Get(ctx context.Context, filters GetFilterOptions) (*domain.EmailChange, error) {
+	row, err := r.executeGetQuery(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		id, userID, newEmail, tokenHash string
+		expiresAt, createdAt            time.Time
+		confirmedAt                     *time.Time
+	)
+
+	if err := row.Scan(&id, &userID, &newEmail, &tokenHash, &expiresAt, &confirmedAt, &createdAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &domain.EmailChange{
+		ID:          id,
+		UserID:      userID,
+		NewEmail:    newEmail,
+		TokenHash:   tokenHash,
+		ExpiresAt:   expiresAt,
+		ConfirmedAt: confirmedAt,
+		CreatedAt:   createdAt,
+	}, nil
+}
+
+func (r *repository) executeGetQuery(ctx context.Context, filters GetFilterOptions) (*sql.Row, error) {
+	query := `SELECT id, user_id, new_email, token_hash, expires_at, confirmed_at, created_at
+			FROM email_changes WHERE 1=1`
+
+	args := []any{}
+
+	if filters.ID != "" {
+		args = append(args, filters.ID)
+		query += fmt.Sprintf(` AND id = $%d`, len(args))
+	}
+
+	if filters.TokenHash != "" {
+		args = append(args, filters.TokenHash)
+		query += fmt.Sprintf(` AND token_hash = $%d`, len(args))
+	}
+
+	query += ` LIMIT 1`
+
+	row := r.db.QueryRowContext(ctx, query, args...)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return row, nil
+}
@@ -0,0 +1,32 @@
+package user_email_change
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	Repository interface {
+		Create(context.Context, domain.EmailChange) (string, error)
+		Get(context.Context, GetFilterOptions) (*domain.EmailChange, error)
+		Confirm(context.Context, string) error
+		DeletePending(context.Context, string) error
+	}
+
+	repository struct {
+		db datasources.DBTX
+	}
+
+	GetFilterOptions struct {
+		ID        string
+		TokenHash string
+	}
+)
+
+func NewRepository(db datasources.DBTX) Repository {
+	return &repository{
+		db: db,
+	}
+}
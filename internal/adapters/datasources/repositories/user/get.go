@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/tapiaw38/auth-api-be/internal/domain"
@@ -16,12 +17,16 @@ func (r *repository) Get(ctx context.Context, filters GetFilterOptions) (*domain
 	}
 
 	var (
-		id, firstName, lastName, username, email, password, verifiedEmailToken, authMethod string
+		id, firstName, lastName, username, email, password, authMethod string
 	)
 	var phoneNumber, picture, address, passwordResetToken *string
-	var isActive, verifiedEmail bool
-	var createdAt, updatedAt, verifiedEmailTokenExpiry time.Time
-	var passwordResetTokenExpiry *time.Time
+	var isActive, verifiedEmail, verifiedPhone bool
+	var createdAt, updatedAt time.Time
+	// The verification token is cleared once used.
+	var verifiedEmailToken sql.NullString
+	var verifiedEmailTokenExpiry sql.NullTime
+	var passwordResetTokenExpiry, suspendedAt, suspendedUntil, deletedAt *time.Time
+	var suspensionReason *string
 	var tokenVersion uint
 	var rolesJSON json.RawMessage
 
@@ -37,6 +42,7 @@ func (r *repository) Get(ctx context.Context, filters GetFilterOptions) (*domain
 		&address,
 		&isActive,
 		&verifiedEmail,
+		&verifiedPhone,
 		&verifiedEmailToken,
 		&verifiedEmailTokenExpiry,
 		&passwordResetToken,
@@ -45,6 +51,10 @@ func (r *repository) Get(ctx context.Context, filters GetFilterOptions) (*domain
 		&authMethod,
 		&createdAt,
 		&updatedAt,
+		&suspendedAt,
+		&suspendedUntil,
+		&suspensionReason,
+		&deletedAt,
 		&rolesJSON,
 	)
 	if err != nil {
@@ -72,14 +82,19 @@ func (r *repository) Get(ctx context.Context, filters GetFilterOptions) (*domain
 		address,
 		isActive,
 		verifiedEmail,
-		verifiedEmailToken,
-		verifiedEmailTokenExpiry,
+		verifiedPhone,
+		verifiedEmailToken.String,
+		verifiedEmailTokenExpiry.Time,
 		passwordResetToken,
 		passwordResetTokenExpiry,
 		tokenVersion,
 		authMethod,
 		createdAt,
 		updatedAt,
+		suspendedAt,
+		suspendedUntil,
+		suspensionReason,
+		deletedAt,
 		roles,
 	), nil
 }
@@ -88,10 +103,11 @@ func (r *repository) executeGetQuery(ctx context.Context, filters GetFilterOptio
 	query := `SELECT
 				u.id, u.first_name, u.last_name, u.username,
 				u.email, u.password, u.phone_number, u.picture, u.address,
-				u.is_active, u.verified_email, u.verified_email_token,
+				u.is_active, u.verified_email, u.verified_phone, u.verified_email_token,
 				u.verified_email_token_expiry, u.password_reset_token,
 				u.password_reset_token_expiry, u.token_version, u.auth_method,
 				u.created_at, u.updated_at,
+				u.suspended_at, u.suspended_until, u.suspension_reason, u.deleted_at,
 				COALESCE(
 					json_agg(
 						json_build_object(
@@ -134,15 +150,25 @@ func (r *repository) executeGetQuery(ctx context.Context, filters GetFilterOptio
 		args = append(args, filters.PasswordResetToken)
 	}
 
+	if filters.SCIMTenant != "" {
+		args = append(args, filters.SCIMTenant)
+		query += fmt.Sprintf(` AND u.scim_tenant = $%d`, len(args))
+	}
+
+	if !filters.IncludeDeleted {
+		query += ` AND u.deleted_at IS NULL`
+	}
+
 	query += ` GROUP BY
 		u.id, u.first_name, u.last_name,
 		u.username, u.email, u.password,
 		u.phone_number, u.picture, u.address,
-		u.is_active, u.verified_email,
+		u.is_active, u.verified_email, u.verified_phone,
 		u.verified_email_token, u.verified_email_token_expiry,
 		u.password_reset_token, u.password_reset_token_expiry,
 		u.token_version, u.auth_method,
-		u.created_at, u.updated_at`
+		u.created_at, u.updated_at,
+		u.suspended_at, u.suspended_until, u.suspension_reason, u.deleted_at`
 
 	row := r.db.QueryRowContext(ctx, query, args...)
 	if row.Err() != nil {
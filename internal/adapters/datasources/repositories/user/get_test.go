@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
 	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/utils"
 )
 
 func TestRepository_Get(t *testing.T) {
@@ -34,6 +35,7 @@ func TestRepository_Get(t *testing.T) {
 		"address",
 		"is_active",
 		"verified_email",
+		"verified_phone",
 		"verified_email_token",
 		"verified_email_token_expiry",
 		"password_reset_token",
@@ -42,6 +44,10 @@ func TestRepository_Get(t *testing.T) {
 		"auth_method",
 		"created_at",
 		"updated_at",
+		"suspended_at",
+		"suspended_until",
+		"suspension_reason",
+		"deleted_at",
 		"roles",
 	}
 
@@ -74,6 +80,7 @@ func TestRepository_Get(t *testing.T) {
 					address,
 					true,
 					true,
+					false,
 					"token123",
 					validDate.Add(24*time.Hour),
 					passwordResetToken,
@@ -82,6 +89,10 @@ func TestRepository_Get(t *testing.T) {
 					"password",
 					validDate,
 					validDate,
+					nil,
+					nil,
+					nil,
+					nil,
 					rolesJSON,
 				)
 				f.mock.ExpectQuery("SELECT").WithArgs("user-123").WillReturnRows(rows)
@@ -130,6 +141,7 @@ func TestRepository_Get(t *testing.T) {
 					nil,
 					true,
 					false,
+					false,
 					"token456",
 					validDate.Add(24*time.Hour),
 					nil,
@@ -138,6 +150,10 @@ func TestRepository_Get(t *testing.T) {
 					"password",
 					validDate,
 					validDate,
+					nil,
+					nil,
+					nil,
+					nil,
 					rolesJSON,
 				)
 				f.mock.ExpectQuery("SELECT").WithArgs("johndoe").WillReturnRows(rows)
@@ -186,6 +202,7 @@ func TestRepository_Get(t *testing.T) {
 					nil,
 					false,
 					false,
+					false,
 					"token789",
 					validDate.Add(24*time.Hour),
 					nil,
@@ -194,6 +211,10 @@ func TestRepository_Get(t *testing.T) {
 					"password",
 					validDate,
 					validDate,
+					nil,
+					nil,
+					nil,
+					nil,
 					rolesJSON,
 				)
 				f.mock.ExpectQuery("SELECT").WithArgs("john@example.com").WillReturnRows(rows)
@@ -240,6 +261,7 @@ func TestRepository_Get(t *testing.T) {
 					nil,
 					true,
 					false,
+					false,
 					"token123",
 					validDate.Add(24*time.Hour),
 					nil,
@@ -248,6 +270,10 @@ func TestRepository_Get(t *testing.T) {
 					"password",
 					validDate,
 					validDate,
+					nil,
+					nil,
+					nil,
+					nil,
 					rolesJSON,
 				)
 				f.mock.ExpectQuery("SELECT").WithArgs("token123").WillReturnRows(rows)
@@ -296,6 +322,7 @@ func TestRepository_Get(t *testing.T) {
 					nil,
 					true,
 					true,
+					false,
 					"token123",
 					validDate.Add(24*time.Hour),
 					"reset-token-123",
@@ -304,6 +331,10 @@ func TestRepository_Get(t *testing.T) {
 					"password",
 					validDate,
 					validDate,
+					nil,
+					nil,
+					nil,
+					nil,
 					rolesJSON,
 				)
 				f.mock.ExpectQuery("SELECT").WithArgs("reset-token-123").WillReturnRows(rows)
@@ -353,6 +384,77 @@ func TestRepository_Get(t *testing.T) {
 			},
 			expectErr: errors.New("database connection error"),
 		},
+		"when soft-deleted users are excluded by default": {
+			filters: user.GetFilterOptions{
+				Email: "john@example.com",
+			},
+			prepare: func(f *fields) {
+				f.mock.ExpectQuery(`AND u.email = \$1 AND u.deleted_at IS NULL GROUP BY`).
+					WithArgs("john@example.com").
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		"when soft-deleted users are included on request": {
+			filters: user.GetFilterOptions{
+				Email:          "john@example.com",
+				IncludeDeleted: true,
+			},
+			prepare: func(f *fields) {
+				suspendedAt := validDate.Add(-time.Hour)
+				deletedAt := validDate
+				rows := sqlmock.NewRows(columns)
+				rows.AddRow(
+					"user-123",
+					"John",
+					"Doe",
+					"johndoe",
+					"john@example.com",
+					"hashedpassword",
+					nil,
+					nil,
+					nil,
+					false,
+					true,
+					false,
+					"token123",
+					validDate.Add(24*time.Hour),
+					nil,
+					nil,
+					2,
+					"password",
+					validDate,
+					validDate,
+					suspendedAt,
+					nil,
+					"spam",
+					deletedAt,
+					buildRolesJSON(`[]`),
+				)
+				f.mock.ExpectQuery(`AND u.email = \$1 GROUP BY`).
+					WithArgs("john@example.com").
+					WillReturnRows(rows)
+			},
+			expect: &domain.User{
+				ID:                       "user-123",
+				FirstName:                "John",
+				LastName:                 "Doe",
+				Username:                 "johndoe",
+				Email:                    "john@example.com",
+				Password:                 "hashedpassword",
+				IsActive:                 false,
+				VerifiedEmail:            true,
+				VerifiedEmailToken:       "token123",
+				VerifiedEmailTokenExpiry: validDate.Add(24 * time.Hour),
+				TokenVersion:             2,
+				AuthMethod:               "password",
+				Roles:                    []domain.Role{},
+				SuspendedAt:              utils.ToPointer(validDate.Add(-time.Hour)),
+				SuspensionReason:         utils.ToPointer("spam"),
+				DeletedAt:                utils.ToPointer(validDate),
+				CreatedAt:                validDate,
+				UpdatedAt:                validDate,
+			},
+		},
 		"when roles JSON parsing fails": {
 			filters: user.GetFilterOptions{
 				ID: "user-123",
@@ -372,6 +474,7 @@ func TestRepository_Get(t *testing.T) {
 					nil,
 					true,
 					true,
+					false,
 					"token123",
 					validDate.Add(24*time.Hour),
 					nil,
@@ -380,6 +483,10 @@ func TestRepository_Get(t *testing.T) {
 					"password",
 					validDate,
 					validDate,
+					nil,
+					nil,
+					nil,
+					nil,
 					invalidRolesJSON,
 				)
 				f.mock.ExpectQuery("SELECT").WithArgs("user-123").WillReturnRows(rows)
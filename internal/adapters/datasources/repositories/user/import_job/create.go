@@ -0,0 +1,47 @@
+package user_import_job
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Create(ctx context.Context, job domain.UserImportJob) (string, error) {
+	row, err := r.executeCreateQuery(ctx, job)
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	if err := row.Scan(&id); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (r *repository) executeCreateQuery(ctx context.Context, job domain.UserImportJob) (*sql.Row, error) {
+	query := `INSERT INTO user_import_jobs (id, format, dry_run, send_invites, status, payload, created_by, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			RETURNING id`
+
+	args := []any{
+		job.ID,
+		job.Format,
+		job.DryRun,
+		job.SendInvites,
+		domain.UserImportJobPending,
+		job.Payload,
+		job.CreatedBy,
+		time.Now().UTC(),
+	}
+
+	row := r.db.QueryRowContext(ctx, query, args...)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return row, nil
+}
@@ -0,0 +1,62 @@
+package user_import_job
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// Finish stores the outcome of a job and drops the uploaded file, which may
+// hold password hashes. A job with result.Error set is marked failed.
+func (r *repository) Finish(ctx context.Context, id string, result domain.UserImportResult) error {
+	rowErrors := result.Errors
+	if rowErrors == nil {
+		rowErrors = []domain.UserImportRowError{}
+	}
+
+	errorsRaw, err := json.Marshal(rowErrors)
+	if err != nil {
+		return err
+	}
+
+	status := domain.UserImportJobCompleted
+	if result.Error != nil {
+		status = domain.UserImportJobFailed
+	}
+
+	query := `UPDATE user_import_jobs
+			SET status = $1, payload = NULL, total_rows = $2, created_rows = $3, skipped_rows = $4,
+				failed_rows = $5, errors = $6, error = $7, finished_at = $8
+			WHERE id = $9`
+
+	args := []any{
+		status,
+		result.TotalRows,
+		result.CreatedRows,
+		result.SkippedRows,
+		result.FailedRows,
+		errorsRaw,
+		result.Error,
+		time.Now().UTC(),
+		id,
+	}
+
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
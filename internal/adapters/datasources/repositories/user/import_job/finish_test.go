@@ -0,0 +1,73 @@
+package user_import_job_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	user_import_job "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/import_job"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func TestRepository_Finish(t *testing.T) {
+	failure := "file is empty"
+
+	tests := map[string]struct {
+		result    domain.UserImportResult
+		prepare   func(mock sqlmock.Sqlmock)
+		expectErr error
+	}{
+		"when the job completes": {
+			result: domain.UserImportResult{
+				TotalRows:   3,
+				CreatedRows: 1,
+				SkippedRows: 1,
+				FailedRows:  1,
+				Errors:      []domain.UserImportRowError{{Row: 3, Email: "x@example.com", Error: "last name is required"}},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE user_import_jobs\s+SET status = \$1, payload = NULL`).
+					WithArgs(
+						domain.UserImportJobCompleted, 3, 1, 1, 1,
+						[]byte(`[{"row":3,"email":"x@example.com","error":"last name is required"}]`),
+						nil, sqlmock.AnyArg(), "job-1",
+					).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"when the job fails": {
+			result: domain.UserImportResult{Error: &failure},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE user_import_jobs`).
+					WithArgs(domain.UserImportJobFailed, 0, 0, 0, 0, []byte(`[]`), &failure, sqlmock.AnyArg(), "job-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"when the job does not exist": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE user_import_jobs`).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectErr: sql.ErrNoRows,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tt.prepare(mock)
+
+			repository := user_import_job.NewRepository(db)
+			err = repository.Finish(context.Background(), "job-1", tt.result)
+
+			assert.Equal(t, tt.expectErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package user_import_job
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Get(ctx context.Context, id string) (*domain.UserImportJob, error) {
+	row, err := r.executeGetQuery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		job                   domain.UserImportJob
+		errorsRaw             []byte
+		jobError              sql.NullString
+		startedAt, finishedAt sql.NullTime
+	)
+
+	err = row.Scan(
+		&job.ID,
+		&job.Format,
+		&job.DryRun,
+		&job.SendInvites,
+		&job.Status,
+		&job.Payload,
+		&job.TotalRows,
+		&job.CreatedRows,
+		&job.SkippedRows,
+		&job.FailedRows,
+		&errorsRaw,
+		&jobError,
+		&job.CreatedBy,
+		&job.CreatedAt,
+		&startedAt,
+		&finishedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(errorsRaw, &job.Errors); err != nil {
+		return nil, err
+	}
+
+	if jobError.Valid {
+		job.Error = &jobError.String
+	}
+	if startedAt.Valid {
+		job.StartedAt = &startedAt.Time
+	}
+	if finishedAt.Valid {
+		job.FinishedAt = &finishedAt.Time
+	}
+
+	return &job, nil
+}
+
+func (r *repository) executeGetQuery(ctx context.Context, id string) (*sql.Row, error) {
+	query := `SELECT id, format, dry_run, send_invites, status, payload, total_rows, created_rows,
+				skipped_rows, failed_rows, errors, error, created_by, created_at, started_at, finished_at
+			FROM user_import_jobs
+			WHERE id = $1`
+
+	row := r.db.QueryRowContext(ctx, query, id)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return row, nil
+}
@@ -0,0 +1,33 @@
+package user_import_job
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// MarkRunning claims a pending job. It returns sql.ErrNoRows when the job
+// was already claimed, so a redelivered message does not import twice.
+func (r *repository) MarkRunning(ctx context.Context, id string) error {
+	query := `UPDATE user_import_jobs
+			SET status = $1, started_at = $2
+			WHERE id = $3 AND status = $4`
+
+	result, err := r.db.ExecContext(ctx, query, domain.UserImportJobRunning, time.Now().UTC(), id, domain.UserImportJobPending)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package user_import_job
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	Repository interface {
+		Create(ctx context.Context, job domain.UserImportJob) (string, error)
+		Get(ctx context.Context, id string) (*domain.UserImportJob, error)
+		MarkRunning(ctx context.Context, id string) error
+		Finish(ctx context.Context, id string, result domain.UserImportResult) error
+	}
+
+	repository struct {
+		db datasources.DBTX
+	}
+)
+
+func NewRepository(db datasources.DBTX) Repository {
+	return &repository{
+		db: db,
+	}
+}
@@ -0,0 +1,16 @@
+package user
+
+import (
+	"context"
+)
+
+// IncrementTokenVersion invalidates every token issued to the user so far.
+func (r *repository) IncrementTokenVersion(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		`UPDATE users SET token_version = token_version + 1 WHERE id = $1`,
+		id,
+	)
+
+	return err
+}
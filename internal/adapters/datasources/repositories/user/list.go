@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/tapiaw38/auth-api-be/internal/domain"
@@ -18,151 +19,227 @@ func (r *repository) List(ctx context.Context, filters ListFilterOptions) ([]*do
 
 	defer rows.Close()
 
+	return scanUsers(rows)
+}
+
+// scanUsers reads every row of a query that selects the same columns as
+// executeListQuery.
+func scanUsers(rows *sql.Rows) ([]*domain.User, error) {
 	var users []*domain.User
 	for rows.Next() {
-		var (
-			id, firstName, lastName, username, email, password, verifiedEmailToken, authMethod string
-		)
-		var phoneNumber, picture, address, passwordResetToken *string
-		var isActive, verifiedEmail bool
-		var createdAt, updatedAt, verifiedEmailTokenExpiry time.Time
-		var passwordResetTokenExpiry *time.Time
-		var tokenVersion uint
-		var rolesJSON json.RawMessage
-
-		err = rows.Scan(
-			&id,
-			&firstName,
-			&lastName,
-			&username,
-			&email,
-			&password,
-			&phoneNumber,
-			&picture,
-			&address,
-			&isActive,
-			&verifiedEmail,
-			&verifiedEmailToken,
-			&verifiedEmailTokenExpiry,
-			&passwordResetToken,
-			&passwordResetTokenExpiry,
-			&tokenVersion,
-			&authMethod,
-			&createdAt,
-			&updatedAt,
-			&rolesJSON,
-		)
+		user, err := scanUser(rows)
 		if err != nil {
 			return nil, err
 		}
+		users = append(users, user)
+	}
 
-		roles, err := unmarshalRoles(rolesJSON)
-		if err != nil {
-			return nil, err
-		}
+	return users, rows.Err()
+}
+
+// scanUser reads the current row of rows, whose columns are userPageColumns
+// followed by one column for each of extra.
+func scanUser(rows *sql.Rows, extra ...any) (*domain.User, error) {
+	var (
+		id, firstName, lastName, username, email, password, authMethod string
+	)
+	var phoneNumber, picture, address, passwordResetToken *string
+	var isActive, verifiedEmail, verifiedPhone bool
+	var createdAt, updatedAt time.Time
+	// The verification token is cleared once used.
+	var verifiedEmailToken sql.NullString
+	var verifiedEmailTokenExpiry sql.NullTime
+	var passwordResetTokenExpiry, suspendedAt, suspendedUntil, deletedAt *time.Time
+	var suspensionReason *string
+	var tokenVersion uint
+	var rolesJSON json.RawMessage
+
+	dest := []any{
+		&id,
+		&firstName,
+		&lastName,
+		&username,
+		&email,
+		&password,
+		&phoneNumber,
+		&picture,
+		&address,
+		&isActive,
+		&verifiedEmail,
+		&verifiedPhone,
+		&verifiedEmailToken,
+		&verifiedEmailTokenExpiry,
+		&passwordResetToken,
+		&passwordResetTokenExpiry,
+		&tokenVersion,
+		&authMethod,
+		&createdAt,
+		&updatedAt,
+		&suspendedAt,
+		&suspendedUntil,
+		&suspensionReason,
+		&deletedAt,
+		&rolesJSON,
+	}
+	if err := rows.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	roles, err := unmarshalRoles(rolesJSON)
+	if err != nil {
+		return nil, err
+	}
 
-		users = append(users, unmarshalUser(
-			id,
-			firstName,
-			lastName,
-			username,
-			email,
-			password,
-			phoneNumber,
-			picture,
-			address,
-			isActive,
-			verifiedEmail,
-			verifiedEmailToken,
-			verifiedEmailTokenExpiry,
-			passwordResetToken,
-			passwordResetTokenExpiry,
-			tokenVersion,
-			authMethod,
-			createdAt,
-			updatedAt,
-			roles,
-		))
-	}
-
-	return users, nil
+	return unmarshalUser(
+		id,
+		firstName,
+		lastName,
+		username,
+		email,
+		password,
+		phoneNumber,
+		picture,
+		address,
+		isActive,
+		verifiedEmail,
+		verifiedPhone,
+		verifiedEmailToken.String,
+		verifiedEmailTokenExpiry.Time,
+		passwordResetToken,
+		passwordResetTokenExpiry,
+		tokenVersion,
+		authMethod,
+		createdAt,
+		updatedAt,
+		suspendedAt,
+		suspendedUntil,
+		suspensionReason,
+		deletedAt,
+		roles,
+	), nil
 }
 
 func (r *repository) executeListQuery(ctx context.Context, filters ListFilterOptions) (*sql.Rows, error) {
-	query := `SELECT
-                u.id, u.first_name, u.last_name, u.username,
-                u.email, u.password, u.phone_number, u.picture, u.address,
-                u.is_active, u.verified_email, u.verified_email_token,
-                u.verified_email_token_expiry, u.password_reset_token,
-                u.password_reset_token_expiry, u.token_version, u.auth_method,
-                u.created_at, u.updated_at,
-                COALESCE(
-                    jsonb_agg(
-                        jsonb_build_object(
-                            'id', r.id,
-                            'name', r.name
-                        )
-                    ), '[]'
-                ) AS roles
-            FROM users u
-            LEFT JOIN user_roles ur ON ur.user_id = u.id
-            LEFT JOIN roles r ON r.id = ur.role_id
-            `
-
-	query += ` WHERE id = id`
-	argIndex := 1
-
-	var args []any
+	where, args := buildListWhere(filters)
+
+	columns := filters.SortBy.columns()
+	direction, comparison := "ASC", ">"
+	if filters.SortDesc {
+		direction, comparison = "DESC", "<"
+	}
+
+	if filters.After != nil {
+		values := filters.After.values(filters.SortBy)
+		placeholders := make([]string, len(values))
+		for i, value := range values {
+			args = append(args, value)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		where += fmt.Sprintf(
+			` AND (%s) %s (%s)`,
+			strings.Join(columns, ", "),
+			comparison,
+			strings.Join(placeholders, ", "),
+		)
+	}
+
+	order := make([]string, len(columns))
+	for i, column := range columns {
+		order[i] = column + " " + direction
+	}
+
+	query := selectUserPageColumns + where + `
+            GROUP BY u.id
+            ORDER BY ` + strings.Join(order, ", ")
+
+	if filters.Limit > 0 {
+		args = append(args, filters.Limit)
+		query += fmt.Sprintf(` LIMIT $%d`, len(args))
+	}
+
+	if filters.Offset > 0 && filters.After == nil {
+		args = append(args, filters.Offset)
+		query += fmt.Sprintf(` OFFSET $%d`, len(args))
+	}
+
+	return r.db.QueryContext(ctx, query, args...)
+}
+
+// CountList returns how many users match filters, ignoring pagination.
+func (r *repository) CountList(ctx context.Context, filters ListFilterOptions) (int, error) {
+	where, args := buildListWhere(filters)
+
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM users u`+where, args...).Scan(&count)
+
+	return count, err
+}
+
+// buildListWhere filters roles with EXISTS rather than on the joined rows,
+// so the roles aggregated for each user stay complete.
+func buildListWhere(filters ListFilterOptions) (string, []any) {
+	where := `
+            WHERE 1=1`
+	args := []any{}
+
+	if !filters.IncludeDeleted {
+		where += ` AND u.deleted_at IS NULL`
+	}
+
+	if search := strings.TrimSpace(filters.Search); search != "" {
+		args = append(args, "%"+escapeLike(search)+"%")
+		n := len(args)
+		where += fmt.Sprintf(
+			` AND ((u.first_name || ' ' || COALESCE(u.last_name, '')) ILIKE $%d OR u.email ILIKE $%d OR u.username ILIKE $%d)`,
+			n, n, n,
+		)
+	}
 
 	if filters.IsActive != nil {
-		query += ` AND is_active = $` + fmt.Sprintf("%d", argIndex)
 		args = append(args, *filters.IsActive)
-		argIndex++
+		where += fmt.Sprintf(` AND u.is_active = $%d`, len(args))
 	}
 
 	if filters.VerifiedEmail != nil {
-		query += ` AND verified_email = $` + fmt.Sprintf("%d", argIndex)
 		args = append(args, *filters.VerifiedEmail)
-		argIndex++
+		where += fmt.Sprintf(` AND u.verified_email = $%d`, len(args))
 	}
 
 	if filters.RoleID != "" {
-		query += ` AND role_id = $` + fmt.Sprintf("%d", argIndex)
 		args = append(args, filters.RoleID)
-		argIndex++
+		where += fmt.Sprintf(
+			` AND EXISTS (SELECT 1 FROM user_roles fur WHERE fur.user_id = u.id AND fur.role_id = $%d)`,
+			len(args),
+		)
 	}
 
-	if !filters.CreatedAt.IsZero() {
-		query += ` AND created_at = $` + fmt.Sprintf("%d", argIndex)
-		args = append(args, filters.CreatedAt)
-		argIndex++
+	if filters.RoleName != "" {
+		args = append(args, filters.RoleName)
+		where += fmt.Sprintf(
+			` AND EXISTS (SELECT 1 FROM user_roles fur JOIN roles fr ON fr.id = fur.role_id WHERE fur.user_id = u.id AND fr.name = $%d)`,
+			len(args),
+		)
 	}
 
-	if filters.Limit > 0 {
-		query += ` LIMIT $` + fmt.Sprintf("%d", argIndex)
-		args = append(args, filters.Limit)
-		argIndex++
+	if filters.AuthMethod != "" {
+		args = append(args, filters.AuthMethod)
+		where += fmt.Sprintf(` AND u.auth_method = $%d`, len(args))
 	}
 
-	if filters.Offset > 0 {
-		query += ` OFFSET $` + fmt.Sprintf("%d", argIndex)
-		args = append(args, filters.Offset)
-		argIndex++
+	if !filters.CreatedFrom.IsZero() {
+		args = append(args, filters.CreatedFrom.UTC())
+		where += fmt.Sprintf(` AND u.created_at >= $%d`, len(args))
 	}
 
-	query += ` GROUP BY 
-		u.id, u.first_name, u.last_name, 
-		u.username, u.email, u.password, 
-		u.phone_number, u.picture, u.address, 
-		u.is_active, u.verified_email, 
-		u.verified_email_token, u.verified_email_token_expiry, 
-		u.password_reset_token, u.password_reset_token_expiry, 
-		u.created_at, u.updated_at`
-
-	rows, err := r.db.QueryContext(ctx, query, args...)
-	if err != nil {
-		return nil, err
+	if !filters.CreatedTo.IsZero() {
+		args = append(args, filters.CreatedTo.UTC())
+		where += fmt.Sprintf(` AND u.created_at < $%d`, len(args))
 	}
 
-	return rows, nil
+	return where, args
+}
+
+// escapeLike escapes the LIKE wildcards in s so it matches literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
 }
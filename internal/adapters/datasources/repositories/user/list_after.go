@@ -0,0 +1,54 @@
+package user
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// userPageColumns are the columns scanUsers reads, with roles aggregated
+// per user from userPageJoins. Callers add WHERE, GROUP BY u.id and
+// ordering.
+const userPageColumns = `
+                u.id, u.first_name, u.last_name, u.username,
+                u.email, u.password, u.phone_number, u.picture, u.address,
+                u.is_active, u.verified_email, u.verified_phone, u.verified_email_token,
+                u.verified_email_token_expiry, u.password_reset_token,
+                u.password_reset_token_expiry, u.token_version, u.auth_method,
+                u.created_at, u.updated_at,
+                u.suspended_at, u.suspended_until, u.suspension_reason, u.deleted_at,
+                COALESCE(
+                    jsonb_agg(
+                        jsonb_build_object(
+                            'id', r.id,
+                            'name', r.name
+                        )
+                    ) FILTER (WHERE r.id IS NOT NULL), '[]'
+                ) AS roles`
+
+const userPageJoins = `
+            LEFT JOIN user_roles ur ON ur.user_id = u.id
+            LEFT JOIN roles r ON r.id = ur.role_id`
+
+const selectUserPageColumns = `SELECT` + userPageColumns + `
+            FROM users u` + userPageJoins
+
+// ListAfter returns up to limit users whose ID sorts after afterID, in ID
+// order, skipping soft-deleted ones. Paging by key keeps a long export
+// stable while users are being added.
+func (r *repository) ListAfter(ctx context.Context, afterID string, limit int) ([]*domain.User, error) {
+	query := selectUserPageColumns + `
+            WHERE u.id > $1 AND u.deleted_at IS NULL
+            GROUP BY u.id
+            ORDER BY u.id
+            LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	return scanUsers(rows)
+}
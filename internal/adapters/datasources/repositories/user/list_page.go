@@ -0,0 +1,38 @@
+package user
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// ListPage returns up to limit users of the SCIM tenant that are not
+// deleted, oldest first, skipping the first offset.
+func (r *repository) ListPage(ctx context.Context, tenant string, offset, limit int) ([]*domain.User, error) {
+	query := selectUserPageColumns + `
+            WHERE u.deleted_at IS NULL AND u.scim_tenant = $1
+            GROUP BY u.id
+            ORDER BY u.created_at, u.id
+            LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.QueryContext(ctx, query, tenant, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	return scanUsers(rows)
+}
+
+// Count returns how many users of the SCIM tenant are not deleted.
+func (r *repository) Count(ctx context.Context, tenant string) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*) FROM users WHERE deleted_at IS NULL AND scim_tenant = $1`,
+		tenant,
+	).Scan(&count)
+
+	return count, err
+}
@@ -0,0 +1,78 @@
+package user
+
+import (
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// ListSortField is a column List can order by. Ties are broken by ID, so
+// the order is total and pages can continue from a ListCursor.
+type ListSortField string
+
+const (
+	ListSortCreatedAt ListSortField = "created_at"
+	ListSortName      ListSortField = "name"
+	ListSortEmail     ListSortField = "email"
+)
+
+// Valid reports whether f is a known field. The empty field sorts by
+// creation time.
+func (f ListSortField) Valid() bool {
+	switch f {
+	case "", ListSortCreatedAt, ListSortName, ListSortEmail:
+		return true
+	}
+	return false
+}
+
+// columns returns the sort key. last_name is nullable and compared as "",
+// as the cursor stores it, so the keyset comparison never sees a NULL.
+func (f ListSortField) columns() []string {
+	switch f {
+	case ListSortName:
+		return []string{"u.first_name", "COALESCE(u.last_name, '')", "u.id"}
+	case ListSortEmail:
+		return []string{"u.email", "u.id"}
+	default:
+		return []string{"u.created_at", "u.id"}
+	}
+}
+
+// ListCursor holds the sort key of the last user of a page. Only the
+// fields of the sort it was made for are used.
+type ListCursor struct {
+	ID        string    `json:"id"`
+	FirstName string    `json:"first_name,omitempty"`
+	LastName  string    `json:"last_name,omitempty"`
+	Email     string    `json:"email,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitzero"`
+}
+
+// NewListCursor returns the cursor that continues after user.
+func NewListCursor(user *domain.User, sortBy ListSortField) ListCursor {
+	cursor := ListCursor{ID: user.ID}
+
+	switch sortBy {
+	case ListSortName:
+		cursor.FirstName = user.FirstName
+		cursor.LastName = user.LastName
+	case ListSortEmail:
+		cursor.Email = user.Email
+	default:
+		cursor.CreatedAt = user.CreatedAt
+	}
+
+	return cursor
+}
+
+func (c ListCursor) values(sortBy ListSortField) []any {
+	switch sortBy {
+	case ListSortName:
+		return []any{c.FirstName, c.LastName, c.ID}
+	case ListSortEmail:
+		return []any{c.Email, c.ID}
+	default:
+		return []any{c.CreatedAt.UTC(), c.ID}
+	}
+}
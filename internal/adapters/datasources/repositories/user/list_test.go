@@ -0,0 +1,119 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+)
+
+func TestRepository_List(t *testing.T) {
+	active := true
+	createdAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		filters   user.ListFilterOptions
+		prepare   func(mock sqlmock.Sqlmock)
+		expectErr error
+	}{
+		"when listing newest first with offset": {
+			filters: user.ListFilterOptions{SortDesc: true, Limit: 10, Offset: 20},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`WHERE 1=1 AND u.deleted_at IS NULL\s+GROUP BY u.id\s+ORDER BY u.created_at DESC, u.id DESC LIMIT \$1 OFFSET \$2`).
+					WithArgs(10, 20).
+					WillReturnRows(sqlmock.NewRows(nil))
+			},
+		},
+		"when searching and filtering by role": {
+			filters: user.ListFilterOptions{
+				Search:   "50%_off",
+				IsActive: &active,
+				RoleName: "admin",
+				SortBy:   user.ListSortEmail,
+				Limit:    5,
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`\(\(u.first_name \|\| ' ' \|\| COALESCE\(u.last_name, ''\)\) ILIKE \$1 OR u.email ILIKE \$1 OR u.username ILIKE \$1\) AND u.is_active = \$2 AND EXISTS \(SELECT 1 FROM user_roles fur JOIN roles fr ON fr.id = fur.role_id WHERE fur.user_id = u.id AND fr.name = \$3\).*ORDER BY u.email ASC, u.id ASC LIMIT \$4`).
+					WithArgs(`%50\%\_off%`, true, "admin", 5).
+					WillReturnRows(sqlmock.NewRows(nil))
+			},
+		},
+		"when continuing from a cursor the offset is ignored": {
+			filters: user.ListFilterOptions{
+				SortDesc: true,
+				After:    &user.ListCursor{ID: "user-9", CreatedAt: createdAt},
+				Limit:    10,
+				Offset:   20,
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`AND \(u.created_at, u.id\) < \(\$1, \$2\)\s+GROUP BY u.id\s+ORDER BY u.created_at DESC, u.id DESC LIMIT \$3$`).
+					WithArgs(createdAt, "user-9", 10).
+					WillReturnRows(sqlmock.NewRows(nil))
+			},
+		},
+		"when continuing a name sort past a user without last name": {
+			filters: user.ListFilterOptions{
+				SortBy: user.ListSortName,
+				After:  &user.ListCursor{ID: "user-9", FirstName: "Ana"},
+				Limit:  10,
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`AND \(u.first_name, COALESCE\(u.last_name, ''\), u.id\) > \(\$1, \$2, \$3\)\s+GROUP BY u.id\s+ORDER BY u.first_name ASC, COALESCE\(u.last_name, ''\) ASC, u.id ASC LIMIT \$4$`).
+					WithArgs("Ana", "", "user-9", 10).
+					WillReturnRows(sqlmock.NewRows(nil))
+			},
+		},
+		"when the query fails": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT`).WillReturnError(errors.New("database error"))
+			},
+			expectErr: errors.New("database error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tt.prepare(mock)
+
+			repository := user.NewRepository(db)
+			_, err = repository.List(context.Background(), tt.filters)
+
+			assert.Equal(t, tt.expectErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_CountList(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM users u\s+WHERE 1=1 AND u.auth_method = \$1 AND u.created_at >= \$2$`).
+		WithArgs("google", from).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+	repository := user.NewRepository(db)
+	count, err := repository.CountList(context.Background(), user.ListFilterOptions{
+		AuthMethod:     "google",
+		CreatedFrom:    from,
+		IncludeDeleted: true,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 7, count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
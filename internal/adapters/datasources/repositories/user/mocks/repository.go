@@ -7,6 +7,7 @@ package mock_user
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 	user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
@@ -94,20 +95,6 @@ func (mr *MockRepositoryMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRepository)(nil).Get), arg0, arg1)
 }
 
-// InvalidatePasswordResetToken mocks base method.
-func (m *MockRepository) InvalidatePasswordResetToken(ctx context.Context, id string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InvalidatePasswordResetToken", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// InvalidatePasswordResetToken indicates an expected call of InvalidatePasswordResetToken.
-func (mr *MockRepositoryMockRecorder) InvalidatePasswordResetToken(ctx, id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidatePasswordResetToken", reflect.TypeOf((*MockRepository)(nil).InvalidatePasswordResetToken), ctx, id)
-}
-
 // List mocks base method.
 func (m *MockRepository) List(arg0 context.Context, arg1 user.ListFilterOptions) ([]*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -124,7 +111,7 @@ func (mr *MockRepositoryMockRecorder) List(arg0, arg1 interface{}) *gomock.Call
 }
 
 // Update mocks base method.
-func (m *MockRepository) Update(arg0 context.Context, arg1 string, arg2 *domain.User) (string, error) {
+func (m *MockRepository) Update(arg0 context.Context, arg1 string, arg2 user.UpdateFields) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
 	ret0, _ := ret[0].(string)
@@ -137,3 +124,204 @@ func (mr *MockRepositoryMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), arg0, arg1, arg2)
 }
+
+// ChangeEmail mocks base method.
+func (m *MockRepository) ChangeEmail(arg0 context.Context, arg1 string, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeEmail", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeEmail indicates an expected call of ChangeEmail.
+func (mr *MockRepositoryMockRecorder) ChangeEmail(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeEmail", reflect.TypeOf((*MockRepository)(nil).ChangeEmail), arg0, arg1, arg2)
+}
+
+// IncrementTokenVersion mocks base method.
+func (m *MockRepository) IncrementTokenVersion(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementTokenVersion", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementTokenVersion indicates an expected call of IncrementTokenVersion.
+func (mr *MockRepositoryMockRecorder) IncrementTokenVersion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementTokenVersion", reflect.TypeOf((*MockRepository)(nil).IncrementTokenVersion), arg0, arg1)
+}
+
+// VerifyPhone mocks base method.
+func (m *MockRepository) VerifyPhone(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyPhone", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyPhone indicates an expected call of VerifyPhone.
+func (mr *MockRepositoryMockRecorder) VerifyPhone(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyPhone", reflect.TypeOf((*MockRepository)(nil).VerifyPhone), arg0, arg1, arg2)
+}
+
+// Suspend mocks base method.
+func (m *MockRepository) Suspend(arg0 context.Context, arg1, arg2 string, arg3 *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspend", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Suspend indicates an expected call of Suspend.
+func (mr *MockRepositoryMockRecorder) Suspend(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockRepository)(nil).Suspend), arg0, arg1, arg2, arg3)
+}
+
+// Reactivate mocks base method.
+func (m *MockRepository) Reactivate(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reactivate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reactivate indicates an expected call of Reactivate.
+func (mr *MockRepositoryMockRecorder) Reactivate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reactivate", reflect.TypeOf((*MockRepository)(nil).Reactivate), arg0, arg1)
+}
+
+// SoftDelete mocks base method.
+func (m *MockRepository) SoftDelete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockRepositoryMockRecorder) SoftDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockRepository)(nil).SoftDelete), arg0, arg1)
+}
+
+// ListAfter mocks base method.
+func (m *MockRepository) ListAfter(ctx context.Context, afterID string, limit int) ([]*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAfter", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAfter indicates an expected call of ListAfter.
+func (mr *MockRepositoryMockRecorder) ListAfter(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAfter", reflect.TypeOf((*MockRepository)(nil).ListAfter), ctx, afterID, limit)
+}
+
+// ListPage mocks base method.
+func (m *MockRepository) ListPage(ctx context.Context, tenant string, offset, limit int) ([]*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPage", ctx, tenant, offset, limit)
+	ret0, _ := ret[0].([]*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPage indicates an expected call of ListPage.
+func (mr *MockRepositoryMockRecorder) ListPage(ctx, tenant, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPage", reflect.TypeOf((*MockRepository)(nil).ListPage), ctx, tenant, offset, limit)
+}
+
+// ConsumePasswordResetToken mocks base method.
+func (m *MockRepository) ConsumePasswordResetToken(ctx context.Context, id, tokenHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumePasswordResetToken", ctx, id, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsumePasswordResetToken indicates an expected call of ConsumePasswordResetToken.
+func (mr *MockRepositoryMockRecorder) ConsumePasswordResetToken(ctx, id, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumePasswordResetToken", reflect.TypeOf((*MockRepository)(nil).ConsumePasswordResetToken), ctx, id, tokenHash)
+}
+
+// ConsumeVerifiedEmailToken mocks base method.
+func (m *MockRepository) ConsumeVerifiedEmailToken(ctx context.Context, id, tokenHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeVerifiedEmailToken", ctx, id, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsumeVerifiedEmailToken indicates an expected call of ConsumeVerifiedEmailToken.
+func (mr *MockRepositoryMockRecorder) ConsumeVerifiedEmailToken(ctx, id, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeVerifiedEmailToken", reflect.TypeOf((*MockRepository)(nil).ConsumeVerifiedEmailToken), ctx, id, tokenHash)
+}
+
+// Count mocks base method.
+func (m *MockRepository) Count(ctx context.Context, tenant string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, tenant)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockRepositoryMockRecorder) Count(ctx, tenant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRepository)(nil).Count), ctx, tenant)
+}
+
+// SetSCIMTenant mocks base method.
+func (m *MockRepository) SetSCIMTenant(ctx context.Context, id, tenant string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSCIMTenant", ctx, id, tenant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSCIMTenant indicates an expected call of SetSCIMTenant.
+func (mr *MockRepositoryMockRecorder) SetSCIMTenant(ctx, id, tenant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSCIMTenant", reflect.TypeOf((*MockRepository)(nil).SetSCIMTenant), ctx, id, tenant)
+}
+
+// CountList mocks base method.
+func (m *MockRepository) CountList(arg0 context.Context, arg1 user.ListFilterOptions) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountList", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountList indicates an expected call of CountList.
+func (mr *MockRepositoryMockRecorder) CountList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountList", reflect.TypeOf((*MockRepository)(nil).CountList), arg0, arg1)
+}
+
+// Search mocks base method.
+func (m *MockRepository) Search(arg0 context.Context, arg1 user.SearchOptions) ([]*user.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].([]*user.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockRepositoryMockRecorder) Search(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockRepository)(nil).Search), arg0, arg1)
+}
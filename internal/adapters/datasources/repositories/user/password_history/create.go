@@ -0,0 +1,22 @@
+package user_password_history
+
+import (
+	"context"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Create(ctx context.Context, entry domain.PasswordHistoryEntry) error {
+	query := `INSERT INTO password_history (id, user_id, password_hash, created_at)
+			VALUES ($1, $2, $3, $4)`
+
+	createdAt := entry.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	_, err := r.db.ExecContext(ctx, query, entry.ID, entry.UserID, entry.PasswordHash, createdAt.UTC())
+
+	return err
+}
@@ -0,0 +1,37 @@
+package user_password_history
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// ListRecent returns up to limit entries for the user, newest first.
+func (r *repository) ListRecent(ctx context.Context, userID string, limit int) ([]domain.PasswordHistoryEntry, error) {
+	query := `SELECT id, password_hash, created_at
+			FROM password_history
+			WHERE user_id = $1
+			ORDER BY created_at DESC
+			LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []domain.PasswordHistoryEntry
+	for rows.Next() {
+		entry := domain.PasswordHistoryEntry{UserID: userID}
+		if err := rows.Scan(&entry.ID, &entry.PasswordHash, &entry.CreatedAt); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
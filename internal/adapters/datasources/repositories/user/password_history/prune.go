@@ -0,0 +1,19 @@
+package user_password_history
+
+import "context"
+
+// Prune deletes all but the keep most recent entries for the user.
+func (r *repository) Prune(ctx context.Context, userID string, keep int) error {
+	query := `DELETE FROM password_history
+			WHERE user_id = $1
+			AND id NOT IN (
+				SELECT id FROM password_history
+				WHERE user_id = $1
+				ORDER BY created_at DESC
+				LIMIT $2
+			)`
+
+	_, err := r.db.ExecContext(ctx, query, userID, keep)
+
+	return err
+}
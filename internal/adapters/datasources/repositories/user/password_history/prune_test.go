@@ -0,0 +1,50 @@
+package user_password_history_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	user_password_history "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/password_history"
+)
+
+func TestRepository_Prune(t *testing.T) {
+	tests := map[string]struct {
+		prepare   func(mock sqlmock.Sqlmock)
+		expectErr error
+	}{
+		"when older entries are pruned": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`DELETE FROM password_history\s+WHERE user_id = \$1\s+AND id NOT IN`).
+					WithArgs("user-1", 5).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+		},
+		"when the query fails": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`DELETE FROM password_history`).WillReturnError(errors.New("database error"))
+			},
+			expectErr: errors.New("database error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tt.prepare(mock)
+
+			repository := user_password_history.NewRepository(db)
+			err = repository.Prune(context.Background(), "user-1", 5)
+
+			assert.Equal(t, tt.expectErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
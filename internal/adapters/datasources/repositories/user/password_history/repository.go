@@ -0,0 +1,26 @@
+package user_password_history
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	Repository interface {
+		Create(context.Context, domain.PasswordHistoryEntry) error
+		ListRecent(ctx context.Context, userID string, limit int) ([]domain.PasswordHistoryEntry, error)
+		Prune(ctx context.Context, userID string, keep int) error
+	}
+
+	repository struct {
+		db datasources.DBTX
+	}
+)
+
+func NewRepository(db datasources.DBTX) Repository {
+	return &repository{
+		db: db,
+	}
+}
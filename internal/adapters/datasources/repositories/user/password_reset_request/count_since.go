@@ -0,0 +1,23 @@
+package user_password_reset_request
+
+import (
+	"context"
+	"time"
+)
+
+func (r *repository) CountByEmailSince(ctx context.Context, email string, since time.Time) (int, error) {
+	return r.countSince(ctx, `SELECT COUNT(*) FROM password_reset_requests WHERE email = $1 AND created_at >= $2`, email, since)
+}
+
+func (r *repository) CountByIPSince(ctx context.Context, ip string, since time.Time) (int, error) {
+	return r.countSince(ctx, `SELECT COUNT(*) FROM password_reset_requests WHERE ip = $1 AND created_at >= $2`, ip, since)
+}
+
+func (r *repository) countSince(ctx context.Context, query string, value string, since time.Time) (int, error) {
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, value, since.UTC()).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,21 @@
+package user_password_reset_request
+
+import (
+	"context"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Create(ctx context.Context, request domain.PasswordResetRequest) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO password_reset_requests (id, email, ip, created_at) VALUES ($1, $2, $3, $4)`,
+		request.ID,
+		request.Email,
+		request.IP,
+		time.Now().UTC(),
+	)
+
+	return err
+}
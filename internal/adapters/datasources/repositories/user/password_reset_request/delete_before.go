@@ -0,0 +1,17 @@
+package user_password_reset_request
+
+import (
+	"context"
+	"time"
+)
+
+// DeleteBefore removes requests older than before, which no longer count
+// towards any limit, and returns how many were removed.
+func (r *repository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM password_reset_requests WHERE created_at < $1`, before.UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,28 @@
+package user_password_reset_request
+
+import (
+	"context"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	Repository interface {
+		Create(context.Context, domain.PasswordResetRequest) error
+		CountByEmailSince(ctx context.Context, email string, since time.Time) (int, error)
+		CountByIPSince(ctx context.Context, ip string, since time.Time) (int, error)
+		DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+	}
+
+	repository struct {
+		db datasources.DBTX
+	}
+)
+
+func NewRepository(db datasources.DBTX) Repository {
+	return &repository{
+		db: db,
+	}
+}
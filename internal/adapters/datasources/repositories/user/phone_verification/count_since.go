@@ -0,0 +1,21 @@
+package user_phone_verification
+
+import (
+	"context"
+	"time"
+)
+
+func (r *repository) CountSince(ctx context.Context, userID string, since time.Time) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*) FROM phone_verifications WHERE user_id = $1 AND created_at >= $2`,
+		userID,
+		since.UTC(),
+	).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
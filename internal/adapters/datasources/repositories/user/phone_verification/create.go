@@ -0,0 +1,45 @@
+package user_phone_verification
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Create(ctx context.Context, verification domain.PhoneVerification) (string, error) {
+	row, err := r.executeCreateQuery(ctx, verification)
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	if err := row.Scan(&id); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (r *repository) executeCreateQuery(ctx context.Context, verification domain.PhoneVerification) (*sql.Row, error) {
+	query := `INSERT INTO phone_verifications (id, user_id, phone_number, code_hash, expires_at, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id`
+
+	args := []any{
+		verification.ID,
+		verification.UserID,
+		verification.PhoneNumber,
+		verification.CodeHash,
+		verification.ExpiresAt.UTC(),
+		time.Now().UTC(),
+	}
+
+	row := r.db.QueryRowContext(ctx, query, args...)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return row, nil
+}
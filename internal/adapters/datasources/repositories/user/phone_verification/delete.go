@@ -0,0 +1,8 @@
+package user_phone_verification
+
+import "context"
+
+func (r *repository) Delete(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM phone_verifications WHERE id = $1`, id)
+	return err
+}
@@ -0,0 +1,57 @@
+package user_phone_verification
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// GetLatest returns the most recent code sent to the user, verified or not.
+func (r *repository) GetLatest(ctx context.Context, userID string) (*domain.PhoneVerification, error) {
+	row, err := r.executeGetLatestQuery(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		id, phoneNumber, codeHash string
+		attempts                  int
+		expiresAt, createdAt      time.Time
+		verifiedAt                *time.Time
+	)
+
+	if err := row.Scan(&id, &phoneNumber, &codeHash, &attempts, &expiresAt, &verifiedAt, &createdAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &domain.PhoneVerification{
+		ID:          id,
+		UserID:      userID,
+		PhoneNumber: phoneNumber,
+		CodeHash:    codeHash,
+		Attempts:    attempts,
+		ExpiresAt:   expiresAt,
+		VerifiedAt:  verifiedAt,
+		CreatedAt:   createdAt,
+	}, nil
+}
+
+func (r *repository) executeGetLatestQuery(ctx context.Context, userID string) (*sql.Row, error) {
+	query := `SELECT id, phone_number, code_hash, attempts, expires_at, verified_at, created_at
+			FROM phone_verifications
+			WHERE user_id = $1
+			ORDER BY created_at DESC
+			LIMIT 1`
+
+	row := r.db.QueryRowContext(ctx, query, userID)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return row, nil
+}
@@ -0,0 +1,19 @@
+package user_phone_verification
+
+import "context"
+
+// IncrementAttempts records a wrong guess and returns the new count. The
+// update is atomic, so concurrent guesses cannot exceed the limit unseen.
+func (r *repository) IncrementAttempts(ctx context.Context, id string) (int, error) {
+	var attempts int
+	err := r.db.QueryRowContext(
+		ctx,
+		`UPDATE phone_verifications SET attempts = attempts + 1 WHERE id = $1 RETURNING attempts`,
+		id,
+	).Scan(&attempts)
+	if err != nil {
+		return 0, err
+	}
+
+	return attempts, nil
+}
@@ -0,0 +1,53 @@
+package user_phone_verification_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	user_phone_verification "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/phone_verification"
+)
+
+func TestRepository_IncrementAttempts(t *testing.T) {
+	tests := map[string]struct {
+		prepare   func(mock sqlmock.Sqlmock)
+		expect    int
+		expectErr error
+	}{
+		"when the attempt is counted": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE phone_verifications SET attempts = attempts \+ 1 WHERE id = \$1 RETURNING attempts`).
+					WithArgs("verification-1").
+					WillReturnRows(sqlmock.NewRows([]string{"attempts"}).AddRow(3))
+			},
+			expect: 3,
+		},
+		"when the query fails": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE phone_verifications`).WillReturnError(errors.New("database error"))
+			},
+			expectErr: errors.New("database error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tt.prepare(mock)
+
+			repository := user_phone_verification.NewRepository(db)
+			attempts, err := repository.IncrementAttempts(context.Background(), "verification-1")
+
+			assert.Equal(t, tt.expect, attempts)
+			assert.Equal(t, tt.expectErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
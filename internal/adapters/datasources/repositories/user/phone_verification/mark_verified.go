@@ -0,0 +1,32 @@
+package user_phone_verification
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// MarkVerified consumes a code. It returns sql.ErrNoRows when the code does
+// not exist or was already used.
+func (r *repository) MarkVerified(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE phone_verifications SET verified_at = $1 WHERE id = $2 AND verified_at IS NULL`,
+		time.Now().UTC(),
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package user_phone_verification
+
+import (
+	"context"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	Repository interface {
+		Create(context.Context, domain.PhoneVerification) (string, error)
+		GetLatest(ctx context.Context, userID string) (*domain.PhoneVerification, error)
+		CountSince(ctx context.Context, userID string, since time.Time) (int, error)
+		IncrementAttempts(ctx context.Context, id string) (int, error)
+		MarkVerified(ctx context.Context, id string) error
+		Delete(ctx context.Context, id string) error
+	}
+
+	repository struct {
+		db datasources.DBTX
+	}
+)
+
+func NewRepository(db datasources.DBTX) Repository {
+	return &repository{
+		db: db,
+	}
+}
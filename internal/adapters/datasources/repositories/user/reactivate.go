@@ -0,0 +1,38 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Reactivate lifts a suspension. It returns sql.ErrNoRows when the user
+// does not exist or was deleted.
+func (r *repository) Reactivate(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE users SET
+			is_active = TRUE,
+			suspended_at = NULL,
+			suspended_until = NULL,
+			suspension_reason = NULL,
+			updated_at = $1
+		WHERE id = $2 AND deleted_at IS NULL`,
+		time.Now().UTC(),
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
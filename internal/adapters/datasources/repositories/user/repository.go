@@ -2,9 +2,9 @@ package user
 
 import (
 	"context"
-	"database/sql"
 	"time"
 
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
 	"github.com/tapiaw38/auth-api-be/internal/domain"
 )
 
@@ -12,32 +12,105 @@ type (
 	Repository interface {
 		Create(context.Context, domain.User) (string, error)
 		Get(context.Context, GetFilterOptions) (*domain.User, error)
-		Update(context.Context, string, *domain.User) (string, error)
+		Update(context.Context, string, UpdateFields) (string, error)
 		Delete(context.Context, string) error
 		List(context.Context, ListFilterOptions) ([]*domain.User, error)
+		CountList(context.Context, ListFilterOptions) (int, error)
+		Search(context.Context, SearchOptions) ([]*SearchResult, error)
+		ListAfter(ctx context.Context, afterID string, limit int) ([]*domain.User, error)
+		ListPage(ctx context.Context, tenant string, offset, limit int) ([]*domain.User, error)
+		Count(ctx context.Context, tenant string) (int, error)
+		SetSCIMTenant(ctx context.Context, id string, tenant string) error
 		ChangePassword(ctx context.Context, id string, password string) error
-		InvalidatePasswordResetToken(ctx context.Context, id string) error
+		ConsumePasswordResetToken(ctx context.Context, id string, tokenHash string) error
+		ConsumeVerifiedEmailToken(ctx context.Context, id string, tokenHash string) error
+		ChangeEmail(ctx context.Context, id string, email string) error
+		IncrementTokenVersion(ctx context.Context, id string) error
+		VerifyPhone(ctx context.Context, id string, phoneNumber string) error
+		Suspend(ctx context.Context, id string, reason string, until *time.Time) error
+		Reactivate(ctx context.Context, id string) error
+		SoftDelete(ctx context.Context, id string) error
 	}
 
 	repository struct {
-		db *sql.DB
+		db datasources.DBTX
 	}
 
+	// GetFilterOptions matches VerifiedEmailToken and PasswordResetToken
+	// against the stored hashes, see auth.HashOneTimeToken.
 	GetFilterOptions struct {
 		ID                 string
 		Username           string
 		Email              string
 		VerifiedEmailToken string
 		PasswordResetToken string
+		// SCIMTenant only matches users provisioned through SCIM by that
+		// tenant.
+		SCIMTenant string
+		// IncludeDeleted also matches soft-deleted users, which are
+		// skipped by default.
+		IncludeDeleted bool
 	}
 
+	// ListFilterOptions narrows and orders List. Search matches part of
+	// the name, email or username, ignoring case. CreatedFrom is
+	// inclusive and CreatedTo exclusive. After continues from the user a
+	// previous page ended with and must come from the same SortBy and
+	// SortDesc; Offset is ignored when it is set.
 	ListFilterOptions struct {
+		Search        string
 		IsActive      *bool
 		VerifiedEmail *bool
 		RoleID        string
-		CreatedAt     time.Time
+		RoleName      string
+		AuthMethod    string
+		CreatedFrom   time.Time
+		CreatedTo     time.Time
+		SortBy        ListSortField
+		SortDesc      bool
+		After         *ListCursor
 		Limit         int
 		Offset        int
+		// IncludeDeleted also lists soft-deleted users, which are
+		// skipped by default.
+		IncludeDeleted bool
+	}
+
+	// SearchOptions is a free-text search over users that are not
+	// deleted. Query is matched against names, emails and usernames by
+	// word prefix and by trigram similarity, so misspellings still match,
+	// and against the digits of phone numbers.
+	SearchOptions struct {
+		Query string
+		Limit int
+	}
+
+	// SearchResult is a user found by Search. Highlight is HTML: the
+	// user's name and email, escaped, with the matched words in <mark>.
+	SearchResult struct {
+		User      *domain.User
+		Rank      float64
+		Highlight string
+	}
+
+	// UpdateFields lists the columns to change. Nil fields are left
+	// untouched; an empty PhoneNumber, Picture, Address or token, or a zero
+	// token expiry, clears the column.
+	UpdateFields struct {
+		FirstName                *string
+		LastName                 *string
+		Email                    *string
+		Password                 *string
+		PhoneNumber              *string
+		Picture                  *string
+		Address                  *string
+		IsActive                 *bool
+		VerifiedEmail            *bool
+		VerifiedEmailToken       *string
+		VerifiedEmailTokenExpiry *time.Time
+		PasswordResetToken       *string
+		PasswordResetTokenExpiry *time.Time
+		AuthMethod               *string
 	}
 
 	RoleJSON struct {
@@ -46,7 +119,7 @@ type (
 	}
 )
 
-func NewRepository(db *sql.DB) Repository {
+func NewRepository(db datasources.DBTX) Repository {
 	return &repository{
 		db: db,
 	}
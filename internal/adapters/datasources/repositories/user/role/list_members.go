@@ -0,0 +1,48 @@
+package user_role
+
+import "context"
+
+// ListMembers returns the users of the SCIM tenant that hold roleID and are
+// not deleted, in ID order.
+func (r *repository) ListMembers(ctx context.Context, roleID string, tenant string) ([]Member, error) {
+	query := `SELECT u.id, u.email
+			FROM user_roles ur
+			JOIN users u ON u.id = ur.user_id
+			WHERE ur.role_id = $1 AND u.scim_tenant = $2 AND u.deleted_at IS NULL
+			ORDER BY u.id`
+
+	return r.listMembers(ctx, query, roleID, tenant)
+}
+
+// ListHolders returns every user holding roleID, whichever tenant
+// provisioned them and including deleted users, in ID order.
+func (r *repository) ListHolders(ctx context.Context, roleID string) ([]Member, error) {
+	query := `SELECT u.id, u.email
+			FROM user_roles ur
+			JOIN users u ON u.id = ur.user_id
+			WHERE ur.role_id = $1
+			ORDER BY u.id`
+
+	return r.listMembers(ctx, query, roleID)
+}
+
+func (r *repository) listMembers(ctx context.Context, query string, args ...any) ([]Member, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var members []Member
+	for rows.Next() {
+		var member Member
+		if err := rows.Scan(&member.UserID, &member.Email); err != nil {
+			return nil, err
+		}
+
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
@@ -2,8 +2,8 @@ package user_role
 
 import (
 	"context"
-	"database/sql"
 
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
 	"github.com/tapiaw38/auth-api-be/internal/domain"
 )
 
@@ -11,14 +11,22 @@ type (
 	Repository interface {
 		Create(context.Context, domain.UserRole) (*domain.UserRole, error)
 		Delete(context.Context, string, string) (*domain.UserRole, error)
+		ListMembers(ctx context.Context, roleID string, tenant string) ([]Member, error)
+		ListHolders(ctx context.Context, roleID string) ([]Member, error)
+	}
+
+	// Member is a user holding a role.
+	Member struct {
+		UserID string
+		Email  string
 	}
 
 	repository struct {
-		db *sql.DB
+		db datasources.DBTX
 	}
 )
 
-func NewRepository(db *sql.DB) Repository {
+func NewRepository(db datasources.DBTX) Repository {
 	return &repository{
 		db: db,
 	}
@@ -0,0 +1,128 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+)
+
+// highlightStart and highlightStop delimit the matched words in the
+// headline Postgres builds. They are private use characters, which names
+// and emails don't hold, so the rest of the headline can be escaped
+// before they become <mark> tags.
+const (
+	highlightStart = "\uE000"
+	highlightStop  = "\uE001"
+)
+
+// minPhoneDigits is how many digits a query needs before it is also
+// matched against phone numbers.
+const minPhoneDigits = 3
+
+// Search ranks word matches by ts_rank and adds the best trigram word
+// similarity of the name or email, so close misspellings still sort
+// after exact matches. last_name is nullable, so it is read through
+// coalesce as in the users_name_trgm_idx expression. Limit must be
+// positive.
+func (r *repository) Search(ctx context.Context, options SearchOptions) ([]*SearchResult, error) {
+	query := `SELECT` + userPageColumns + `,
+                m.rank,
+                ts_headline(
+                    'simple',
+                    u.first_name || ' ' || coalesce(u.last_name, '') || ' ' || u.email,
+                    to_tsquery('simple', $2),
+                    $4
+                ) AS headline
+            FROM (
+                SELECT u.id,
+                    ts_rank(u.search_vector, to_tsquery('simple', $2)) + GREATEST(
+                        word_similarity($1, u.first_name || ' ' || coalesce(u.last_name, '')),
+                        word_similarity($1, u.email)
+                    ) AS rank
+                FROM users u
+                WHERE u.deleted_at IS NULL AND (
+                    u.search_vector @@ to_tsquery('simple', $2)
+                    OR $1 <% (u.first_name || ' ' || coalesce(u.last_name, ''))
+                    OR $1 <% u.email
+                    OR ($3::text <> '' AND regexp_replace(u.phone_number, '[^0-9]', '', 'g') LIKE $3)
+                )
+                ORDER BY rank DESC, u.id
+                LIMIT $5
+            ) m
+            JOIN users u ON u.id = m.id` + userPageJoins + `
+            GROUP BY u.id, m.rank
+            ORDER BY m.rank DESC, u.id`
+
+	text := strings.TrimSpace(options.Query)
+	headlineOptions := fmt.Sprintf(`StartSel="%s", StopSel="%s", HighlightAll=true`, highlightStart, highlightStop)
+
+	rows, err := r.db.QueryContext(ctx, query,
+		text,
+		prefixQuery(text),
+		phonePattern(text),
+		headlineOptions,
+		options.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var results []*SearchResult
+	for rows.Next() {
+		var rank float64
+		var headline string
+
+		user, err := scanUser(rows, &rank, &headline)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, &SearchResult{
+			User:      user,
+			Rank:      rank,
+			Highlight: highlightHTML(headline),
+		})
+	}
+
+	return results, rows.Err()
+}
+
+// prefixQuery turns text into a tsquery matching each of its words as a
+// prefix. Only letters and digits are kept, so the result always parses.
+func prefixQuery(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+
+	return strings.Join(words, " & ")
+}
+
+// phonePattern returns a LIKE pattern for the digits of text, or "" when
+// it has too few of them to be a phone number fragment.
+func phonePattern(text string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, text)
+	if len(digits) < minPhoneDigits {
+		return ""
+	}
+
+	return "%" + digits + "%"
+}
+
+func highlightHTML(headline string) string {
+	return strings.NewReplacer(
+		highlightStart, "<mark>",
+		highlightStop, "</mark>",
+	).Replace(html.EscapeString(headline))
+}
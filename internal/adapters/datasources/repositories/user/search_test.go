@@ -0,0 +1,122 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+)
+
+func TestRepository_Search(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	columns := []string{
+		"id", "first_name", "last_name", "username", "email", "password",
+		"phone_number", "picture", "address", "is_active", "verified_email",
+		"verified_phone", "verified_email_token", "verified_email_token_expiry",
+		"password_reset_token", "password_reset_token_expiry", "token_version",
+		"auth_method", "created_at", "updated_at", "suspended_at",
+		"suspended_until", "suspension_reason", "deleted_at", "roles",
+		"rank", "headline",
+	}
+
+	tests := map[string]struct {
+		query     string
+		prepare   func(mock sqlmock.Sqlmock)
+		expect    []*user.SearchResult
+		expectErr error
+	}{
+		"when words match by prefix and the headline is escaped": {
+			query: "  Jane O'Sm  ",
+			prepare: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow(
+					"user-1", "Jane", "O'Smith", "jane", "jane@example.com", "hash",
+					nil, nil, nil, true, true,
+					false, nil, nil,
+					nil, nil, 1,
+					"password", createdAt, createdAt, nil,
+					nil, nil, nil, []byte(`[]`),
+					0.75, "\uE000Jane\uE001 \uE000O\uE001'\uE000Smith\uE001 jane@example.com <x>",
+				)
+				mock.ExpectQuery(`search_vector @@ to_tsquery\('simple', \$2\)\s+OR \$1 <% \(u.first_name \|\| ' ' \|\| coalesce\(u.last_name, ''\)\)`).
+					WithArgs("Jane O'Sm", "jane:* & o:* & sm:*", "", sqlmock.AnyArg(), 20).
+					WillReturnRows(rows)
+			},
+			expect: []*user.SearchResult{
+				{
+					Rank:      0.75,
+					Highlight: "<mark>Jane</mark> <mark>O</mark>&#39;<mark>Smith</mark> jane@example.com &lt;x&gt;",
+				},
+			},
+		},
+		"when the name and headline treat a missing last name as empty": {
+			query: "jane",
+			prepare: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow(
+					"user-1", "Jane", "", "jane", "jane@example.com", "hash",
+					nil, nil, nil, true, true,
+					false, nil, nil,
+					nil, nil, 1,
+					"password", createdAt, createdAt, nil,
+					nil, nil, nil, []byte(`[]`),
+					0.5, "\uE000Jane\uE001  jane@example.com",
+				)
+				mock.ExpectQuery(`u.first_name \|\| ' ' \|\| coalesce\(u.last_name, ''\) \|\| ' ' \|\| u.email,.+word_similarity\(\$1, u.first_name \|\| ' ' \|\| coalesce\(u.last_name, ''\)\)`).
+					WithArgs("jane", "jane:*", "", sqlmock.AnyArg(), 20).
+					WillReturnRows(rows)
+			},
+			expect: []*user.SearchResult{
+				{
+					Rank:      0.5,
+					Highlight: "<mark>Jane</mark>  jane@example.com",
+				},
+			},
+		},
+		"when the query holds a phone number fragment": {
+			query: "+54 (11) 5555",
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`regexp_replace\(u.phone_number, '\[\^0-9\]', '', 'g'\) LIKE \$3`).
+					WithArgs("+54 (11) 5555", "54:* & 11:* & 5555:*", "%54115555%", sqlmock.AnyArg(), 20).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		"when the query fails": {
+			query: "jane",
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT`).WillReturnError(errors.New("database error"))
+			},
+			expectErr: errors.New("database error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tt.prepare(mock)
+
+			repository := user.NewRepository(db)
+			results, err := repository.Search(context.Background(), user.SearchOptions{
+				Query: tt.query,
+				Limit: 20,
+			})
+
+			assert.Equal(t, tt.expectErr, err)
+			assert.Len(t, results, len(tt.expect))
+			for i, expect := range tt.expect {
+				assert.Equal(t, "user-1", results[i].User.ID)
+				assert.Equal(t, expect.Rank, results[i].Rank)
+				assert.Equal(t, expect.Highlight, results[i].Highlight)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+)
+
+// SetSCIMTenant records the SCIM tenant that provisioned the user. It
+// returns sql.ErrNoRows when the user does not exist.
+func (r *repository) SetSCIMTenant(ctx context.Context, id string, tenant string) error {
+	result, err := r.db.ExecContext(ctx, `UPDATE users SET scim_tenant = $1 WHERE id = $2`, tenant, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
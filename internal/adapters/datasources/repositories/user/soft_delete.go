@@ -0,0 +1,39 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// SoftDelete hides the user from Get and List and invalidates their
+// tokens. The row is kept, so the username and email stay taken. It
+// returns sql.ErrNoRows when the user does not exist or was already
+// deleted.
+func (r *repository) SoftDelete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE users SET
+			deleted_at = $1,
+			is_active = FALSE,
+			token_version = token_version + 1,
+			updated_at = $1
+		WHERE id = $2 AND deleted_at IS NULL`,
+		time.Now().UTC(),
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
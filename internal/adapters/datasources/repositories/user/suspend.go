@@ -0,0 +1,51 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Suspend deactivates the user and bumps token_version, so tokens already
+// issued stop working at once. until may be nil for an open-ended
+// suspension. It returns sql.ErrNoRows when the user does not exist or was
+// deleted.
+func (r *repository) Suspend(ctx context.Context, id string, reason string, until *time.Time) error {
+	now := time.Now().UTC()
+
+	var suspendedUntil *time.Time
+	if until != nil {
+		value := until.UTC()
+		suspendedUntil = &value
+	}
+
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE users SET
+			is_active = FALSE,
+			suspended_at = $1,
+			suspended_until = $2,
+			suspension_reason = $3,
+			token_version = token_version + 1,
+			updated_at = $1
+		WHERE id = $4 AND deleted_at IS NULL`,
+		now,
+		suspendedUntil,
+		reason,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package user_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+)
+
+func TestRepository_Suspend(t *testing.T) {
+	until := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		until     *time.Time
+		prepare   func(mock sqlmock.Sqlmock)
+		expectErr error
+	}{
+		"when the user is suspended until a date": {
+			until: &until,
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE users SET\s+is_active = FALSE,.*token_version = token_version \+ 1,.*WHERE id = \$4 AND deleted_at IS NULL`).
+					WithArgs(sqlmock.AnyArg(), &until, "spam", "user-123").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"when the suspension is open-ended": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE users SET`).
+					WithArgs(sqlmock.AnyArg(), nil, "spam", "user-123").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"when the user does not exist or was deleted": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE users SET`).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectErr: sql.ErrNoRows,
+		},
+		"when the query fails": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE users SET`).WillReturnError(errors.New("database error"))
+			},
+			expectErr: errors.New("database error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tt.prepare(mock)
+
+			repository := user.NewRepository(db)
+			err = repository.Suspend(context.Background(), "user-123", "spam", tt.until)
+
+			assert.Equal(t, tt.expectErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -19,6 +19,7 @@ func unmarshalUser(
 	address *string,
 	isActive bool,
 	verifiedEmail bool,
+	verifiedPhone bool,
 	verifiedEmailToken string,
 	verifiedEmailTokenExpiry time.Time,
 	passwordResetToken *string,
@@ -27,6 +28,10 @@ func unmarshalUser(
 	authMethod string,
 	createdAt time.Time,
 	updatedAt time.Time,
+	suspendedAt *time.Time,
+	suspendedUntil *time.Time,
+	suspensionReason *string,
+	deletedAt *time.Time,
 	roles []domain.Role,
 ) *domain.User {
 	return &domain.User{
@@ -41,6 +46,7 @@ func unmarshalUser(
 		Address:                  address,
 		IsActive:                 isActive,
 		VerifiedEmail:            verifiedEmail,
+		VerifiedPhone:            verifiedPhone,
 		VerifiedEmailToken:       verifiedEmailToken,
 		VerifiedEmailTokenExpiry: verifiedEmailTokenExpiry,
 		PasswordResetToken:       passwordResetToken,
@@ -49,6 +55,10 @@ func unmarshalUser(
 		AuthMethod:               authMethod,
 		CreatedAt:                createdAt,
 		UpdatedAt:                updatedAt,
+		SuspendedAt:              suspendedAt,
+		SuspendedUntil:           suspendedUntil,
+		SuspensionReason:         suspensionReason,
+		DeletedAt:                deletedAt,
 		Roles:                    roles,
 	}
 }
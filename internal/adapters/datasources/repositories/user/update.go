@@ -3,13 +3,14 @@ package user
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 	"time"
-
-	"github.com/tapiaw38/auth-api-be/internal/domain"
 )
 
-func (r *repository) Update(ctx context.Context, id string, user *domain.User) (string, error) {
-	row, err := r.executeUpdateQuery(ctx, id, user)
+// Update only writes the columns set in fields and returns the user's ID.
+func (r *repository) Update(ctx context.Context, id string, fields UpdateFields) (string, error) {
+	row, err := r.executeUpdateQuery(ctx, id, fields)
 	if err != nil {
 		return "", err
 	}
@@ -22,81 +23,81 @@ func (r *repository) Update(ctx context.Context, id string, user *domain.User) (
 	return updatedID, nil
 }
 
-func (r *repository) executeUpdateQuery(ctx context.Context, id string, user *domain.User) (*sql.Row, error) {
-	query := `UPDATE users
-		SET
-			first_name = COALESCE($1, first_name),
-			last_name = COALESCE($2, last_name),
-			email = COALESCE($3, email),
-			password = COALESCE($4, password),
-			picture = COALESCE($5, picture),
-			phone_number = COALESCE($6, phone_number),
-			address = COALESCE($7, address),
-			is_active = COALESCE($8, is_active),
-			verified_email = COALESCE($9, verified_email),
-			verified_email_token = COALESCE($10, verified_email_token),
-			verified_email_token_expiry = COALESCE($11, verified_email_token_expiry),
-			password_reset_token = COALESCE($12, password_reset_token),
-			password_reset_token_expiry = COALESCE($13, password_reset_token_expiry),
-			auth_method = COALESCE($14, auth_method),
-			updated_at = $15
-		WHERE id = $16
-		RETURNING id;`
-
+func (r *repository) executeUpdateQuery(ctx context.Context, id string, fields UpdateFields) (*sql.Row, error) {
 	var (
-		verifiedEmailToken                                *string
-		verifiedEmailTokenExpiry                          *time.Time
-		picture, phoneNumber, address, passwordResetToken *string
-		passwordResetTokenExpiry                          *time.Time
+		sets []string
+		args []any
 	)
 
-	if user.VerifiedEmailToken != "" {
-		verifiedEmailToken = &user.VerifiedEmailToken
+	set := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	if fields.FirstName != nil {
+		set("first_name", *fields.FirstName)
+	}
+
+	if fields.LastName != nil {
+		set("last_name", *fields.LastName)
+	}
+
+	if fields.Email != nil {
+		set("email", *fields.Email)
+	}
+
+	if fields.Password != nil {
+		set("password", *fields.Password)
+	}
+
+	if fields.PhoneNumber != nil {
+		set("phone_number", nullIfEmpty(*fields.PhoneNumber))
+		// The right-hand side reads the old row, so the verification only
+		// survives when the number stays the same.
+		sets = append(sets, fmt.Sprintf("verified_phone = verified_phone AND phone_number IS NOT DISTINCT FROM $%d", len(args)))
+	}
+
+	if fields.Picture != nil {
+		set("picture", nullIfEmpty(*fields.Picture))
+	}
+
+	if fields.Address != nil {
+		set("address", nullIfEmpty(*fields.Address))
 	}
 
-	if !user.VerifiedEmailTokenExpiry.IsZero() {
-		verifiedEmailTokenExpiry = &user.VerifiedEmailTokenExpiry
+	if fields.IsActive != nil {
+		set("is_active", *fields.IsActive)
 	}
 
-	if user.Picture != nil {
-		picture = user.Picture
+	if fields.VerifiedEmail != nil {
+		set("verified_email", *fields.VerifiedEmail)
 	}
 
-	if user.PhoneNumber != nil {
-		phoneNumber = user.PhoneNumber
+	if fields.VerifiedEmailToken != nil {
+		set("verified_email_token", nullIfEmpty(*fields.VerifiedEmailToken))
 	}
 
-	if user.Address != nil {
-		address = user.Address
+	if fields.VerifiedEmailTokenExpiry != nil {
+		set("verified_email_token_expiry", nullIfZero(*fields.VerifiedEmailTokenExpiry))
 	}
 
-	if user.PasswordResetToken != nil {
-		passwordResetToken = user.PasswordResetToken
+	if fields.PasswordResetToken != nil {
+		set("password_reset_token", nullIfEmpty(*fields.PasswordResetToken))
 	}
 
-	if user.PasswordResetTokenExpiry != nil && !user.PasswordResetTokenExpiry.IsZero() {
-		passwordResetTokenExpiry = user.PasswordResetTokenExpiry
+	if fields.PasswordResetTokenExpiry != nil {
+		set("password_reset_token_expiry", nullIfZero(*fields.PasswordResetTokenExpiry))
 	}
 
-	args := []any{
-		user.FirstName,
-		user.LastName,
-		user.Email,
-		user.Password,
-		picture,
-		phoneNumber,
-		address,
-		user.IsActive,
-		user.VerifiedEmail,
-		verifiedEmailToken,
-		verifiedEmailTokenExpiry,
-		passwordResetToken,
-		passwordResetTokenExpiry,
-		user.AuthMethod,
-		user.UpdatedAt,
-		id,
+	if fields.AuthMethod != nil {
+		set("auth_method", *fields.AuthMethod)
 	}
 
+	set("updated_at", time.Now().UTC())
+
+	args = append(args, id)
+	query := fmt.Sprintf(`UPDATE users SET %s WHERE id = $%d RETURNING id`, strings.Join(sets, ", "), len(args))
+
 	row := r.db.QueryRowContext(ctx, query, args...)
 	if row.Err() != nil {
 		return nil, row.Err()
@@ -104,3 +105,19 @@ func (r *repository) executeUpdateQuery(ctx context.Context, id string, user *do
 
 	return row, nil
 }
+
+func nullIfEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func nullIfZero(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+
+	return &value
+}
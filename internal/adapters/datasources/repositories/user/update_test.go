@@ -0,0 +1,96 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+)
+
+func TestRepository_Update(t *testing.T) {
+	firstName := "Jane"
+	emptyAddress := ""
+	phoneNumber := "+14155550123"
+	inactive := false
+	emptyToken := ""
+
+	tests := map[string]struct {
+		fields    user.UpdateFields
+		prepare   func(mock sqlmock.Sqlmock)
+		expect    string
+		expectErr error
+	}{
+		"when only the given columns are written": {
+			fields: user.UpdateFields{
+				FirstName: &firstName,
+				Address:   &emptyAddress,
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE users SET first_name = \$1, address = \$2, updated_at = \$3 WHERE id = \$4 RETURNING id`).
+					WithArgs("Jane", nil, sqlmock.AnyArg(), "user-123").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("user-123"))
+			},
+			expect: "user-123",
+		},
+		"when the phone number changes it needs verification again": {
+			fields: user.UpdateFields{
+				PhoneNumber: &phoneNumber,
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE users SET phone_number = \$1, verified_phone = verified_phone AND phone_number IS NOT DISTINCT FROM \$1, updated_at = \$2 WHERE id = \$3 RETURNING id`).
+					WithArgs(&phoneNumber, sqlmock.AnyArg(), "user-123").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("user-123"))
+			},
+			expect: "user-123",
+		},
+		"when flags are set to false and a token is cleared": {
+			fields: user.UpdateFields{
+				IsActive:           &inactive,
+				VerifiedEmailToken: &emptyToken,
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE users SET is_active = \$1, verified_email_token = \$2, updated_at = \$3 WHERE id = \$4 RETURNING id`).
+					WithArgs(false, nil, sqlmock.AnyArg(), "user-123").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("user-123"))
+			},
+			expect: "user-123",
+		},
+		"when no fields are given": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE users SET updated_at = \$1 WHERE id = \$2 RETURNING id`).
+					WithArgs(sqlmock.AnyArg(), "user-123").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("user-123"))
+			},
+			expect: "user-123",
+		},
+		"when the query fails": {
+			fields: user.UpdateFields{FirstName: &firstName},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE users`).WillReturnError(errors.New("database error"))
+			},
+			expectErr: errors.New("database error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tt.prepare(mock)
+
+			repository := user.NewRepository(db)
+			id, err := repository.Update(context.Background(), "user-123", tt.fields)
+
+			assert.Equal(t, tt.expect, id)
+			assert.Equal(t, tt.expectErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
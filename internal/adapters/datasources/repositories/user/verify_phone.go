@@ -0,0 +1,34 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// VerifyPhone marks phoneNumber as verified. It returns sql.ErrNoRows when
+// the user no longer has that number, so a code sent to an old number
+// cannot verify a new one.
+func (r *repository) VerifyPhone(ctx context.Context, id string, phoneNumber string) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE users SET verified_phone = TRUE, updated_at = $1 WHERE id = $2 AND phone_number = $3`,
+		time.Now().UTC(),
+		id,
+		phoneNumber,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Create(ctx context.Context, webhook domain.Webhook) (string, error) {
+	row, err := r.executeCreateQuery(ctx, webhook)
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	if err := row.Scan(&id); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (r *repository) executeCreateQuery(ctx context.Context, webhook domain.Webhook) (*sql.Row, error) {
+	query := `INSERT INTO webhooks (id, url, secret, event_types, is_active, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $6)
+			RETURNING id`
+
+	args := []any{
+		webhook.ID,
+		webhook.URL,
+		webhook.Secret,
+		eventTypesArray(webhook.EventTypes),
+		webhook.IsActive,
+		time.Now().UTC(),
+	}
+
+	row := r.db.QueryRowContext(ctx, query, args...)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return row, nil
+}
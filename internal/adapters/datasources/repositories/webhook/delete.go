@@ -0,0 +1,30 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+)
+
+func (r *repository) Delete(ctx context.Context, id string) error {
+	result, err := r.executeDeleteQuery(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *repository) executeDeleteQuery(ctx context.Context, id string) (sql.Result, error) {
+	query := `DELETE FROM webhooks WHERE id = $1`
+
+	return r.db.ExecContext(ctx, query, id)
+}
@@ -0,0 +1,47 @@
+package webhook_delivery
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// ClaimDue pushes the next attempt of up to Limit due deliveries to
+// LeaseUntil and returns them. Other dispatchers skip the claimed rows
+// until the lease runs out, so the caller can commit right away and send
+// outside the transaction. A delivery whose attempt is never recorded is
+// picked up again once its lease expires.
+func (r *repository) ClaimDue(ctx context.Context, options ClaimDueOptions) ([]domain.WebhookDelivery, error) {
+	rows, err := r.executeClaimDueQuery(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	return scanDeliveries(rows)
+}
+
+func (r *repository) executeClaimDueQuery(ctx context.Context, options ClaimDueOptions) (*sql.Rows, error) {
+	query := `UPDATE webhook_deliveries
+			SET next_attempt_at = $1, updated_at = $2
+			WHERE id IN (
+				SELECT id FROM webhook_deliveries
+				WHERE status = $3 AND next_attempt_at <= $2
+				ORDER BY next_attempt_at
+				LIMIT $4
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING ` + selectColumns
+
+	args := []any{
+		options.LeaseUntil,
+		time.Now().UTC(),
+		string(domain.WebhookDeliveryPending),
+		options.Limit,
+	}
+
+	return r.db.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,47 @@
+package webhook_delivery
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Create(ctx context.Context, delivery domain.WebhookDelivery) (string, error) {
+	row, err := r.executeCreateQuery(ctx, delivery)
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	if err := row.Scan(&id); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (r *repository) executeCreateQuery(ctx context.Context, delivery domain.WebhookDelivery) (*sql.Row, error) {
+	query := `INSERT INTO webhook_deliveries
+			(id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, 0, $7, $7, $7)
+			RETURNING id`
+
+	args := []any{
+		delivery.ID,
+		delivery.WebhookID,
+		delivery.EventID,
+		string(delivery.EventType),
+		[]byte(delivery.Payload),
+		string(domain.WebhookDeliveryPending),
+		time.Now().UTC(),
+	}
+
+	row := r.db.QueryRowContext(ctx, query, args...)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return row, nil
+}
@@ -0,0 +1,42 @@
+package webhook_delivery
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Get(ctx context.Context, filters GetFilterOptions) (*domain.WebhookDelivery, error) {
+	row := r.executeGetQuery(ctx, filters)
+
+	delivery, err := scanDelivery(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (r *repository) executeGetQuery(ctx context.Context, filters GetFilterOptions) *sql.Row {
+	query := `SELECT ` + selectColumns + ` FROM webhook_deliveries WHERE 1=1`
+
+	args := []any{}
+
+	if filters.ID != "" {
+		args = append(args, filters.ID)
+		query += fmt.Sprintf(` AND id = $%d`, len(args))
+	}
+
+	if filters.WebhookID != "" {
+		args = append(args, filters.WebhookID)
+		query += fmt.Sprintf(` AND webhook_id = $%d`, len(args))
+	}
+
+	return r.db.QueryRowContext(ctx, query, args...)
+}
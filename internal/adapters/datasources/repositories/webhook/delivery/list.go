@@ -0,0 +1,48 @@
+package webhook_delivery
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+const defaultListLimit = 50
+
+func (r *repository) List(ctx context.Context, filters ListFilterOptions) ([]domain.WebhookDelivery, error) {
+	rows, err := r.executeListQuery(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	return scanDeliveries(rows)
+}
+
+func (r *repository) executeListQuery(ctx context.Context, filters ListFilterOptions) (*sql.Rows, error) {
+	query := `SELECT ` + selectColumns + ` FROM webhook_deliveries WHERE 1=1`
+
+	args := []any{}
+
+	if filters.WebhookID != "" {
+		args = append(args, filters.WebhookID)
+		query += fmt.Sprintf(` AND webhook_id = $%d`, len(args))
+	}
+
+	if filters.Status != "" {
+		args = append(args, string(filters.Status))
+		query += fmt.Sprintf(` AND status = $%d`, len(args))
+	}
+
+	limit := filters.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+
+	args = append(args, limit)
+	query += fmt.Sprintf(` ORDER BY created_at DESC LIMIT $%d`, len(args))
+
+	return r.db.QueryContext(ctx, query, args...)
+}
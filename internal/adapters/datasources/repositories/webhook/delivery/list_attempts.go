@@ -0,0 +1,56 @@
+package webhook_delivery
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) ListAttempts(ctx context.Context, deliveryID string) ([]domain.WebhookDeliveryAttempt, error) {
+	rows, err := r.executeListAttemptsQuery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var attempts []domain.WebhookDeliveryAttempt
+	for rows.Next() {
+		var (
+			id                    int64
+			attempt               int
+			responseStatus        *int
+			responseBody, errText *string
+			durationMs            int64
+			createdAt             time.Time
+		)
+
+		if err := rows.Scan(&id, &attempt, &responseStatus, &responseBody, &errText, &durationMs, &createdAt); err != nil {
+			return nil, err
+		}
+
+		attempts = append(attempts, domain.WebhookDeliveryAttempt{
+			ID:             id,
+			DeliveryID:     deliveryID,
+			Attempt:        attempt,
+			ResponseStatus: responseStatus,
+			ResponseBody:   responseBody,
+			Error:          errText,
+			Duration:       time.Duration(durationMs) * time.Millisecond,
+			CreatedAt:      createdAt,
+		})
+	}
+
+	return attempts, rows.Err()
+}
+
+func (r *repository) executeListAttemptsQuery(ctx context.Context, deliveryID string) (*sql.Rows, error) {
+	query := `SELECT id, attempt, response_status, response_body, error, duration_ms, created_at
+			FROM webhook_delivery_attempts
+			WHERE delivery_id = $1
+			ORDER BY id`
+
+	return r.db.QueryContext(ctx, query, deliveryID)
+}
@@ -0,0 +1,43 @@
+package webhook_delivery
+
+import (
+	"context"
+	"time"
+)
+
+func (r *repository) RecordAttempt(ctx context.Context, input RecordAttemptInput) error {
+	now := time.Now().UTC()
+
+	insertQuery := `INSERT INTO webhook_delivery_attempts
+			(delivery_id, attempt, response_status, response_body, error, duration_ms, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	insertArgs := []any{
+		input.DeliveryID,
+		input.Attempt,
+		input.ResponseStatus,
+		input.ResponseBody,
+		input.Error,
+		input.Duration.Milliseconds(),
+		now,
+	}
+
+	if _, err := r.db.ExecContext(ctx, insertQuery, insertArgs...); err != nil {
+		return err
+	}
+
+	updateQuery := `UPDATE webhook_deliveries
+			SET status = $1, attempts = $2, next_attempt_at = $3, updated_at = $4
+			WHERE id = $5`
+
+	updateArgs := []any{
+		string(input.Status),
+		input.Attempt,
+		input.NextAttemptAt,
+		now,
+		input.DeliveryID,
+	}
+
+	_, err := r.db.ExecContext(ctx, updateQuery, updateArgs...)
+	return err
+}
@@ -0,0 +1,39 @@
+package webhook_delivery
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// Redeliver puts a delivery back in the queue for an immediate attempt.
+// The attempt counter is kept, so the attempt log stays continuous.
+func (r *repository) Redeliver(ctx context.Context, id string) error {
+	query := `UPDATE webhook_deliveries
+			SET status = $1, next_attempt_at = $2, updated_at = $2
+			WHERE id = $3`
+
+	args := []any{
+		string(domain.WebhookDeliveryPending),
+		time.Now().UTC(),
+		id,
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package webhook_delivery
+
+import (
+	"context"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	Repository interface {
+		ClaimDue(context.Context, ClaimDueOptions) ([]domain.WebhookDelivery, error)
+		Create(context.Context, domain.WebhookDelivery) (string, error)
+		Get(context.Context, GetFilterOptions) (*domain.WebhookDelivery, error)
+		List(context.Context, ListFilterOptions) ([]domain.WebhookDelivery, error)
+		ListAttempts(context.Context, string) ([]domain.WebhookDeliveryAttempt, error)
+		RecordAttempt(context.Context, RecordAttemptInput) error
+		Redeliver(context.Context, string) error
+	}
+
+	repository struct {
+		db datasources.DBTX
+	}
+
+	GetFilterOptions struct {
+		ID        string
+		WebhookID string
+	}
+
+	ListFilterOptions struct {
+		WebhookID string
+		Status    domain.WebhookDeliveryStatus
+		Limit     int
+	}
+
+	ClaimDueOptions struct {
+		Limit      int
+		LeaseUntil time.Time
+	}
+
+	// RecordAttemptInput stores the outcome of one HTTP request and moves
+	// the delivery to Status, retrying at NextAttemptAt when still pending.
+	RecordAttemptInput struct {
+		DeliveryID     string
+		Attempt        int
+		ResponseStatus *int
+		ResponseBody   *string
+		Error          *string
+		Duration       time.Duration
+		Status         domain.WebhookDeliveryStatus
+		NextAttemptAt  time.Time
+	}
+)
+
+func NewRepository(db datasources.DBTX) Repository {
+	return &repository{
+		db: db,
+	}
+}
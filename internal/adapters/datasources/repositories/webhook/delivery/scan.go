@@ -0,0 +1,69 @@
+package webhook_delivery
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+const selectColumns = `id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at, created_at, updated_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanDelivery(row scanner) (*domain.WebhookDelivery, error) {
+	var (
+		id, webhookID, eventID, eventType, status string
+		payload                                   []byte
+		attempts                                  int
+		nextAttemptAt, createdAt, updatedAt       time.Time
+	)
+
+	if err := row.Scan(
+		&id,
+		&webhookID,
+		&eventID,
+		&eventType,
+		&payload,
+		&status,
+		&attempts,
+		&nextAttemptAt,
+		&createdAt,
+		&updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &domain.WebhookDelivery{
+		ID:            id,
+		WebhookID:     webhookID,
+		EventID:       eventID,
+		EventType:     domain.EventType(eventType),
+		Payload:       json.RawMessage(payload),
+		Status:        domain.WebhookDeliveryStatus(status),
+		Attempts:      attempts,
+		NextAttemptAt: nextAttemptAt,
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+	}, nil
+}
+
+func scanDeliveries(rows interface {
+	scanner
+	Next() bool
+	Err() error
+}) ([]domain.WebhookDelivery, error) {
+	var deliveries []domain.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, *delivery)
+	}
+
+	return deliveries, rows.Err()
+}
@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Get(ctx context.Context, id string) (*domain.Webhook, error) {
+	row := r.executeGetQuery(ctx, id)
+
+	webhook, err := scanWebhook(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func (r *repository) executeGetQuery(ctx context.Context, id string) *sql.Row {
+	query := `SELECT ` + selectColumns + ` FROM webhooks WHERE id = $1`
+
+	return r.db.QueryRowContext(ctx, query, id)
+}
@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) List(ctx context.Context, filters ListFilterOptions) ([]domain.Webhook, error) {
+	rows, err := r.executeListQuery(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var webhooks []domain.Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		webhooks = append(webhooks, *webhook)
+	}
+
+	return webhooks, rows.Err()
+}
+
+func (r *repository) executeListQuery(ctx context.Context, filters ListFilterOptions) (*sql.Rows, error) {
+	query := `SELECT ` + selectColumns + ` FROM webhooks WHERE 1=1`
+
+	args := []any{}
+
+	if filters.ActiveOnly {
+		query += ` AND is_active = TRUE`
+	}
+
+	if filters.EventType != "" {
+		args = append(args, string(filters.EventType))
+		query += fmt.Sprintf(` AND (cardinality(event_types) = 0 OR $%d = ANY(event_types))`, len(args))
+	}
+
+	query += ` ORDER BY created_at`
+
+	return r.db.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,84 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func TestRepository_List(t *testing.T) {
+	columns := []string{"id", "url", "secret", "event_types", "is_active", "created_at", "updated_at"}
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		filters   webhook.ListFilterOptions
+		prepare   func(mock sqlmock.Sqlmock)
+		expect    []domain.Webhook
+		expectErr error
+	}{
+		"when listing subscribers of an event type": {
+			filters: webhook.ListFilterOptions{
+				EventType:  domain.EventUserDeleted,
+				ActiveOnly: true,
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM webhooks WHERE 1=1 AND is_active = TRUE AND \(cardinality\(event_types\) = 0 OR \$1 = ANY\(event_types\)\)`).
+					WithArgs("user.deleted").
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow("webhook-1", "https://example.com/a", "secret", "{user.deleted,user.registered}", true, createdAt, createdAt).
+						AddRow("webhook-2", "https://example.com/b", "secret", "{}", true, createdAt, createdAt))
+			},
+			expect: []domain.Webhook{
+				{
+					ID:         "webhook-1",
+					URL:        "https://example.com/a",
+					Secret:     "secret",
+					EventTypes: []domain.EventType{domain.EventUserDeleted, domain.EventUserRegistered},
+					IsActive:   true,
+					CreatedAt:  createdAt,
+					UpdatedAt:  createdAt,
+				},
+				{
+					ID:         "webhook-2",
+					URL:        "https://example.com/b",
+					Secret:     "secret",
+					EventTypes: []domain.EventType{},
+					IsActive:   true,
+					CreatedAt:  createdAt,
+					UpdatedAt:  createdAt,
+				},
+			},
+		},
+		"when the query fails": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM webhooks").WillReturnError(errors.New("database error"))
+			},
+			expectErr: errors.New("database error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tt.prepare(mock)
+
+			repository := webhook.NewRepository(db)
+			result, err := repository.List(context.Background(), tt.filters)
+
+			assert.Equal(t, tt.expect, result)
+			assert.Equal(t, tt.expectErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	Repository interface {
+		Create(context.Context, domain.Webhook) (string, error)
+		Get(context.Context, string) (*domain.Webhook, error)
+		List(context.Context, ListFilterOptions) ([]domain.Webhook, error)
+		Update(context.Context, string, *domain.Webhook) (string, error)
+		Delete(context.Context, string) error
+	}
+
+	repository struct {
+		db datasources.DBTX
+	}
+
+	// ListFilterOptions narrows the list to active webhooks subscribed to
+	// EventType when it is set.
+	ListFilterOptions struct {
+		EventType  domain.EventType
+		ActiveOnly bool
+	}
+)
+
+func NewRepository(db datasources.DBTX) Repository {
+	return &repository{
+		db: db,
+	}
+}
@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+const selectColumns = `id, url, secret, event_types, is_active, created_at, updated_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanWebhook(row scanner) (*domain.Webhook, error) {
+	var (
+		id, url, secret      string
+		eventTypes           pq.StringArray
+		isActive             bool
+		createdAt, updatedAt time.Time
+	)
+
+	if err := row.Scan(&id, &url, &secret, &eventTypes, &isActive, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	types := make([]domain.EventType, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		types = append(types, domain.EventType(t))
+	}
+
+	return &domain.Webhook{
+		ID:         id,
+		URL:        url,
+		Secret:     secret,
+		EventTypes: types,
+		IsActive:   isActive,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}, nil
+}
+
+func eventTypesArray(types []domain.EventType) pq.StringArray {
+	array := make(pq.StringArray, 0, len(types))
+	for _, t := range types {
+		array = append(array, string(t))
+	}
+
+	return array
+}
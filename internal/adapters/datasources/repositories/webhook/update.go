@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func (r *repository) Update(ctx context.Context, id string, webhook *domain.Webhook) (string, error) {
+	row, err := r.executeUpdateQuery(ctx, id, webhook)
+	if err != nil {
+		return "", err
+	}
+
+	var updatedID string
+	if err := row.Scan(&updatedID); err != nil {
+		return "", err
+	}
+
+	return updatedID, nil
+}
+
+func (r *repository) executeUpdateQuery(ctx context.Context, id string, webhook *domain.Webhook) (*sql.Row, error) {
+	query := `UPDATE webhooks
+		SET
+			url = $1,
+			secret = $2,
+			event_types = $3,
+			is_active = $4,
+			updated_at = $5
+		WHERE id = $6
+		RETURNING id`
+
+	args := []any{
+		webhook.URL,
+		webhook.Secret,
+		eventTypesArray(webhook.EventTypes),
+		webhook.IsActive,
+		time.Now().UTC(),
+		id,
+	}
+
+	row := r.db.QueryRowContext(ctx, query, args...)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return row, nil
+}
@@ -0,0 +1,128 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+const defaultMemoryBufferSize = 1024
+
+// Memory is an in-process queue backed by buffered channels. Messages are
+// lost on restart, so it is meant for development and tests.
+type Memory struct {
+	bufferSize int
+	topics     map[Topic]chan []byte
+	closed     bool
+	mutex      sync.Mutex
+}
+
+func NewMemory(cfg *config.ConfigurationService) *Memory {
+	bufferSize := cfg.Queue.MemoryBufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultMemoryBufferSize
+	}
+
+	return &Memory{
+		bufferSize: bufferSize,
+		topics:     make(map[Topic]chan []byte),
+	}
+}
+
+func (m *Memory) topic(topic Topic) (chan []byte, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.closed {
+		return nil, ErrNotConnected
+	}
+
+	ch, ok := m.topics[topic]
+	if !ok {
+		ch = make(chan []byte, m.bufferSize)
+		m.topics[topic] = ch
+	}
+
+	return ch, nil
+}
+
+func (m *Memory) Publish(topic Topic, data interface{}) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	ch, err := m.topic(topic)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case ch <- body:
+		return nil
+	default:
+		return fmt.Errorf("queue for topic %s is full", topic)
+	}
+}
+
+// PublishEvent drops the event: without an exchange there is no one to fan
+// it out to, and queueing it on a topic nobody consumes would fill the
+// buffer. Webhooks are still delivered from the outbox.
+func (m *Memory) PublishEvent(eventType string, data interface{}) error {
+	return nil
+}
+
+func (m *Memory) Consume(ctx context.Context, topic Topic, concurrency int, handler MessageHandler) error {
+	ch, err := m.topic(topic)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case body := <-ch:
+					if err := handler(ctx, body); err != nil {
+						log.Printf("Error handling message on topic %s: %v", topic, err)
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
+
+func (m *Memory) Name() string {
+	return string(BackendMemory)
+}
+
+func (m *Memory) Check(context.Context) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.closed {
+		return ErrNotConnected
+	}
+
+	return nil
+}
+
+func (m *Memory) Close() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.closed = true
+	return nil
+}
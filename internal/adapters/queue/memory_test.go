@@ -0,0 +1,96 @@
+package queue_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+func TestMemory_PublishAndConsume(t *testing.T) {
+	mq := queue.NewMemory(&config.ConfigurationService{})
+
+	err := mq.Publish(queue.TopicSendEmail, map[string]string{"to": "john@example.com"})
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	received := make(chan string, 1)
+	go func() {
+		_ = mq.Consume(ctx, queue.TopicSendEmail, 1, func(ctx context.Context, body []byte) error {
+			received <- string(body)
+			return nil
+		})
+	}()
+
+	select {
+	case body := <-received:
+		assert.JSONEq(t, `{"to":"john@example.com"}`, body)
+	case <-ctx.Done():
+		t.Fatal("message was not consumed")
+	}
+}
+
+func TestMemory_Publish(t *testing.T) {
+	tests := map[string]struct {
+		bufferSize  int
+		messages    int
+		close       bool
+		expectedErr string
+	}{
+		"when the buffer has room": {
+			bufferSize: 2,
+			messages:   2,
+		},
+		"when the buffer is full": {
+			bufferSize:  1,
+			messages:    2,
+			expectedErr: "queue for topic send_email is full",
+		},
+		"when the queue is closed": {
+			bufferSize:  1,
+			messages:    1,
+			close:       true,
+			expectedErr: queue.ErrNotConnected.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mq := queue.NewMemory(&config.ConfigurationService{
+				Queue: config.QueueConfig{MemoryBufferSize: tc.bufferSize},
+			})
+
+			if tc.close {
+				assert.NoError(t, mq.Close())
+			}
+
+			var err error
+			for i := 0; i < tc.messages; i++ {
+				err = mq.Publish(queue.TopicSendEmail, i)
+			}
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMemory_PublishEventDoesNotFillTheBuffer(t *testing.T) {
+	mq := queue.NewMemory(&config.ConfigurationService{
+		Queue: config.QueueConfig{MemoryBufferSize: 1},
+	})
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, mq.PublishEvent("user.registered", map[string]int{"n": i}))
+	}
+
+	assert.NoError(t, mq.Publish(queue.TopicSendEmail, map[string]string{"to": "john@example.com"}))
+}
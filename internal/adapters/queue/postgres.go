@@ -0,0 +1,199 @@
+package queue
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+const (
+	defaultPollInterval = time.Second
+	defaultMaxAttempts  = 5
+	retryBaseDelay      = 10 * time.Second
+)
+
+const (
+	JobStatusPending JobStatus = "pending"
+	JobStatusDone    JobStatus = "done"
+	JobStatusFailed  JobStatus = "failed"
+)
+
+type (
+	JobStatus string
+
+	// Postgres stores messages in the jobs table and claims them with
+	// SELECT ... FOR UPDATE SKIP LOCKED, so several processes can consume the
+	// same topic without a broker.
+	Postgres struct {
+		db           *sql.DB
+		pollInterval time.Duration
+		maxAttempts  int
+	}
+)
+
+func NewPostgres(db *sql.DB, cfg *config.ConfigurationService) *Postgres {
+	pollInterval := cfg.Queue.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+
+	maxAttempts := cfg.Queue.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+
+	return &Postgres{
+		db:           db,
+		pollInterval: pollInterval,
+		maxAttempts:  maxAttempts,
+	}
+}
+
+func (p *Postgres) Publish(topic Topic, data interface{}) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	query := `INSERT INTO jobs (topic, payload, status, run_at, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $4, $4)`
+
+	args := []any{
+		string(topic),
+		body,
+		string(JobStatusPending),
+		time.Now().UTC(),
+	}
+
+	if _, err := p.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("failed to enqueue job: %w", err)
+	}
+
+	return nil
+}
+
+// PublishEvent drops the event: without an exchange there is no one to fan
+// it out to, and jobs on a topic nobody consumes would pile up. Webhooks
+// are still delivered from the outbox.
+func (p *Postgres) PublishEvent(eventType string, data interface{}) error {
+	return nil
+}
+
+func (p *Postgres) Consume(ctx context.Context, topic Topic, concurrency int, handler MessageHandler) error {
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				processed, err := p.processNext(ctx, topic, handler)
+				if err != nil {
+					log.Printf("Error processing job on topic %s: %v", topic, err)
+				}
+
+				if processed {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(p.pollInterval):
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// processNext claims and handles one due job. The row stays locked for the
+// duration of the handler, so a crashed worker releases it on rollback.
+func (p *Postgres) processNext(ctx context.Context, topic Topic, handler MessageHandler) (bool, error) {
+	if ctx.Err() != nil {
+		return false, nil
+	}
+
+	// Bookkeeping must survive shutdown: a cancelled context would roll back
+	// the transaction after the handler already ran.
+	txCtx := context.WithoutCancel(ctx)
+
+	tx, err := p.db.BeginTx(txCtx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	query := `SELECT id, payload, attempts FROM jobs
+			WHERE topic = $1 AND status = $2 AND run_at <= $3
+			ORDER BY id
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED`
+
+	var (
+		id       int64
+		payload  []byte
+		attempts int
+	)
+
+	err = tx.QueryRowContext(txCtx, query, string(topic), string(JobStatusPending), time.Now().UTC()).
+		Scan(&id, &payload, &attempts)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if handleErr := handler(ctx, payload); handleErr != nil {
+		attempts++
+		status := JobStatusPending
+		if attempts >= p.maxAttempts {
+			status = JobStatusFailed
+		}
+		runAt := time.Now().UTC().Add(retryBaseDelay * time.Duration(attempts))
+
+		if _, err := tx.ExecContext(
+			txCtx,
+			`UPDATE jobs SET status = $1, attempts = $2, last_error = $3, run_at = $4, updated_at = $5 WHERE id = $6`,
+			string(status), attempts, handleErr.Error(), runAt, time.Now().UTC(), id,
+		); err != nil {
+			return true, err
+		}
+
+		if err := tx.Commit(); err != nil {
+			return true, err
+		}
+
+		return true, fmt.Errorf("job %d failed (attempt %d): %w", id, attempts, handleErr)
+	}
+
+	if _, err := tx.ExecContext(
+		txCtx,
+		`UPDATE jobs SET status = $1, attempts = $2, last_error = NULL, updated_at = $3 WHERE id = $4`,
+		string(JobStatusDone), attempts+1, time.Now().UTC(), id,
+	); err != nil {
+		return true, err
+	}
+
+	return true, tx.Commit()
+}
+
+func (p *Postgres) Name() string {
+	return string(BackendPostgres)
+}
+
+func (p *Postgres) Check(ctx context.Context) error {
+	return p.db.PingContext(ctx)
+}
+
+func (p *Postgres) Close() error {
+	return nil
+}
@@ -0,0 +1,129 @@
+package queue_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+func TestPostgres_Publish(t *testing.T) {
+	tests := map[string]struct {
+		prepare     func(mock sqlmock.Sqlmock)
+		expectedErr string
+	}{
+		"when the job is enqueued successfully": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO jobs").
+					WithArgs("send_email", []byte(`{"to":"john@example.com"}`), "pending", sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"when the insert fails": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO jobs").
+					WillReturnError(errors.New("database error"))
+			},
+			expectedErr: "failed to enqueue job: database error",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tc.prepare(mock)
+
+			mq := queue.NewPostgres(db, &config.ConfigurationService{})
+			err = mq.Publish(queue.TopicSendEmail, map[string]string{"to": "john@example.com"})
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostgres_Consume(t *testing.T) {
+	tests := map[string]struct {
+		handlerErr error
+		prepare    func(mock sqlmock.Sqlmock)
+	}{
+		"when the job is handled successfully": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE SKIP LOCKED").
+					WithArgs("send_email", "pending", sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "payload", "attempts"}).AddRow(1, []byte(`{}`), 0))
+				mock.ExpectExec("UPDATE jobs SET status").
+					WithArgs("done", 1, sqlmock.AnyArg(), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		"when the handler fails the job is rescheduled": {
+			handlerErr: errors.New("smtp error"),
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE SKIP LOCKED").
+					WithArgs("send_email", "pending", sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "payload", "attempts"}).AddRow(1, []byte(`{}`), 0))
+				mock.ExpectExec("UPDATE jobs SET status").
+					WithArgs("pending", 1, "smtp error", sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tc.prepare(mock)
+
+			mq := queue.NewPostgres(db, &config.ConfigurationService{
+				Queue: config.QueueConfig{PollInterval: time.Hour},
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			handled := make(chan struct{})
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_ = mq.Consume(ctx, queue.TopicSendEmail, 1, func(ctx context.Context, body []byte) error {
+					close(handled)
+					cancel()
+					return tc.handlerErr
+				})
+			}()
+
+			select {
+			case <-handled:
+			case <-time.After(time.Second):
+				t.Fatal("job was not handled")
+			}
+			<-done
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
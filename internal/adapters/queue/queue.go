@@ -1,121 +1,67 @@
 package queue
 
 import (
-	"encoding/json"
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
-	"sync"
 
-	"github.com/streadway/amqp"
 	"github.com/tapiaw38/auth-api-be/internal/platform/config"
 )
 
 type Topic string
 
 const (
-	TopicSendEmail Topic = "send_email"
+	TopicSendEmail   Topic = "send_email"
+	TopicImportUsers Topic = "import_users"
 )
 
-type Publisher interface {
-	Publish(topic Topic, data interface{}) error
-}
-
-type (
-	RabbitMQ struct {
-		conn       *amqp.Connection
-		publishers map[Topic]*publisher
-		mutex      sync.Mutex
-	}
-
-	publisher struct {
-		topic Topic
-		ch    *amqp.Channel
-	}
+const (
+	BackendRabbitMQ Backend = "rabbitmq"
+	BackendPostgres Backend = "postgres"
+	BackendMemory   Backend = "memory"
 )
 
-func NewRabbitMQ(cfg *config.ConfigurationService) (*RabbitMQ, error) {
-	conn, err := amqp.Dial(cfg.RabbitMQ.URL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to RabbitMQ: %w", err)
-	}
-
-	return &RabbitMQ{
-		conn:       conn,
-		publishers: make(map[Topic]*publisher),
-	}, nil
-}
-
-func (r *RabbitMQ) Close() error {
-	r.mutex.Lock()
-	defer r.mutex.Unlock()
-
-	for _, pub := range r.publishers {
-		if pub.ch != nil {
-			_ = pub.ch.Close()
-		}
-	}
-	return r.conn.Close()
-}
-
-func (r *RabbitMQ) GetConnection() *amqp.Connection {
-	return r.conn
-}
-
-func (r *RabbitMQ) GetPublisher(topic Topic) error {
-	r.mutex.Lock()
-	defer r.mutex.Unlock()
+var ErrNotConnected = errors.New("queue is not connected")
 
-	if _, ok := r.publishers[topic]; ok {
-		return nil
-	}
-
-	ch, err := r.conn.Channel()
-	if err != nil {
-		return fmt.Errorf("failed to create channel: %w", err)
-	}
-
-	pub := &publisher{
-		topic: topic,
-		ch:    ch,
+type (
+	Backend string
+
+	Publisher interface {
+		Publish(topic Topic, data interface{}) error
+		// PublishEvent fans a domain event out to subscribers, using the
+		// event type as the routing key. Backends without exchange routing
+		// drop events.
+		PublishEvent(eventType string, data interface{}) error
 	}
 
-	r.publishers[topic] = pub
-	return nil
-}
-
-func (r *RabbitMQ) Publish(topic Topic, data interface{}) error {
-	r.mutex.Lock()
-	pub, ok := r.publishers[topic]
-	r.mutex.Unlock()
+	// MessageHandler processes the raw JSON body of a message. Returning an
+	// error marks the message as failed for the backend.
+	MessageHandler func(ctx context.Context, body []byte) error
 
-	if !ok {
-		return fmt.Errorf("publisher for topic %s not found", topic)
+	Consumer interface {
+		Consume(ctx context.Context, topic Topic, concurrency int, handler MessageHandler) error
 	}
 
-	q, err := pub.ch.QueueDeclare(
-		string(pub.topic), // Asegúrate de que este nombre coincida con el tema
-		false,             // Durable
-		false,             // Auto-delete
-		false,             // Exclusive
-		false,             // No-wait
-		nil,               // Arguments
-	)
-	if err != nil {
-		return fmt.Errorf("queue declare failed: %w", err)
+	Broker interface {
+		Publisher
+		Consumer
+		Name() string
+		Check(context.Context) error
+		Close() error
 	}
+)
 
-	jsonData, err := json.Marshal(data)
-	if err != nil {
-		return fmt.Errorf("failed to marshal message: %w", err)
+// NewBroker builds the queue backend selected in configuration.
+func NewBroker(cfg *config.ConfigurationService, db *sql.DB) (Broker, error) {
+	switch Backend(cfg.Queue.Backend) {
+	case BackendRabbitMQ, "":
+		return NewRabbitMQ(cfg), nil
+	case BackendPostgres:
+		return NewPostgres(db, cfg), nil
+	case BackendMemory:
+		return NewMemory(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown queue backend: %s", cfg.Queue.Backend)
 	}
-
-	return pub.ch.Publish(
-		"",     // exchange
-		q.Name, // routing key
-		false,  // mandatory
-		false,  // immediate
-		amqp.Publishing{
-			ContentType: "application/json",
-			Body:        jsonData,
-		},
-	)
 }
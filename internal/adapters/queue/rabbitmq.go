@@ -0,0 +1,462 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/streadway/amqp"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+const (
+	defaultConfirmTimeout = 5 * time.Second
+	defaultEventsExchange = "auth.events"
+	minReconnectBackoff   = time.Second
+	maxReconnectBackoff   = 30 * time.Second
+	resubscribeDelay      = time.Second
+)
+
+type RabbitMQ struct {
+	url            string
+	confirmTimeout time.Duration
+	eventsExchange string
+
+	conn      *amqp.Connection
+	connected bool
+	ready     chan struct{}
+	mutex     sync.RWMutex
+
+	publishCh    *amqp.Channel
+	confirms     chan amqp.Confirmation
+	declared     map[Topic]bool
+	exchanged    bool
+	publishMutex sync.Mutex
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewRabbitMQ dials the broker and supervises the connection in the
+// background. An unreachable broker does not prevent startup: the supervisor
+// keeps retrying and the health check reports the queue as degraded.
+func NewRabbitMQ(cfg *config.ConfigurationService) *RabbitMQ {
+	confirmTimeout := cfg.RabbitMQ.ConfirmTimeout
+	if confirmTimeout <= 0 {
+		confirmTimeout = defaultConfirmTimeout
+	}
+
+	eventsExchange := cfg.RabbitMQ.EventsExchange
+	if eventsExchange == "" {
+		eventsExchange = defaultEventsExchange
+	}
+
+	r := &RabbitMQ{
+		url:            cfg.RabbitMQ.URL,
+		confirmTimeout: confirmTimeout,
+		eventsExchange: eventsExchange,
+		ready:          make(chan struct{}),
+		done:           make(chan struct{}),
+	}
+
+	if err := r.connect(); err != nil {
+		log.Printf("failed to connect to RabbitMQ, retrying in background: %v", err)
+	}
+
+	go r.supervise()
+
+	return r
+}
+
+func (r *RabbitMQ) connect() error {
+	conn, err := amqp.Dial(r.url)
+	if err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.conn = conn
+	if !r.connected {
+		r.connected = true
+		close(r.ready)
+	}
+
+	return nil
+}
+
+func (r *RabbitMQ) markDisconnected() {
+	r.mutex.Lock()
+	if r.connected {
+		r.connected = false
+		r.ready = make(chan struct{})
+	}
+	r.mutex.Unlock()
+
+	r.publishMutex.Lock()
+	r.resetPublisher()
+	r.publishMutex.Unlock()
+}
+
+// supervise watches the connection and re-dials with exponential backoff
+// whenever the broker closes it. Channels are re-created lazily by
+// publishers and consumers once the connection is back.
+func (r *RabbitMQ) supervise() {
+	for {
+		r.mutex.RLock()
+		conn := r.conn
+		r.mutex.RUnlock()
+
+		if conn != nil {
+			closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+
+			select {
+			case <-r.done:
+				return
+			case err := <-closed:
+				select {
+				case <-r.done:
+					return
+				default:
+				}
+				log.Printf("RabbitMQ connection lost: %v", err)
+			}
+
+			r.markDisconnected()
+		}
+
+		if !r.reconnect() {
+			return
+		}
+	}
+}
+
+// reconnect retries until a connection is established. It returns false if
+// the broker was closed while waiting.
+func (r *RabbitMQ) reconnect() bool {
+	backoff := minReconnectBackoff
+	for {
+		select {
+		case <-r.done:
+			return false
+		case <-time.After(backoff):
+		}
+
+		if err := r.connect(); err != nil {
+			log.Printf("RabbitMQ reconnect failed, retrying in %s: %v", backoff, err)
+			backoff *= 2
+			if backoff > maxReconnectBackoff {
+				backoff = maxReconnectBackoff
+			}
+			continue
+		}
+
+		log.Println("RabbitMQ connection established")
+		return true
+	}
+}
+
+func (r *RabbitMQ) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.done)
+	})
+
+	r.publishMutex.Lock()
+	r.resetPublisher()
+	r.publishMutex.Unlock()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.connected = false
+	if r.conn == nil {
+		return nil
+	}
+
+	return r.conn.Close()
+}
+
+// Channel opens a new channel on the current connection. Callers own the
+// returned channel and must re-open it after a reconnect.
+func (r *RabbitMQ) Channel() (*amqp.Channel, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if !r.connected {
+		return nil, ErrNotConnected
+	}
+
+	return r.conn.Channel()
+}
+
+// WaitConnected blocks until the connection is available or ctx is done.
+func (r *RabbitMQ) WaitConnected(ctx context.Context) error {
+	r.mutex.RLock()
+	ready := r.ready
+	r.mutex.RUnlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *RabbitMQ) Name() string {
+	return string(BackendRabbitMQ)
+}
+
+func (r *RabbitMQ) Check(ctx context.Context) error {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if !r.connected {
+		return ErrNotConnected
+	}
+
+	return nil
+}
+
+func (r *RabbitMQ) Publish(topic Topic, data interface{}) error {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	r.publishMutex.Lock()
+	defer r.publishMutex.Unlock()
+
+	if err := r.ensurePublisher(); err != nil {
+		return err
+	}
+
+	if !r.declared[topic] {
+		if err := declareQueue(r.publishCh, topic); err != nil {
+			r.resetPublisher()
+			return err
+		}
+		r.declared[topic] = true
+	}
+
+	return r.publish("", string(topic), jsonData)
+}
+
+// PublishEvent publishes to the durable events topic exchange. Subscribers
+// bind their own queues with patterns such as "user.*".
+func (r *RabbitMQ) PublishEvent(eventType string, data interface{}) error {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	r.publishMutex.Lock()
+	defer r.publishMutex.Unlock()
+
+	if err := r.ensurePublisher(); err != nil {
+		return err
+	}
+
+	if !r.exchanged {
+		if err := r.publishCh.ExchangeDeclare(
+			r.eventsExchange, // name
+			"topic",          // kind
+			true,             // durable
+			false,            // auto-delete
+			false,            // internal
+			false,            // no-wait
+			nil,              // arguments
+		); err != nil {
+			r.resetPublisher()
+			return fmt.Errorf("exchange declare failed: %w", err)
+		}
+		r.exchanged = true
+	}
+
+	return r.publish(r.eventsExchange, eventType, jsonData)
+}
+
+// publish sends a persistent message and waits for the broker confirmation.
+// It must be called with publishMutex held.
+func (r *RabbitMQ) publish(exchange, routingKey string, body []byte) error {
+	if err := r.publishCh.Publish(
+		exchange,   // exchange
+		routingKey, // routing key
+		false,      // mandatory
+		false,      // immediate
+		amqp.Publishing{
+			ContentType:  "application/json",
+			DeliveryMode: amqp.Persistent,
+			Body:         body,
+		},
+	); err != nil {
+		r.resetPublisher()
+		return fmt.Errorf("publish failed: %w", err)
+	}
+
+	select {
+	case confirm, ok := <-r.confirms:
+		if !ok {
+			r.resetPublisher()
+			return errors.New("channel closed before publish was confirmed")
+		}
+		if !confirm.Ack {
+			return fmt.Errorf("broker rejected message on %s", routingKey)
+		}
+		return nil
+	case <-time.After(r.confirmTimeout):
+		// A late confirmation would be read by the next publish, so the
+		// channel is discarded instead of reused.
+		r.resetPublisher()
+		return fmt.Errorf("timed out waiting for publish confirmation on %s", routingKey)
+	}
+}
+
+// ensurePublisher must be called with publishMutex held.
+func (r *RabbitMQ) ensurePublisher() error {
+	if r.publishCh != nil {
+		return nil
+	}
+
+	ch, err := r.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to create channel: %w", err)
+	}
+
+	if err := ch.Confirm(false); err != nil {
+		_ = ch.Close()
+		return fmt.Errorf("failed to enable publisher confirms: %w", err)
+	}
+
+	r.publishCh = ch
+	r.confirms = ch.NotifyPublish(make(chan amqp.Confirmation, 1))
+	r.declared = make(map[Topic]bool)
+	r.exchanged = false
+
+	return nil
+}
+
+// resetPublisher must be called with publishMutex held.
+func (r *RabbitMQ) resetPublisher() {
+	if r.publishCh != nil {
+		_ = r.publishCh.Close()
+	}
+
+	r.publishCh = nil
+	r.confirms = nil
+	r.declared = nil
+	r.exchanged = false
+}
+
+// Consume keeps a subscription open for the topic, re-subscribing on a fresh
+// channel every time the broker connection is re-established.
+func (r *RabbitMQ) Consume(ctx context.Context, topic Topic, concurrency int, handler MessageHandler) error {
+	for {
+		if err := r.WaitConnected(ctx); err != nil {
+			return nil
+		}
+
+		if err := r.consume(ctx, topic, concurrency, handler); err != nil {
+			log.Printf("Consumer for topic %s interrupted: %v", topic, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(resubscribeDelay):
+		}
+
+		log.Printf("Re-subscribing consumer for topic: %s", topic)
+	}
+}
+
+// declareQueue declares the queue for topic as durable, so persistent
+// messages survive a broker restart. Queues declared by earlier versions
+// are not durable and the broker refuses to redeclare them with other
+// flags: once the previous version has drained them, stop it and delete
+// the queues (make migrate-rabbitmq-queues) before starting this one.
+func declareQueue(ch *amqp.Channel, topic Topic) error {
+	_, err := ch.QueueDeclare(
+		string(topic), // name
+		true,          // durable
+		false,         // auto-delete
+		false,         // exclusive
+		false,         // no-wait
+		nil,           // arguments
+	)
+
+	var amqpErr *amqp.Error
+	if errors.As(err, &amqpErr) && amqpErr.Code == amqp.PreconditionFailed {
+		return fmt.Errorf("queue %s was declared with other flags, delete it so it can be redeclared as durable: %w", topic, err)
+	}
+	if err != nil {
+		return fmt.Errorf("queue declare failed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RabbitMQ) consume(ctx context.Context, topic Topic, concurrency int, handler MessageHandler) error {
+	ch, err := r.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to create channel: %w", err)
+	}
+	defer ch.Close()
+
+	if err := ch.Qos(concurrency, 0, false); err != nil {
+		return fmt.Errorf("failed to set qos: %w", err)
+	}
+
+	if err := declareQueue(ch, topic); err != nil {
+		return err
+	}
+
+	msgs, err := ch.Consume(
+		string(topic), // queue name
+		"",            // consumer
+		false,         // auto-ack
+		false,         // exclusive
+		false,         // no-local
+		false,         // no-wait
+		nil,           // args
+	)
+	if err != nil {
+		return fmt.Errorf("consume failed: %w", err)
+	}
+
+	log.Printf("Waiting for messages on queue: %s", topic)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case d, ok := <-msgs:
+					if !ok {
+						return
+					}
+
+					if err := handler(ctx, d.Body); err != nil {
+						log.Printf("Error handling message on topic %s: %v", topic, err)
+						_ = d.Nack(false, false)
+						continue
+					}
+
+					_ = d.Ack(false)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
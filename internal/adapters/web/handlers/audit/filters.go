@@ -0,0 +1,49 @@
+package audit
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/usecases/audit"
+)
+
+func parseListFilter(queries url.Values) (audit.ListFilterOptions, error) {
+	limit, _ := strconv.Atoi(queries.Get("limit"))
+	offset, _ := strconv.Atoi(queries.Get("offset"))
+
+	from, err := parseTime(queries, "from")
+	if err != nil {
+		return audit.ListFilterOptions{}, err
+	}
+
+	to, err := parseTime(queries, "to")
+	if err != nil {
+		return audit.ListFilterOptions{}, err
+	}
+
+	return audit.ListFilterOptions{
+		ActorID:  queries.Get("actor_id"),
+		TargetID: queries.Get("target_id"),
+		Action:   queries.Get("action"),
+		From:     from,
+		To:       to,
+		Limit:    limit,
+		Offset:   offset,
+	}, nil
+}
+
+func parseTime(queries url.Values, key string) (time.Time, error) {
+	value := queries.Get(key)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be an RFC 3339 timestamp", key)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,31 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/audit"
+)
+
+func NewListHandler(usecase audit.ListUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		filters, err := parseListFilter(c.Request.URL.Query())
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "Invalid query parameters",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		output, err := usecase.Execute(c, filters)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, output)
+	}
+}
@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/audit"
+)
+
+func NewListActivityHandler(usecase audit.ListActivityUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username, ok := c.Request.Context().Value("userID").(string)
+		if !ok || username == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"message": "unauthorized"})
+			return
+		}
+
+		filters, err := parseListFilter(c.Request.URL.Query())
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "Invalid query parameters",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		output, err := usecase.Execute(c, username, filters)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, output)
+	}
+}
@@ -0,0 +1,78 @@
+package audit_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	audit_handler "github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/audit"
+	auditUsecase "github.com/tapiaw38/auth-api-be/internal/usecases/audit"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/usecases/audit/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListActivityHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := map[string]struct {
+		username     string
+		setupUsecase func(*mock_audit.MockListActivityUsecase)
+		expectedCode int
+		expectedBody string
+	}{
+		"when the activity is listed": {
+			username: "jdoe",
+			setupUsecase: func(mockUsecase *mock_audit.MockListActivityUsecase) {
+				mockUsecase.EXPECT().Execute(gomock.Any(), "jdoe", auditUsecase.ListFilterOptions{}).
+					Return(&auditUsecase.ListOutput{
+						Data:  []auditUsecase.AuditEventOutputData{},
+						Limit: 50,
+					}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":[],"total":0,"limit":50,"offset":0}`,
+		},
+		"when the user is not authenticated": {
+			expectedCode: 401,
+			expectedBody: `{"message":"unauthorized"}`,
+		},
+		"when list activity usecase returns error": {
+			username: "jdoe",
+			setupUsecase: func(mockUsecase *mock_audit.MockListActivityUsecase) {
+				mockUsecase.EXPECT().Execute(gomock.Any(), "jdoe", gomock.Any()).Return(nil, assert.AnError)
+			},
+			expectedCode: 500,
+			expectedBody: `{"message":"` + assert.AnError.Error() + `"}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mock_audit.NewMockListActivityUsecase(ctrl)
+			if tc.setupUsecase != nil {
+				tc.setupUsecase(mockUsecase)
+			}
+
+			handler := audit_handler.NewListActivityHandler(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodGet, "/user/me/activity", nil)
+			if tc.username != "" {
+				req = req.WithContext(context.WithValue(req.Context(), "userID", tc.username))
+			}
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+
+			handler(c)
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+			assert.Equal(t, tc.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package audit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	audit_handler "github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/audit"
+	auditUsecase "github.com/tapiaw38/auth-api-be/internal/usecases/audit"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/usecases/audit/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := map[string]struct {
+		query        string
+		setupUsecase func(*mock_audit.MockListUsecase)
+		expectedCode int
+		expectedBody string
+	}{
+		"when filters are parsed from the query": {
+			query: "?actor_id=user-1&action=auth.login&from=2024-01-01T00:00:00Z&limit=10&offset=20",
+			setupUsecase: func(mockUsecase *mock_audit.MockListUsecase) {
+				mockUsecase.EXPECT().Execute(gomock.Any(), auditUsecase.ListFilterOptions{
+					ActorID: "user-1",
+					Action:  "auth.login",
+					From:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					Limit:   10,
+					Offset:  20,
+				}).Return(&auditUsecase.ListOutput{
+					Data:   []auditUsecase.AuditEventOutputData{},
+					Total:  21,
+					Limit:  10,
+					Offset: 20,
+				}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":[],"total":21,"limit":10,"offset":20}`,
+		},
+		"when the time filter is invalid": {
+			query:        "?to=yesterday",
+			expectedCode: 400,
+			expectedBody: `{"error":"to must be an RFC 3339 timestamp","message":"Invalid query parameters"}`,
+		},
+		"when list usecase returns error": {
+			setupUsecase: func(mockUsecase *mock_audit.MockListUsecase) {
+				mockUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			expectedCode: 500,
+			expectedBody: `{"message":"` + assert.AnError.Error() + `"}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mock_audit.NewMockListUsecase(ctrl)
+			if tc.setupUsecase != nil {
+				tc.setupUsecase(mockUsecase)
+			}
+
+			handler := audit_handler.NewListHandler(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodGet, "/admin/audit"+tc.query, nil)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+
+			handler(c)
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+			assert.Equal(t, tc.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/audit"
+)
+
+func NewVerifyHandler(usecase audit.VerifyUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		output, err := usecase.Execute(c)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, output)
+	}
+}
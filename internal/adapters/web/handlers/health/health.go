@@ -0,0 +1,42 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const checkTimeout = 2 * time.Second
+
+type Checker interface {
+	Name() string
+	Check(context.Context) error
+}
+
+func NewHealthHandler(checkers ...Checker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), checkTimeout)
+		defer cancel()
+
+		status := "ok"
+		statusCode := http.StatusOK
+		checks := gin.H{}
+
+		for _, checker := range checkers {
+			if err := checker.Check(ctx); err != nil {
+				status = "degraded"
+				statusCode = http.StatusServiceUnavailable
+				checks[checker.Name()] = err.Error()
+				continue
+			}
+			checks[checker.Name()] = "ok"
+		}
+
+		c.JSON(statusCode, gin.H{
+			"status": status,
+			"checks": checks,
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/health"
+)
+
+type fakeChecker struct {
+	name string
+	err  error
+}
+
+func (f fakeChecker) Name() string {
+	return f.name
+}
+
+func (f fakeChecker) Check(context.Context) error {
+	return f.err
+}
+
+func TestHealthHandler(t *testing.T) {
+	tests := map[string]struct {
+		checkers           []health.Checker
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"when all checks pass": {
+			checkers: []health.Checker{
+				fakeChecker{name: "rabbitmq"},
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"checks":{"rabbitmq":"ok"},"status":"ok"}`,
+		},
+		"when a check fails": {
+			checkers: []health.Checker{
+				fakeChecker{name: "rabbitmq", err: errors.New("queue is not connected")},
+			},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedBody:       `{"checks":{"rabbitmq":"queue is not connected"},"status":"degraded"}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/health", nil)
+
+			handler := health.NewHealthHandler(tc.checkers...)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.JSONEq(t, tc.expectedBody, w.Body.String())
+		})
+	}
+}
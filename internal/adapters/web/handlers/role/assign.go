@@ -0,0 +1,52 @@
+package role
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/role"
+)
+
+type AssignInput struct {
+	UserID   string `json:"user_id" binding:"required"`
+	RoleName string `json:"role_name" binding:"required"`
+}
+
+func NewAssignHandler(usecase role.AssignUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input AssignInput
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "Invalid request body",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		actor, _ := c.Request.Context().Value("userID").(string)
+
+		err := usecase.Execute(c, role.AssignInput{
+			UserID:   input.UserID,
+			RoleName: input.RoleName,
+			Actor:    actor,
+		})
+		if err != nil {
+			if errors.Is(err, domain.ErrInsufficientRole) {
+				c.JSON(http.StatusForbidden, gin.H{
+					"message": err.Error(),
+				})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"message": "role assigned successfully",
+		})
+	}
+}
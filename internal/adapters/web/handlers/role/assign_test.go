@@ -0,0 +1,88 @@
+package role_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	role_handler "github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/role"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	roleUsecase "github.com/tapiaw38/auth-api-be/internal/usecases/role"
+	mock_role "github.com/tapiaw38/auth-api-be/internal/usecases/role/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAssignHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := map[string]struct {
+		body         string
+		setupUsecase func(*mock_role.MockAssignUsecase)
+		expectedCode int
+		expectedBody string
+	}{
+		"when assign usecase executes successfully": {
+			body: `{"user_id":"user-1","role_name":"admin"}`,
+			setupUsecase: func(mockUsecase *mock_role.MockAssignUsecase) {
+				mockUsecase.EXPECT().Execute(gomock.Any(), roleUsecase.AssignInput{
+					UserID:   "user-1",
+					RoleName: "admin",
+					Actor:    "root",
+				}).Return(nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"message":"role assigned successfully"}`,
+		},
+		"when assign usecase returns error": {
+			body: `{"user_id":"user-1","role_name":"admin"}`,
+			setupUsecase: func(mockUsecase *mock_role.MockAssignUsecase) {
+				mockUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(assert.AnError)
+			},
+			expectedCode: 500,
+			expectedBody: `{"message":"` + assert.AnError.Error() + `"}`,
+		},
+		"when the caller does not outrank the role": {
+			body: `{"user_id":"user-1","role_name":"superadmin"}`,
+			setupUsecase: func(mockUsecase *mock_role.MockAssignUsecase) {
+				mockUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(domain.ErrInsufficientRole)
+			},
+			expectedCode: 403,
+			expectedBody: domain.ErrInsufficientRole.Error(),
+		},
+		"when the request body is invalid": {
+			body:         `{"user_id":"user-1"}`,
+			expectedCode: 400,
+			expectedBody: "Invalid request body",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mock_role.NewMockAssignUsecase(ctrl)
+			if tc.setupUsecase != nil {
+				tc.setupUsecase(mockUsecase)
+			}
+
+			handler := role_handler.NewAssignHandler(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/role/assign", bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = req.WithContext(context.WithValue(req.Context(), "userID", "root"))
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+
+			handler(c)
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+)
+
+// NewCreateTokenHandler serves POST /admin/scim/tokens.
+func NewCreateTokenHandler(usecase scim.CreateTokenUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input scim.CreateTokenInput
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "Invalid request body",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		input.Actor, _ = c.Request.Context().Value("userID").(string)
+
+		output, err := usecase.Execute(c, input)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusCreated, output)
+	}
+}
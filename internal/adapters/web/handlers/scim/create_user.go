@@ -0,0 +1,30 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+)
+
+// NewCreateUserHandler serves POST /scim/v2/Users.
+func NewCreateUserHandler(usecase scim.CreateUserUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var request scim.UserRequest
+		if !bind(c, &request) {
+			return
+		}
+
+		output, err := usecase.Execute(c, scim.CreateUserInput{
+			Request: request,
+			TokenID: tokenID(c),
+			Tenant:  tenant(c),
+		})
+		if err != nil {
+			renderError(c, err)
+			return
+		}
+
+		renderResource(c, http.StatusCreated, output, output.Meta)
+	}
+}
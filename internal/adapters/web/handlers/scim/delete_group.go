@@ -0,0 +1,25 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+)
+
+// NewDeleteGroupHandler serves DELETE /scim/v2/Groups/:id.
+func NewDeleteGroupHandler(usecase scim.DeleteGroupUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := usecase.Execute(c, scim.DeleteInput{
+			ID:      c.Param("id"),
+			IfMatch: c.GetHeader("If-Match"),
+			TokenID: tokenID(c),
+			Tenant:  tenant(c),
+		}); err != nil {
+			renderError(c, err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,64 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+)
+
+const (
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	schemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+)
+
+// NewServiceProviderConfigHandler serves GET /scim/v2/ServiceProviderConfig,
+// which tells identity providers which optional features are supported.
+func NewServiceProviderConfigHandler() gin.HandlerFunc {
+	config := gin.H{
+		"schemas":        []string{schemaServiceProviderConfig},
+		"patch":          gin.H{"supported": true},
+		"bulk":           gin.H{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         gin.H{"supported": true, "maxResults": 200},
+		"changePassword": gin.H{"supported": false},
+		"sort":           gin.H{"supported": false},
+		"etag":           gin.H{"supported": true},
+		"authenticationSchemes": []gin.H{{
+			"type":        "oauthbearertoken",
+			"name":        "Bearer Token",
+			"description": "A SCIM token issued from the admin API",
+		}},
+	}
+
+	return func(c *gin.Context) {
+		render(c, http.StatusOK, config)
+	}
+}
+
+// NewResourceTypesHandler serves GET /scim/v2/ResourceTypes.
+func NewResourceTypesHandler() gin.HandlerFunc {
+	resourceTypes := []gin.H{
+		{
+			"schemas":  []string{schemaResourceType},
+			"id":       "User",
+			"name":     "User",
+			"endpoint": "/Users",
+			"schema":   scim.SchemaUser,
+		},
+		{
+			"schemas":  []string{schemaResourceType},
+			"id":       "Group",
+			"name":     "Group",
+			"endpoint": "/Groups",
+			"schema":   scim.SchemaGroup,
+		},
+	}
+
+	return func(c *gin.Context) {
+		render(c, http.StatusOK, gin.H{
+			"schemas":      []string{scim.SchemaListResponse},
+			"totalResults": len(resourceTypes),
+			"Resources":    resourceTypes,
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+)
+
+// NewGetGroupHandler serves GET /scim/v2/Groups/:id.
+func NewGetGroupHandler(usecase scim.GetGroupUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		output, err := usecase.Execute(c, scim.GetInput{
+			ID:     c.Param("id"),
+			Tenant: tenant(c),
+		})
+		if err != nil {
+			renderError(c, err)
+			return
+		}
+
+		renderResource(c, http.StatusOK, output, output.Meta)
+	}
+}
@@ -0,0 +1,26 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+)
+
+// NewListGroupsHandler serves GET /scim/v2/Groups.
+func NewListGroupsHandler(usecase scim.ListGroupsUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		input, ok := pageInput(c)
+		if !ok {
+			return
+		}
+
+		output, err := usecase.Execute(c, input)
+		if err != nil {
+			renderError(c, err)
+			return
+		}
+
+		render(c, http.StatusOK, output)
+	}
+}
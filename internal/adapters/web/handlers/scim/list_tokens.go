@@ -0,0 +1,23 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+)
+
+// NewListTokensHandler serves GET /admin/scim/tokens.
+func NewListTokensHandler(usecase scim.ListTokensUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		output, err := usecase.Execute(c)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, output)
+	}
+}
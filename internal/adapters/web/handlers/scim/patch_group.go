@@ -0,0 +1,32 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+)
+
+// NewPatchGroupHandler serves PATCH /scim/v2/Groups/:id.
+func NewPatchGroupHandler(usecase scim.PatchGroupUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var request scim.PatchRequest
+		if !bind(c, &request) {
+			return
+		}
+
+		output, err := usecase.Execute(c, scim.PatchGroupInput{
+			ID:      c.Param("id"),
+			Request: request,
+			IfMatch: c.GetHeader("If-Match"),
+			TokenID: tokenID(c),
+			Tenant:  tenant(c),
+		})
+		if err != nil {
+			renderError(c, err)
+			return
+		}
+
+		renderResource(c, http.StatusOK, output, output.Meta)
+	}
+}
@@ -0,0 +1,111 @@
+package scim_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/scim"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/scim/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPatchUserHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockPatchUserUsecase
+	}
+
+	body := `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","path":"active","value":false}]}`
+
+	tests := map[string]struct {
+		body               string
+		prepare            func(f *fields)
+		expectedStatusCode int
+		expectedBody       string
+		expectedETag       string
+	}{
+		"when the user is patched": {
+			body: body,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, input usecase.PatchUserInput) (*usecase.UserResource, error) {
+						assert.Equal(t, "user-123", input.ID)
+						assert.Equal(t, `W/"v1"`, input.IfMatch)
+						assert.Equal(t, "token-1", input.TokenID)
+						assert.Len(t, input.Request.Operations, 1)
+						return &usecase.UserResource{
+							ID:   "user-123",
+							Meta: &usecase.Meta{Version: `W/"v2"`},
+						}, nil
+					},
+				)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"id":"user-123"`,
+			expectedETag:       `W/"v2"`,
+		},
+		"when the usecase returns a SCIM error": {
+			body: body,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, &usecase.Error{
+					Status:   http.StatusConflict,
+					ScimType: "uniqueness",
+					Detail:   "userName is already in use",
+				})
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `"scimType":"uniqueness"`,
+		},
+		"when the usecase fails": {
+			body: body,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       `"status":"500"`,
+		},
+		"when the request body is invalid": {
+			body:               `not json`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"scimType":"invalidSyntax"`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockPatchUserUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			c.Request = httptest.NewRequest(http.MethodPatch, "/scim/v2/Users/user-123", strings.NewReader(tc.body))
+			c.Request.Header.Set("If-Match", `W/"v1"`)
+			c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), "scimTokenID", "token-1"))
+			c.Params = gin.Params{{Key: "id", Value: "user-123"}}
+
+			handler := scim.NewPatchUserHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Equal(t, "application/scim+json", w.Header().Get("Content-Type"))
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+			assert.Equal(t, tc.expectedETag, w.Header().Get("ETag"))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package scim
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+)
+
+const contentType = "application/scim+json"
+
+func render(c *gin.Context, status int, body any) {
+	c.Header("Content-Type", contentType)
+	c.JSON(status, body)
+}
+
+// renderResource also sets the ETag header from meta.version.
+func renderResource(c *gin.Context, status int, body any, meta *scim.Meta) {
+	if meta != nil {
+		c.Header("ETag", meta.Version)
+		if status == http.StatusCreated {
+			c.Header("Location", meta.Location)
+		}
+	}
+
+	render(c, status, body)
+}
+
+func renderError(c *gin.Context, err error) {
+	status, body := scim.NewErrorResponse(err)
+	render(c, status, body)
+}
+
+// bind decodes the request body and reports a SCIM error when it is not
+// valid JSON.
+func bind(c *gin.Context, v any) bool {
+	if err := c.ShouldBindJSON(v); err != nil {
+		renderError(c, scim.NewInvalidSyntaxError(err))
+		return false
+	}
+
+	return true
+}
+
+func tokenID(c *gin.Context) string {
+	id, _ := c.Request.Context().Value("scimTokenID").(string)
+	return id
+}
+
+func tenant(c *gin.Context) string {
+	tenant, _ := c.Request.Context().Value("scimTenant").(string)
+	return tenant
+}
+
+// pageInput reads the filter, startIndex and count query parameters.
+func pageInput(c *gin.Context) (scim.PageInput, bool) {
+	input := scim.PageInput{Filter: c.Query("filter"), Tenant: tenant(c)}
+
+	if value := c.Query("startIndex"); value != "" {
+		startIndex, err := strconv.Atoi(value)
+		if err != nil {
+			renderError(c, scim.NewInvalidSyntaxError(err))
+			return input, false
+		}
+		input.StartIndex = startIndex
+	}
+
+	if value := c.Query("count"); value != "" {
+		count, err := strconv.Atoi(value)
+		if err != nil {
+			renderError(c, scim.NewInvalidSyntaxError(err))
+			return input, false
+		}
+		input.Count = &count
+	}
+
+	return input, true
+}
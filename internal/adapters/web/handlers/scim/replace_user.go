@@ -0,0 +1,32 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+)
+
+// NewReplaceUserHandler serves PUT /scim/v2/Users/:id.
+func NewReplaceUserHandler(usecase scim.ReplaceUserUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var request scim.UserRequest
+		if !bind(c, &request) {
+			return
+		}
+
+		output, err := usecase.Execute(c, scim.ReplaceUserInput{
+			ID:      c.Param("id"),
+			Request: request,
+			IfMatch: c.GetHeader("If-Match"),
+			TokenID: tokenID(c),
+			Tenant:  tenant(c),
+		})
+		if err != nil {
+			renderError(c, err)
+			return
+		}
+
+		renderResource(c, http.StatusOK, output, output.Meta)
+	}
+}
@@ -0,0 +1,27 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+)
+
+// NewRevokeTokenHandler serves DELETE /admin/scim/tokens/:id.
+func NewRevokeTokenHandler(usecase scim.RevokeTokenUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		input := scim.RevokeTokenInput{ID: c.Param("id")}
+		input.Actor, _ = c.Request.Context().Value("userID").(string)
+
+		if err := usecase.Execute(c, input); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"message": "SCIM token revoked successfully",
+		})
+	}
+}
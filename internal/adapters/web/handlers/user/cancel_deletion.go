@@ -0,0 +1,27 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+func NewCancelDeletionHandler(usecase user.CancelDeletionUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username, ok := c.Request.Context().Value("userID").(string)
+		if !ok || username == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"message": "unauthorized"})
+			return
+		}
+
+		if err := usecase.Execute(c, username); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
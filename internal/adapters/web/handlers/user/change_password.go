@@ -23,6 +23,10 @@ func NewChangePasswordHandler(usecase user.ChangePasswordUsecase) func(c *gin.Co
 		}
 
 		if err := usecase.Execute(c, request, username); err != nil {
+			if policyErr, ok := asPasswordPolicyError(err); ok {
+				c.JSON(http.StatusBadRequest, gin.H{"error": policyErr.Message, "code": policyErr.Code})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
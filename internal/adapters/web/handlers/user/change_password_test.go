@@ -13,6 +13,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
 	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
 	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
 	"go.uber.org/mock/gomock"
@@ -50,6 +51,20 @@ func TestChangePasswordHandler(t *testing.T) {
 			expectedStatusCode: http.StatusInternalServerError,
 			expectedErr:        errors.New("some error"),
 		},
+		"when the new password is rejected by the policy": {
+			body: usecase.ChangePasswordInput{
+				OldPassword: "old_password",
+				NewPassword: "Password1!",
+			},
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any(), "user-123").Return(&auth.PasswordPolicyError{
+					Code:    auth.PasswordBreached,
+					Message: "password has appeared in a data breach, please choose another one",
+				})
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        errors.New(`"code":"password_breached"`),
+		},
 		"when request body is invalid": {
 			body:               "invalid body",
 			prepare:            nil,
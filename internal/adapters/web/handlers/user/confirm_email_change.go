@@ -0,0 +1,30 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+func NewConfirmEmailChangeHandler(usecase user.ConfirmEmailChangeUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.Query("token")
+		if token == "" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "token is required",
+			})
+			return
+		}
+
+		redirectURL, err := usecase.Execute(c, token)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.Redirect(http.StatusFound, redirectURL)
+	}
+}
@@ -0,0 +1,86 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestConfirmPhoneVerificationHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockConfirmPhoneVerificationUsecase
+	}
+
+	tests := map[string]struct {
+		body               string
+		prepare            func(f *fields)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"when the phone number is verified": {
+			body: `{"code":"123456"}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), usecase.ConfirmPhoneVerificationInput{
+					Code:     "123456",
+					Username: "jdoe",
+				}).Return(&usecase.ConfirmPhoneVerificationOutput{
+					Data: usecase.UserOutputData{ID: "user-123", VerifiedPhone: true},
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"verified_phone":true`,
+		},
+		"when the code is wrong": {
+			body: `{"code":"000000"}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("invalid verification code"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "invalid verification code",
+		},
+		"when the request body is invalid": {
+			body:               `{`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid request body",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockConfirmPhoneVerificationUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			c.Request = httptest.NewRequest(http.MethodPost, "/user/me/phone/verify/confirm", strings.NewReader(tc.body))
+			c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), "userID", "jdoe"))
+
+			handler := user.NewConfirmPhoneVerificationHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
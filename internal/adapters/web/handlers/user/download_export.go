@@ -0,0 +1,32 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+func NewDownloadExportHandler(usecase user.DownloadExportUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.Query("token")
+		if token == "" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "token is required",
+			})
+			return
+		}
+
+		output, err := usecase.Execute(c, token)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.Header("Content-Disposition", `attachment; filename="`+output.FileName+`"`)
+		c.Header("Cache-Control", "no-store")
+		c.Data(http.StatusOK, "application/json", output.Payload)
+	}
+}
@@ -0,0 +1,83 @@
+package user_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDownloadExportHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockDownloadExportUsecase
+	}
+
+	tests := map[string]struct {
+		query               string
+		prepare             func(f *fields)
+		expectedStatusCode  int
+		expectedBody        string
+		expectedDisposition string
+	}{
+		"when the link is valid": {
+			query: "?token=abc",
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), "abc").Return(&usecase.DownloadExportOutput{
+					FileName: "export-20240101T000000Z.json",
+					Payload:  []byte(`{"profile":{}}`),
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedBody:        `{"profile":{}}`,
+			expectedDisposition: `attachment; filename="export-20240101T000000Z.json"`,
+		},
+		"when the link has expired": {
+			query: "?token=abc",
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), "abc").
+					Return(nil, errors.New("export link is invalid or has expired"))
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       "export link is invalid or has expired",
+		},
+		"when the token is missing": {
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "token is required",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockDownloadExportUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			c.Request = httptest.NewRequest(http.MethodGet, "/user/export/download"+tc.query, nil)
+
+			handler := user.NewDownloadExportHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+			assert.Equal(t, tc.expectedDisposition, w.Header().Get("Content-Disposition"))
+		})
+	}
+}
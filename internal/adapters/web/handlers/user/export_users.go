@@ -0,0 +1,69 @@
+package user
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+// NewExportUsersHandler serves GET /admin/users/export. The format query
+// parameter is csv (the default) or ndjson, and include_password_hash
+// adds password hashes to the file.
+func NewExportUsersHandler(usecase user.ExportUsersUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		input := user.ExportUsersInput{
+			Format: c.DefaultQuery("format", "csv"),
+		}
+
+		var err error
+		if input.IncludePasswordHash, err = queryBool(c, "include_password_hash"); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"message": "include_password_hash must be a boolean"})
+			return
+		}
+
+		input.Actor, _ = c.Request.Context().Value("userID").(string)
+
+		writer := &exportResponseWriter{context: c, format: input.Format}
+		if err := usecase.Execute(c, input, writer); err != nil {
+			if !writer.started {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"message": err.Error(),
+				})
+				return
+			}
+
+			// The status line is already sent, so the truncated body is the
+			// only signal left for the client.
+			log.Printf("User export interrupted: %v", err)
+			c.Abort()
+		}
+	}
+}
+
+// exportResponseWriter sends the download headers on the first write, so an
+// error raised before any data can still be answered with JSON.
+type exportResponseWriter struct {
+	context *gin.Context
+	format  string
+	started bool
+}
+
+func (w *exportResponseWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+
+		contentType, extension := "text/csv", "csv"
+		if w.format == "ndjson" {
+			contentType, extension = "application/x-ndjson", "ndjson"
+		}
+
+		w.context.Header("Content-Type", contentType)
+		w.context.Header("Content-Disposition", `attachment; filename="users.`+extension+`"`)
+		w.context.Header("Cache-Control", "no-store")
+		w.context.Status(http.StatusOK)
+	}
+
+	return w.context.Writer.Write(p)
+}
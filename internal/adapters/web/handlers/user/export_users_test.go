@@ -0,0 +1,92 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportUsersHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockExportUsersUsecase
+	}
+
+	tests := map[string]struct {
+		query               string
+		prepare             func(f *fields)
+		expectedStatusCode  int
+		expectedContentType string
+		expectedBody        string
+	}{
+		"when users are exported as ndjson": {
+			query: "?format=ndjson&include_password_hash=true",
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), usecase.ExportUsersInput{
+					Format:              "ndjson",
+					IncludePasswordHash: true,
+					Actor:               "admin",
+				}, gomock.Any()).DoAndReturn(func(ctx context.Context, input usecase.ExportUsersInput, w io.Writer) error {
+					_, err := w.Write([]byte(`{"email":"jane@example.com"}` + "\n"))
+					return err
+				})
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedContentType: "application/x-ndjson",
+			expectedBody:        "jane@example.com",
+		},
+		"when the export fails before any data is written": {
+			query: "?format=xml",
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("format must be csv or ndjson"))
+			},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedContentType: "application/json",
+			expectedBody:        "format must be csv or ndjson",
+		},
+		"when include_password_hash is not a boolean": {
+			query:               "?include_password_hash=maybe",
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedContentType: "application/json",
+			expectedBody:        "include_password_hash must be a boolean",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockExportUsersUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			c.Request = httptest.NewRequest(http.MethodGet, "/admin/users/export"+tc.query, nil)
+			c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), "userID", "admin"))
+
+			handler := user.NewExportUsersHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Header().Get("Content-Type"), tc.expectedContentType)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+// NewGetImportJobHandler serves GET /admin/users/import/:id.
+func NewGetImportJobHandler(usecase user.GetImportJobUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		output, err := usecase.Execute(c, c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, output)
+	}
+}
@@ -0,0 +1,125 @@
+package user
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+const (
+	importFormField = "file"
+
+	// maxImportRequestSize caps an uploaded import file.
+	maxImportRequestSize = 10 << 20
+)
+
+// NewImportUsersHandler serves POST /admin/users/import. The file is sent
+// either in the "file" field of a multipart form or as the raw body. The
+// format comes from the format query parameter, or else from the file
+// extension or Content-Type. dry_run and send_invites are optional
+// booleans.
+func NewImportUsersHandler(usecase user.ImportUsersUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportRequestSize)
+
+		input := user.ImportUsersInput{
+			Format: c.Query("format"),
+		}
+
+		var err error
+		if input.DryRun, err = queryBool(c, "dry_run"); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"message": "dry_run must be a boolean"})
+			return
+		}
+		if input.SendInvites, err = queryBool(c, "send_invites"); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"message": "send_invites must be a boolean"})
+			return
+		}
+
+		mediaType, _, _ := mime.ParseMediaType(c.ContentType())
+		if mediaType == "multipart/form-data" {
+			fileHeader, err := c.FormFile(importFormField)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"message": "import file is required",
+					"error":   err.Error(),
+				})
+				return
+			}
+
+			file, err := fileHeader.Open()
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+				return
+			}
+			defer file.Close()
+
+			if input.Payload, err = io.ReadAll(file); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+				return
+			}
+
+			if input.Format == "" {
+				input.Format = importFormatFromName(fileHeader.Filename)
+			}
+		} else {
+			if input.Payload, err = io.ReadAll(c.Request.Body); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+				return
+			}
+
+			if input.Format == "" {
+				input.Format = importFormatFromMediaType(mediaType)
+			}
+		}
+
+		input.Actor, _ = c.Request.Context().Value("userID").(string)
+
+		output, err := usecase.Execute(c, input)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, output)
+	}
+}
+
+func queryBool(c *gin.Context, name string) (bool, error) {
+	value := c.Query(name)
+	if value == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(value)
+}
+
+func importFormatFromName(name string) string {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return "csv"
+	case ".ndjson", ".jsonl":
+		return "ndjson"
+	}
+
+	return ""
+}
+
+func importFormatFromMediaType(mediaType string) string {
+	switch mediaType {
+	case "text/csv":
+		return "csv"
+	case "application/x-ndjson", "application/ndjson", "application/jsonl":
+		return "ndjson"
+	}
+
+	return ""
+}
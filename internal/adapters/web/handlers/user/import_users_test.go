@@ -0,0 +1,126 @@
+package user_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImportUsersHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockImportUsersUsecase
+	}
+
+	csvFile := "first_name,last_name,email\nJane,Doe,jane@example.com\n"
+
+	multipartRequest := func() *http.Request {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, _ := writer.CreateFormFile("file", "users.csv")
+		part.Write([]byte(csvFile))
+		writer.Close()
+
+		request := httptest.NewRequest(http.MethodPost, "/admin/users/import?send_invites=true", body)
+		request.Header.Set("Content-Type", writer.FormDataContentType())
+		return request
+	}
+
+	tests := map[string]struct {
+		request            func() *http.Request
+		prepare            func(f *fields)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"when a file is uploaded in a multipart form": {
+			request: multipartRequest,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), usecase.ImportUsersInput{
+					Format:      "csv",
+					SendInvites: true,
+					Payload:     []byte(csvFile),
+					Actor:       "admin",
+				}).Return(&usecase.ImportJobOutput{
+					Data: usecase.ImportJobOutputData{ID: "job-1", Status: "pending"},
+				}, nil)
+			},
+			expectedStatusCode: http.StatusAccepted,
+			expectedBody:       "job-1",
+		},
+		"when the file is sent as the raw body": {
+			request: func() *http.Request {
+				request := httptest.NewRequest(http.MethodPost, "/admin/users/import?dry_run=true", strings.NewReader(`{"email":"jane@example.com"}`))
+				request.Header.Set("Content-Type", "application/x-ndjson")
+				return request
+			},
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), usecase.ImportUsersInput{
+					Format:  "ndjson",
+					DryRun:  true,
+					Payload: []byte(`{"email":"jane@example.com"}`),
+					Actor:   "admin",
+				}).Return(&usecase.ImportJobOutput{
+					Data: usecase.ImportJobOutputData{ID: "job-2", DryRun: true},
+				}, nil)
+			},
+			expectedStatusCode: http.StatusAccepted,
+			expectedBody:       "job-2",
+		},
+		"when dry_run is not a boolean": {
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/admin/users/import?dry_run=maybe", strings.NewReader(csvFile))
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "dry_run must be a boolean",
+		},
+		"when the usecase rejects the file": {
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/admin/users/import", strings.NewReader(csvFile))
+			},
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("format must be csv or ndjson"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "format must be csv or ndjson",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockImportUsersUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			c.Request = tc.request()
+			c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), "userID", "admin"))
+
+			handler := user.NewImportUsersHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+// NewListHandler serves GET /admin/users. Besides limit, offset and
+// cursor, it takes search, role, is_active, verified_email, auth_method,
+// created_from, created_to (RFC 3339), sort, order and include_deleted.
+func NewListHandler(usecase user.ListUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		filters, err := parseListFilter(c.Request.URL.Query())
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "Invalid query parameters",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		output, err := usecase.Execute(c, filters)
+		if err != nil {
+			if errors.Is(err, user.ErrInvalidListFilter) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"message": "Invalid query parameters",
+					"error":   err.Error(),
+				})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, output)
+	}
+}
+
+func parseListFilter(queries url.Values) (user.ListFilterOptions, error) {
+	limit, _ := strconv.Atoi(queries.Get("limit"))
+	offset, _ := strconv.Atoi(queries.Get("offset"))
+
+	filters := user.ListFilterOptions{
+		Search:     queries.Get("search"),
+		Role:       queries.Get("role"),
+		AuthMethod: queries.Get("auth_method"),
+		SortBy:     queries.Get("sort"),
+		Order:      queries.Get("order"),
+		Cursor:     queries.Get("cursor"),
+		Limit:      limit,
+		Offset:     offset,
+	}
+
+	var err error
+	if filters.IsActive, err = parseOptionalBool(queries, "is_active"); err != nil {
+		return user.ListFilterOptions{}, err
+	}
+	if filters.VerifiedEmail, err = parseOptionalBool(queries, "verified_email"); err != nil {
+		return user.ListFilterOptions{}, err
+	}
+	if includeDeleted, err := parseOptionalBool(queries, "include_deleted"); err != nil {
+		return user.ListFilterOptions{}, err
+	} else if includeDeleted != nil {
+		filters.IncludeDeleted = *includeDeleted
+	}
+	if filters.CreatedFrom, err = parseTime(queries, "created_from"); err != nil {
+		return user.ListFilterOptions{}, err
+	}
+	if filters.CreatedTo, err = parseTime(queries, "created_to"); err != nil {
+		return user.ListFilterOptions{}, err
+	}
+
+	return filters, nil
+}
+
+func parseOptionalBool(queries url.Values, key string) (*bool, error) {
+	value := queries.Get(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a boolean", key)
+	}
+
+	return &b, nil
+}
+
+func parseTime(queries url.Values, key string) (time.Time, error) {
+	value := queries.Get(key)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be an RFC 3339 timestamp", key)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,107 @@
+package user_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockListUsecase
+	}
+
+	active := true
+
+	tests := map[string]struct {
+		query              string
+		prepare            func(f *fields)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"when users are listed with filters": {
+			query: "?search=jane&role=admin&is_active=true&created_from=2024-01-01T00:00:00Z&sort=name&order=desc&limit=20",
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), usecase.ListFilterOptions{
+					Search:      "jane",
+					Role:        "admin",
+					IsActive:    &active,
+					CreatedFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					SortBy:      "name",
+					Order:       "desc",
+					Limit:       20,
+				}).Return(&usecase.ListOutput{
+					Data:       []usecase.UserOutputData{{ID: "user-123"}},
+					Total:      1,
+					Limit:      20,
+					NextCursor: "abc",
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"total":1`,
+		},
+		"when a boolean filter is invalid": {
+			query:              "?is_active=maybe",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "is_active must be a boolean",
+		},
+		"when a date filter is invalid": {
+			query:              "?created_to=yesterday",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "created_to must be an RFC 3339 timestamp",
+		},
+		"when the usecase rejects the filter": {
+			query: "?sort=password",
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("%w: sort must be name, email or created_at", usecase.ErrInvalidListFilter))
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "sort must be name, email or created_at",
+		},
+		"when the usecase returns an error": {
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "database error",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockListUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			c.Request = httptest.NewRequest(http.MethodGet, "/admin/users"+tc.query, nil)
+
+			handler := user.NewListHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package user
+
+import (
+	"errors"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+// asPasswordPolicyError unwraps a password rejected by the policy, which
+// handlers report as a 400 with its code.
+func asPasswordPolicyError(err error) (*auth.PasswordPolicyError, bool) {
+	var policyErr *auth.PasswordPolicyError
+	if errors.As(err, &policyErr) {
+		return policyErr, true
+	}
+
+	return nil, false
+}
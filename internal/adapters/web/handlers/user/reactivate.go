@@ -0,0 +1,46 @@
+package user
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+// NewReactivateHandler serves POST /admin/users/:id/reactivate. The body
+// is optional.
+func NewReactivateHandler(usecase user.ReactivateUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input user.ReactivateInput
+		if c.Request.ContentLength != 0 {
+			if err := c.ShouldBindJSON(&input); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"message": "Invalid request body",
+					"error":   err.Error(),
+				})
+				return
+			}
+		}
+
+		input.UserID = c.Param("id")
+		input.Actor, _ = c.Request.Context().Value("userID").(string)
+
+		output, err := usecase.Execute(c, input)
+		if err != nil {
+			if errors.Is(err, domain.ErrInsufficientRole) {
+				c.JSON(http.StatusForbidden, gin.H{
+					"message": err.Error(),
+				})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, output)
+	}
+}
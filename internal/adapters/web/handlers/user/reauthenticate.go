@@ -0,0 +1,43 @@
+package user
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+func NewReauthenticateHandler(usecase user.ReauthenticateUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username, ok := c.Request.Context().Value("userID").(string)
+		if !ok || username == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"message": "unauthorized"})
+			return
+		}
+
+		var input user.ReauthenticateInput
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "Invalid request body",
+			})
+			return
+		}
+
+		output, err := usecase.Execute(c.Request.Context(), input, username)
+		if err != nil {
+			if errors.Is(err, user.ErrReauthenticationFailed) {
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"message": err.Error(),
+				})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, output)
+	}
+}
@@ -0,0 +1,88 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReauthenticateHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockReauthenticateUsecase
+	}
+
+	tests := map[string]struct {
+		body               string
+		prepare            func(f *fields)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"when the password is accepted": {
+			body: `{"password":"Password123!"}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), usecase.ReauthenticateInput{Password: "Password123!"}, "jdoe").
+					Return(&usecase.ReauthenticateOutput{Token: "new-token", Methods: []string{"pwd"}}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "new-token",
+		},
+		"when the password is wrong": {
+			body: `{"password":"nope"}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any(), "jdoe").Return(nil, usecase.ErrReauthenticationFailed)
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedBody:       "reauthentication failed",
+		},
+		"when the usecase returns an error": {
+			body: `{}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any(), "jdoe").Return(nil, errors.New("password is required"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "password is required",
+		},
+		"when the request body is invalid": {
+			body:               `not json`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid request body",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockReauthenticateUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/auth/reauthenticate", strings.NewReader(tc.body))
+			c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), "userID", "jdoe"))
+
+			handler := user.NewReauthenticateHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
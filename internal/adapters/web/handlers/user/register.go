@@ -19,6 +19,13 @@ func NewRegisterHandler(usecase user.RegisterUsecase) gin.HandlerFunc {
 
 		output, err := usecase.Execute(c, user)
 		if err != nil {
+			if policyErr, ok := asPasswordPolicyError(err); ok {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"message": policyErr.Message,
+					"code":    policyErr.Code,
+				})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"message": err.Error(),
 			})
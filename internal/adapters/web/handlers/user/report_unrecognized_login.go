@@ -0,0 +1,38 @@
+package user
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+func NewReportUnrecognizedLoginHandler(usecase user.ReportUnrecognizedLoginUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input user.ReportUnrecognizedLoginInput
+		if err := c.ShouldBindJSON(&input); err != nil || input.Token == "" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "token is required",
+			})
+			return
+		}
+
+		if err := usecase.Execute(c, input); err != nil {
+			if errors.Is(err, user.ErrInvalidReportToken) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"message": err.Error(),
+				})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"message": "Sessions revoked, check your email to reset your password",
+		})
+	}
+}
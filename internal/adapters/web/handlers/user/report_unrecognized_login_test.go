@@ -0,0 +1,86 @@
+package user_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReportUnrecognizedLoginHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockReportUnrecognizedLoginUsecase
+	}
+
+	tests := map[string]struct {
+		body               string
+		prepare            func(f *fields)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"when the login is reported": {
+			body: `{"token":"plain-token"}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), usecase.ReportUnrecognizedLoginInput{Token: "plain-token"}).Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "Sessions revoked",
+		},
+		"when the token is invalid": {
+			body: `{"token":"used-token"}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(usecase.ErrInvalidReportToken)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "token expired or invalid",
+		},
+		"when the usecase fails": {
+			body: `{"token":"plain-token"}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "database error",
+		},
+		"when the token is missing": {
+			body:               `{}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "token is required",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockReportUnrecognizedLoginUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			c.Request = httptest.NewRequest(http.MethodPost, "/auth/not-me", strings.NewReader(tc.body))
+
+			handler := user.NewReportUnrecognizedLoginHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+// NewRequestEmailChangeHandler serves POST /user/me/email. The address is
+// only swapped once the link sent to it is followed.
+func NewRequestEmailChangeHandler(usecase user.RequestEmailChangeUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input user.RequestEmailChangeInput
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "Invalid request body",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		username, ok := c.Request.Context().Value("userID").(string)
+		if !ok || username == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"message": "unauthorized"})
+			return
+		}
+
+		input.Username = username
+
+		output, err := usecase.Execute(c, input)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, output)
+	}
+}
@@ -0,0 +1,87 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRequestEmailChangeHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockRequestEmailChangeUsecase
+	}
+
+	tests := map[string]struct {
+		body               string
+		prepare            func(f *fields)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"when the change is requested": {
+			body: `{"new_email":"new@example.com"}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), usecase.RequestEmailChangeInput{
+					NewEmail: "new@example.com",
+					Username: "jdoe",
+				}).Return(&usecase.RequestEmailChangeOutput{
+					Data: usecase.ResetPasswordOutputData{Email: "new@example.com"},
+				}, nil)
+			},
+			expectedStatusCode: http.StatusAccepted,
+			expectedBody:       "new@example.com",
+		},
+		"when the usecase returns an error": {
+			body: `{"new_email":"new@example.com"}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("email already in use"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "email already in use",
+		},
+		"when the request body is invalid": {
+			body:               `not json`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid request body",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockRequestEmailChangeUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			c.Request = httptest.NewRequest(http.MethodPost, "/user/me/email", strings.NewReader(tc.body))
+			ctx := context.WithValue(c.Request.Context(), "userID", "jdoe")
+			c.Request = c.Request.WithContext(ctx)
+
+			handler := user.NewRequestEmailChangeHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+// NewRequestExportHandler serves POST /user/me/export. The archive is
+// fetched separately from the returned download link.
+func NewRequestExportHandler(usecase user.RequestExportUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username, ok := c.Request.Context().Value("userID").(string)
+		if !ok || username == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"message": "unauthorized"})
+			return
+		}
+
+		output, err := usecase.Execute(c, username)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusCreated, output)
+	}
+}
@@ -0,0 +1,45 @@
+package user
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+// NewRequestResetPasswordHandler serves POST /auth/forgot-password. The
+// response is the same whether or not the email has an account.
+func NewRequestResetPasswordHandler(usecase user.RequestResetPasswordUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input ForgotPasswordRequest
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "Invalid request format",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		if strings.TrimSpace(input.Email) == "" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "Email is required",
+			})
+			return
+		}
+
+		output, err := usecase.Execute(c.Request.Context(), input.Email)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, output)
+	}
+}
@@ -0,0 +1,86 @@
+package user_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRequestResetPasswordHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockRequestResetPasswordUsecase
+	}
+
+	tests := map[string]struct {
+		body               any
+		prepare            func(f *fields)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"when the request is accepted": {
+			body: user.ForgotPasswordRequest{Email: "jane@example.com"},
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), "jane@example.com").Return(&usecase.RequestResetPasswordOutput{
+					Data: usecase.ResetPasswordOutputData{Email: "jane@example.com", Message: "sent"},
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "sent",
+		},
+		"when the usecase fails": {
+			body: user.ForgotPasswordRequest{Email: "jane@example.com"},
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), "jane@example.com").Return(nil, errors.New("database error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "database error",
+		},
+		"when the email is missing": {
+			body:               user.ForgotPasswordRequest{},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Email is required",
+		},
+		"when request body is invalid": {
+			body:               "invalid body",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockRequestResetPasswordUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			bodyBytes, _ := json.Marshal(tc.body)
+			c.Request = httptest.NewRequest(http.MethodPost, "/auth/forgot-password", bytes.NewReader(bodyBytes))
+
+			handler := user.NewRequestResetPasswordHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package user
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+// NewResendVerificationHandler serves POST /auth/verify-email/resend for
+// the authenticated user.
+func NewResendVerificationHandler(usecase user.ResendVerificationUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username, ok := c.Request.Context().Value("userID").(string)
+		if !ok || username == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"message": "unauthorized"})
+			return
+		}
+
+		output, err := usecase.Execute(c.Request.Context(), username)
+		if err != nil {
+			if errors.Is(err, user.ErrVerificationResendTooSoon) {
+				c.JSON(http.StatusTooManyRequests, gin.H{
+					"message": err.Error(),
+				})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, output)
+	}
+}
@@ -0,0 +1,89 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResendVerificationHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockResendVerificationUsecase
+	}
+
+	tests := map[string]struct {
+		username           string
+		prepare            func(f *fields)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"when the email is sent": {
+			username: "jdoe",
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), "jdoe").Return(&usecase.ResendVerificationOutput{
+					Data: usecase.ResendVerificationOutputData{Email: "jane@example.com", RetryAfterSeconds: 300},
+				}, nil)
+			},
+			expectedStatusCode: http.StatusAccepted,
+			expectedBody:       "jane@example.com",
+		},
+		"when the email was sent recently": {
+			username: "jdoe",
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), "jdoe").Return(nil, usecase.ErrVerificationResendTooSoon)
+			},
+			expectedStatusCode: http.StatusTooManyRequests,
+			expectedBody:       usecase.ErrVerificationResendTooSoon.Error(),
+		},
+		"when the usecase returns an error": {
+			username: "jdoe",
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), "jdoe").Return(nil, errors.New("email already verified"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "email already verified",
+		},
+		"when the request is not authenticated": {
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedBody:       "unauthorized",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockResendVerificationUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/auth/verify-email/resend", nil)
+			if tc.username != "" {
+				c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), "userID", tc.username))
+			}
+
+			handler := user.NewResendVerificationHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -34,15 +34,15 @@ func NewResetPasswordHandler(usecase user.ResetPasswordUsecase) func(c *gin.Cont
 			return
 		}
 
-		if len(input.Password) < 8 {
-			c.JSON(http.StatusBadRequest, gin.H{
-				"message": "Password must be at least 8 characters long",
-			})
-			return
-		}
-
 		output, err := usecase.Execute(c, input)
 		if err != nil {
+			if policyErr, ok := asPasswordPolicyError(err); ok {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"message": policyErr.Message,
+					"code":    policyErr.Code,
+				})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"message": err.Error(),
 			})
@@ -0,0 +1,32 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+// NewScheduleDeletionHandler serves POST /user/me/delete. The account is
+// only erased once the grace period ends.
+func NewScheduleDeletionHandler(usecase user.ScheduleDeletionUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username, ok := c.Request.Context().Value("userID").(string)
+		if !ok || username == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"message": "unauthorized"})
+			return
+		}
+
+		output, err := usecase.Execute(c, user.ScheduleDeletionInput{
+			Username: username,
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, output)
+	}
+}
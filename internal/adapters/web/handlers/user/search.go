@@ -0,0 +1,37 @@
+package user
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+// NewSearchHandler serves GET /admin/users/search?q=&limit=.
+func NewSearchHandler(usecase user.SearchUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit, _ := strconv.Atoi(c.Query("limit"))
+
+		output, err := usecase.Execute(c, user.SearchInput{
+			Query: c.Query("q"),
+			Limit: limit,
+		})
+		if err != nil {
+			if errors.Is(err, user.ErrInvalidSearchQuery) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"message": "Invalid query parameters",
+					"error":   err.Error(),
+				})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, output)
+	}
+}
@@ -0,0 +1,87 @@
+package user_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSearchHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockSearchUsecase
+	}
+
+	tests := map[string]struct {
+		query              string
+		prepare            func(f *fields)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"when users match": {
+			query: "?q=jane&limit=5",
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), usecase.SearchInput{
+					Query: "jane",
+					Limit: 5,
+				}).Return(&usecase.SearchOutput{
+					Data: []usecase.SearchResultData{
+						{User: usecase.UserOutputData{ID: "user-123"}, Highlight: "<mark>Jane</mark>"},
+					},
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "user-123",
+		},
+		"when the query is invalid": {
+			query: "?q=j",
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, usecase.ErrInvalidSearchQuery)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       usecase.ErrInvalidSearchQuery.Error(),
+		},
+		"when the usecase returns an error": {
+			query: "?q=jane",
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "database error",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockSearchUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			c.Request = httptest.NewRequest(http.MethodGet, "/admin/users/search"+tc.query, nil)
+
+			handler := user.NewSearchHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -28,6 +28,10 @@ func NewSetPasswordHandler(usecase user.SetPasswordUsecase) func(c *gin.Context)
 		}
 
 		if err := usecase.Execute(c, input); err != nil {
+			if policyErr, ok := asPasswordPolicyError(err); ok {
+				c.JSON(http.StatusBadRequest, gin.H{"error": policyErr.Message, "code": policyErr.Code})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
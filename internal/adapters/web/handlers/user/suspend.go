@@ -0,0 +1,43 @@
+package user
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+// NewSuspendHandler serves POST /admin/users/:id/suspend.
+func NewSuspendHandler(usecase user.SuspendUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input user.SuspendInput
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "Invalid request body",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		input.UserID = c.Param("id")
+		input.Actor, _ = c.Request.Context().Value("userID").(string)
+
+		output, err := usecase.Execute(c, input)
+		if err != nil {
+			if errors.Is(err, domain.ErrInsufficientRole) {
+				c.JSON(http.StatusForbidden, gin.H{
+					"message": err.Error(),
+				})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, output)
+	}
+}
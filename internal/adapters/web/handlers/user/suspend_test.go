@@ -0,0 +1,96 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSuspendHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockSuspendUsecase
+	}
+
+	tests := map[string]struct {
+		body               string
+		prepare            func(f *fields)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"when the user is suspended": {
+			body: `{"reason":"spam"}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), usecase.SuspendInput{
+					UserID: "user-123",
+					Reason: "spam",
+					Actor:  "admin",
+				}).Return(&usecase.SuspendOutput{
+					Data: usecase.UserOutputData{ID: "user-123"},
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "user-123",
+		},
+		"when the usecase returns an error": {
+			body: `{"reason":""}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.New("reason is required"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "reason is required",
+		},
+		"when the user ranks at or above the caller": {
+			body: `{"reason":"spam"}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, domain.ErrInsufficientRole)
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedBody:       domain.ErrInsufficientRole.Error(),
+		},
+		"when the request body is invalid": {
+			body:               `not json`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Invalid request body",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockSuspendUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			c.Request = httptest.NewRequest(http.MethodPost, "/admin/users/user-123/suspend", strings.NewReader(tc.body))
+			c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), "userID", "admin"))
+			c.Params = gin.Params{{Key: "id", Value: "user-123"}}
+
+			handler := user.NewSuspendHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
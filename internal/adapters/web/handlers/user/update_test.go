@@ -0,0 +1,84 @@
+package user_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	userUsecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdateHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockUpdateUsecase
+	}
+
+	firstName := "Jane"
+
+	tests := map[string]struct {
+		body               string
+		prepare            func(f *fields)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"when the profile is updated": {
+			body: `{"first_name":"Jane"}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), "user-123", userUsecase.UpdateInput{FirstName: &firstName}).
+					Return(&userUsecase.UpdateOutput{Data: userUsecase.UserOutputData{FirstName: "Jane"}}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"first_name":"Jane"`,
+		},
+		"when a protected field is sent": {
+			body:               `{"email":"other@example.com"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `unknown field \"email\"`,
+		},
+		"when usecase returns an error": {
+			body: `{"phone_number":"abc"}`,
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), "user-123", gomock.Any()).
+					Return(nil, errors.New("phone number must be in international format, e.g. +14155550123"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "phone number must be in international format",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockUpdateUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			c.Request = httptest.NewRequest(http.MethodPatch, "/user/me", bytes.NewBufferString(tc.body))
+			c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), "userID", "user-123"))
+
+			handler := user.NewUpdateHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package user
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+const (
+	avatarFormField = "avatar"
+
+	// maxAvatarRequestSize caps what is read into memory; the usecase
+	// enforces the configured, smaller, image limit.
+	maxAvatarRequestSize = 10 << 20
+)
+
+// NewUploadAvatarHandler serves PUT /user/me/avatar with the image in the
+// "avatar" field of a multipart form.
+func NewUploadAvatarHandler(usecase user.UploadAvatarUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxAvatarRequestSize)
+
+		fileHeader, err := c.FormFile(avatarFormField)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "avatar file is required",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+			return
+		}
+		defer file.Close()
+
+		data, err := io.ReadAll(file)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+			return
+		}
+
+		username, ok := c.Request.Context().Value("userID").(string)
+		if !ok || username == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"message": "unauthorized"})
+			return
+		}
+
+		output, err := usecase.Execute(c, user.UploadAvatarInput{
+			Username: username,
+			Data:     data,
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, output)
+	}
+}
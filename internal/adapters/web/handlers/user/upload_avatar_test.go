@@ -0,0 +1,95 @@
+package user_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	mock_usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUploadAvatarHandler(t *testing.T) {
+	type fields struct {
+		usecase *mock_usecase.MockUploadAvatarUsecase
+	}
+
+	tests := map[string]struct {
+		field              string
+		prepare            func(f *fields)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"when the avatar is uploaded": {
+			field: "avatar",
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), usecase.UploadAvatarInput{
+					Username: "jdoe",
+					Data:     []byte("image-bytes"),
+				}).Return(&usecase.UploadAvatarOutput{
+					Variants: map[string]string{"large": "https://cdn.example.com/large.jpg"},
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "https://cdn.example.com/large.jpg",
+		},
+		"when the usecase rejects the image": {
+			field: "avatar",
+			prepare: func(f *fields) {
+				f.usecase.EXPECT().Execute(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("image must be a JPEG or PNG"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       "image must be a JPEG or PNG",
+		},
+		"when the avatar field is missing": {
+			field:              "file",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "avatar file is required",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				usecase: mock_usecase.NewMockUploadAvatarUsecase(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			var body bytes.Buffer
+			writer := multipart.NewWriter(&body)
+			part, err := writer.CreateFormFile(tc.field, "avatar.jpg")
+			assert.NoError(t, err)
+			_, _ = part.Write([]byte("image-bytes"))
+			assert.NoError(t, writer.Close())
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			c.Request = httptest.NewRequest(http.MethodPut, "/user/me/avatar", &body)
+			c.Request.Header.Set("Content-Type", writer.FormDataContentType())
+			c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), "userID", "jdoe"))
+
+			handler := user.NewUploadAvatarHandler(f.usecase)
+			handler(c)
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
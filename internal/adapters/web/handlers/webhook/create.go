@@ -0,0 +1,31 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/webhook"
+)
+
+func NewCreateHandler(usecase webhook.CreateUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input webhook.CreateInput
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message": "Invalid request body",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		output, err := usecase.Execute(c, input)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusCreated, output)
+	}
+}
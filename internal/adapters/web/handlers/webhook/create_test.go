@@ -0,0 +1,84 @@
+package webhook_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	webhook_handler "github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/webhook"
+	webhookUsecase "github.com/tapiaw38/auth-api-be/internal/usecases/webhook"
+	mock_webhook "github.com/tapiaw38/auth-api-be/internal/usecases/webhook/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := map[string]struct {
+		body         string
+		setupUsecase func(*mock_webhook.MockCreateUsecase)
+		expectedCode int
+		expectedBody string
+	}{
+		"when create usecase executes successfully": {
+			body: `{"url":"https://example.com/hook","event_types":["user.registered"]}`,
+			setupUsecase: func(mockUsecase *mock_webhook.MockCreateUsecase) {
+				mockUsecase.EXPECT().Execute(gomock.Any(), webhookUsecase.CreateInput{
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.registered"},
+				}).Return(&webhookUsecase.CreateOutput{
+					Data: webhookUsecase.WebhookOutputData{
+						ID:         "webhook-1",
+						URL:        "https://example.com/hook",
+						EventTypes: []string{"user.registered"},
+						IsActive:   true,
+					},
+					Secret: "generated",
+				}, nil)
+			},
+			expectedCode: 201,
+			expectedBody: `"secret":"generated"`,
+		},
+		"when create usecase returns error": {
+			body: `{"url":"/hook"}`,
+			setupUsecase: func(mockUsecase *mock_webhook.MockCreateUsecase) {
+				mockUsecase.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			expectedCode: 500,
+			expectedBody: `{"message":"` + assert.AnError.Error() + `"}`,
+		},
+		"when the request body is invalid": {
+			body:         `{"url":`,
+			expectedCode: 400,
+			expectedBody: "Invalid request body",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mock_webhook.NewMockCreateUsecase(ctrl)
+			if tc.setupUsecase != nil {
+				tc.setupUsecase(mockUsecase)
+			}
+
+			handler := webhook_handler.NewCreateHandler(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/webhooks", bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+
+			handler(c)
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/webhook"
+)
+
+func NewDeleteHandler(usecase webhook.DeleteUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := usecase.Execute(c, c.Param("id")); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"message": "webhook deleted successfully",
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/webhook"
+)
+
+func NewListDeliveriesHandler(usecase webhook.ListDeliveriesUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		output, err := usecase.Execute(c, c.Param("id"), parseListDeliveriesFilter(c.Request.URL.Query()))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, output)
+	}
+}
+
+func parseListDeliveriesFilter(queries url.Values) webhook.ListDeliveriesFilterOptions {
+	limit, _ := strconv.Atoi(queries.Get("limit"))
+
+	return webhook.ListDeliveriesFilterOptions{
+		Status: queries.Get("status"),
+		Limit:  limit,
+	}
+}
@@ -0,0 +1,23 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/webhook"
+)
+
+func NewRedeliverHandler(usecase webhook.RedeliverUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := usecase.Execute(c, c.Param("id"), c.Param("delivery_id")); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, gin.H{
+			"message": "delivery queued",
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package webhook_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	webhook_handler "github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/webhook"
+	mock_webhook "github.com/tapiaw38/auth-api-be/internal/usecases/webhook/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRedeliverHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := map[string]struct {
+		setupUsecase func(*mock_webhook.MockRedeliverUsecase)
+		expectedCode int
+		expectedBody string
+	}{
+		"when the delivery is queued": {
+			setupUsecase: func(mockUsecase *mock_webhook.MockRedeliverUsecase) {
+				mockUsecase.EXPECT().Execute(gomock.Any(), "webhook-1", "delivery-1").Return(nil)
+			},
+			expectedCode: 202,
+			expectedBody: `{"message":"delivery queued"}`,
+		},
+		"when redeliver usecase returns error": {
+			setupUsecase: func(mockUsecase *mock_webhook.MockRedeliverUsecase) {
+				mockUsecase.EXPECT().Execute(gomock.Any(), "webhook-1", "delivery-1").Return(assert.AnError)
+			},
+			expectedCode: 500,
+			expectedBody: `{"message":"` + assert.AnError.Error() + `"}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsecase := mock_webhook.NewMockRedeliverUsecase(ctrl)
+			tc.setupUsecase(mockUsecase)
+
+			handler := webhook_handler.NewRedeliverHandler(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/webhooks/webhook-1/deliveries/delivery-1/redeliver", nil)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = []gin.Param{
+				{Key: "id", Value: "webhook-1"},
+				{Key: "delivery_id", Value: "delivery-1"},
+			}
+
+			handler(c)
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+			assert.Equal(t, tc.expectedBody, w.Body.String())
+		})
+	}
+}
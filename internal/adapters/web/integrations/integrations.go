@@ -2,18 +2,27 @@ package integrations
 
 import (
 	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/notification"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/sms"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/sso"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/storage"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/webhook"
 	"github.com/tapiaw38/auth-api-be/internal/platform/config"
 )
 
 type Integrations struct {
 	SSO          sso.Integration
 	Notification notification.Integration
+	Webhook      webhook.Integration
+	BlobStore    storage.BlobStore
+	SMS          sms.Integration
 }
 
 func CreateIntegration(cfg *config.ConfigurationService) *Integrations {
 	return &Integrations{
 		SSO:          sso.NewIntegration(cfg),
 		Notification: notification.NewIntegration(cfg),
+		Webhook:      webhook.NewIntegration(cfg),
+		BlobStore:    storage.NewBlobStore(cfg),
+		SMS:          sms.NewIntegration(cfg),
 	}
 }
@@ -0,0 +1,87 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+const (
+	defaultTimeout      = 10 * time.Second
+	maxResponseBodySize = 1024
+)
+
+// httpProvider posts {"from", "to", "message"} as JSON to a gateway URL
+// with a bearer API key, the common shape of SMS HTTP APIs. Anything other
+// than a 2xx answer is an error.
+type httpProvider struct {
+	client *http.Client
+	url    string
+	apiKey string
+	from   string
+}
+
+type httpMessage struct {
+	From    string `json:"from,omitempty"`
+	To      string `json:"to"`
+	Message string `json:"message"`
+}
+
+func newHTTPProvider(cfg config.SMSConfig) Integration {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	return &httpProvider{
+		client: &http.Client{Timeout: timeout},
+		url:    cfg.URL,
+		apiKey: cfg.APIKey,
+		from:   cfg.From,
+	}
+}
+
+func (p *httpProvider) Send(ctx context.Context, input SendInput) error {
+	if p.url == "" {
+		return errors.New("sms: provider URL is not configured")
+	}
+
+	body, err := json.Marshal(httpMessage{
+		From:    p.from,
+		To:      input.To,
+		Message: input.Message,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+		return fmt.Errorf("sms: provider returned %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package sms
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+const (
+	ProviderLog  = "log"
+	ProviderHTTP = "http"
+)
+
+type (
+	Integration interface {
+		Send(context.Context, SendInput) error
+	}
+
+	SendInput struct {
+		To      string
+		Message string
+	}
+)
+
+// NewIntegration returns the configured provider. The log provider, the
+// default, only writes messages to the application log and is meant for
+// development.
+func NewIntegration(cfg *config.ConfigurationService) Integration {
+	if cfg.Notification.SMS.Provider == ProviderHTTP {
+		return newHTTPProvider(cfg.Notification.SMS)
+	}
+
+	return &logProvider{}
+}
@@ -0,0 +1,72 @@
+package sms_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/sms"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+func TestHTTPProvider_Send(t *testing.T) {
+	tests := map[string]struct {
+		status      int
+		expectedErr bool
+	}{
+		"when the gateway accepts the message": {
+			status: http.StatusAccepted,
+		},
+		"when the gateway rejects the message": {
+			status:      http.StatusUnauthorized,
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "Bearer api-key", r.Header.Get("Authorization"))
+
+				var body map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, map[string]string{
+					"from":    "AuthAPI",
+					"to":      "+14155550123",
+					"message": "Your code is 123456",
+				}, body)
+
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			integration := sms.NewIntegration(&config.ConfigurationService{
+				Notification: config.NotificationConfig{
+					SMS: config.SMSConfig{
+						Provider: sms.ProviderHTTP,
+						URL:      server.URL,
+						APIKey:   "api-key",
+						From:     "AuthAPI",
+					},
+				},
+			})
+
+			err := integration.Send(context.Background(), sms.SendInput{
+				To:      "+14155550123",
+				Message: "Your code is 123456",
+			})
+
+			assert.Equal(t, tc.expectedErr, err != nil)
+		})
+	}
+}
+
+func TestLogProvider_Send(t *testing.T) {
+	integration := sms.NewIntegration(&config.ConfigurationService{})
+
+	assert.NoError(t, integration.Send(context.Background(), sms.SendInput{To: "+14155550123", Message: "hi"}))
+}
@@ -0,0 +1,13 @@
+package sms
+
+import (
+	"context"
+	"log"
+)
+
+type logProvider struct{}
+
+func (p *logProvider) Send(ctx context.Context, input SendInput) error {
+	log.Printf("sms to %s: %s", input.To, input.Message)
+	return nil
+}
@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+const (
+	BackendS3    = "s3"
+	BackendLocal = "local"
+)
+
+var ErrInvalidKey = errors.New("invalid object key")
+
+// BlobStore keeps public objects such as avatars. Keys are slash separated
+// paths relative to the store root.
+type BlobStore interface {
+	Put(ctx context.Context, key string, body []byte, contentType string) (string, error)
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
+
+// NewBlobStore picks the backend from the configuration. The local backend
+// is meant for development and tests.
+func NewBlobStore(cfg *config.ConfigurationService) BlobStore {
+	if cfg.Storage.Backend == BackendLocal {
+		return NewLocalStore(cfg.Storage.LocalPath, cfg.Storage.LocalURL)
+	}
+
+	return NewS3Store(cfg.S3Config)
+}
+
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return ErrInvalidKey
+	}
+
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return ErrInvalidKey
+		}
+	}
+
+	return nil
+}
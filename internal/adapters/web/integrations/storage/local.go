@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type localStore struct {
+	root    string
+	baseURL string
+}
+
+func NewLocalStore(root, baseURL string) BlobStore {
+	return &localStore{
+		root:    root,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+func (s *localStore) Put(ctx context.Context, key string, body []byte, contentType string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(s.root, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+
+	if err := os.WriteFile(path, body, 0o644); err != nil {
+		return "", err
+	}
+
+	return s.URL(key), nil
+}
+
+func (s *localStore) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	err := os.Remove(filepath.Join(s.root, filepath.FromSlash(key)))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *localStore) URL(key string) string {
+	return s.baseURL + "/" + key
+}
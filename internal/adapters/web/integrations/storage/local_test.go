@@ -0,0 +1,39 @@
+package storage_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/storage"
+)
+
+func TestLocalStore(t *testing.T) {
+	root := t.TempDir()
+	store := storage.NewLocalStore(root, "http://localhost:8080/uploads/")
+
+	url, err := store.Put(context.Background(), "avatars/user-1/large.jpg", []byte("image"), "image/jpeg")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/uploads/avatars/user-1/large.jpg", url)
+
+	content, err := os.ReadFile(filepath.Join(root, "avatars", "user-1", "large.jpg"))
+	assert.NoError(t, err)
+	assert.Equal(t, "image", string(content))
+
+	assert.NoError(t, store.Delete(context.Background(), "avatars/user-1/large.jpg"))
+	assert.NoError(t, store.Delete(context.Background(), "avatars/user-1/large.jpg"))
+
+	_, err = os.Stat(filepath.Join(root, "avatars", "user-1", "large.jpg"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestLocalStore_RejectsTraversal(t *testing.T) {
+	store := storage.NewLocalStore(t.TempDir(), "http://localhost:8080/uploads")
+
+	for _, key := range []string{"../secret", "/etc/passwd", "avatars//large.jpg", ""} {
+		_, err := store.Put(context.Background(), key, []byte("image"), "image/jpeg")
+		assert.ErrorIs(t, err, storage.ErrInvalidKey, key)
+	}
+}
@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+const (
+	s3RequestTimeout   = 30 * time.Second
+	maxErrorBodySize   = 1024
+	objectCacheControl = "public, max-age=31536000, immutable"
+	s3EndpointFormat   = "https://%s.s3.%s.amazonaws.com"
+)
+
+// s3Store talks to S3 or any S3-compatible service through plain HTTP and
+// Signature Version 4. A custom endpoint, such as MinIO, is addressed
+// path-style as <endpoint>/<bucket>/<key>.
+type s3Store struct {
+	client      *http.Client
+	region      string
+	accessKeyID string
+	secretKey   string
+	bucketURL   string
+	publicURL   string
+}
+
+func NewS3Store(cfg config.S3Config) BlobStore {
+	bucketURL := fmt.Sprintf(s3EndpointFormat, cfg.AWSBucket, cfg.AWSRegion)
+	if cfg.Endpoint != "" {
+		bucketURL = strings.TrimRight(cfg.Endpoint, "/") + "/" + cfg.AWSBucket
+	}
+
+	publicURL := bucketURL
+	if cfg.PublicURL != "" {
+		publicURL = strings.TrimRight(cfg.PublicURL, "/")
+	}
+
+	return &s3Store{
+		client:      &http.Client{Timeout: s3RequestTimeout},
+		region:      cfg.AWSRegion,
+		accessKeyID: cfg.AWSAccessKeyID,
+		secretKey:   cfg.AWSSecretAccessKey,
+		bucketURL:   bucketURL,
+		publicURL:   publicURL,
+	}
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, body []byte, contentType string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Cache-Control", objectCacheControl)
+
+	if err := s.do(req, body); err != nil {
+		return "", fmt.Errorf("s3: put %s: %w", key, err)
+	}
+
+	return s.URL(key), nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+	if err != nil {
+		return err
+	}
+
+	if err := s.do(req, nil); err != nil {
+		return fmt.Errorf("s3: delete %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *s3Store) URL(key string) string {
+	return s.publicURL + "/" + key
+}
+
+func (s *s3Store) objectURL(key string) string {
+	return s.bucketURL + "/" + uriEscape(key, false)
+}
+
+func (s *s3Store) do(req *http.Request, body []byte) error {
+	SignV4(req, body, Credentials{
+		AccessKeyID:     s.accessKeyID,
+		SecretAccessKey: s.secretKey,
+		Region:          s.region,
+		Service:         "s3",
+	}, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	return nil
+}
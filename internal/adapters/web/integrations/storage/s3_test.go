@@ -0,0 +1,82 @@
+package storage_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/storage"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+func TestS3Store(t *testing.T) {
+	tests := map[string]struct {
+		status      int
+		call        func(store storage.BlobStore) (string, error)
+		method      string
+		expectedURL string
+		expectedErr bool
+	}{
+		"when an object is uploaded": {
+			status: http.StatusOK,
+			call: func(store storage.BlobStore) (string, error) {
+				return store.Put(context.Background(), "avatars/user-1/large.jpg", []byte("image"), "image/jpeg")
+			},
+			method:      http.MethodPut,
+			expectedURL: "https://cdn.example.com/avatars/user-1/large.jpg",
+		},
+		"when the upload is rejected": {
+			status: http.StatusForbidden,
+			call: func(store storage.BlobStore) (string, error) {
+				return store.Put(context.Background(), "avatars/user-1/large.jpg", []byte("image"), "image/jpeg")
+			},
+			method:      http.MethodPut,
+			expectedErr: true,
+		},
+		"when an object is deleted": {
+			status: http.StatusNoContent,
+			call: func(store storage.BlobStore) (string, error) {
+				return "", store.Delete(context.Background(), "avatars/user-1/large.jpg")
+			},
+			method: http.MethodDelete,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tc.method, r.Method)
+				assert.Equal(t, "/bucket/avatars/user-1/large.jpg", r.URL.Path)
+				assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key/"))
+				assert.NotEmpty(t, r.Header.Get("X-Amz-Content-Sha256"))
+
+				if r.Method == http.MethodPut {
+					body, _ := io.ReadAll(r.Body)
+					assert.Equal(t, "image", string(body))
+					assert.Equal(t, "image/jpeg", r.Header.Get("Content-Type"))
+				}
+
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			store := storage.NewS3Store(config.S3Config{
+				AWSRegion:          "us-east-1",
+				AWSBucket:          "bucket",
+				AWSAccessKeyID:     "key",
+				AWSSecretAccessKey: "secret",
+				Endpoint:           server.URL,
+				PublicURL:          "https://cdn.example.com/",
+			})
+
+			url, err := tc.call(store)
+
+			assert.Equal(t, tc.expectedErr, err != nil)
+			assert.Equal(t, tc.expectedURL, url)
+		})
+	}
+}
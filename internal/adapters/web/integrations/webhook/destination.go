@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"syscall"
+)
+
+// ErrPrivateDestination is returned for webhook URLs that point at
+// loopback, private, link-local or otherwise internal addresses. Delivery
+// logs keep part of the response, so reaching them would let an
+// administrator read internal services.
+var ErrPrivateDestination = errors.New("webhook URL must point to a public address")
+
+// sharedAddressSpace is the carrier-grade NAT range, which net.IP doesn't
+// count as private.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// CheckHost rejects hosts that are internal without a DNS lookup: literal
+// internal IPs and localhost names. Names that resolve to internal
+// addresses are refused when the request connects.
+func CheckHost(host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrPrivateDestination
+	}
+
+	if ip := net.ParseIP(strings.Trim(host, "[]")); ip != nil && !isPublicIP(ip) {
+		return ErrPrivateDestination
+	}
+
+	return nil
+}
+
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!sharedAddressSpace.Contains(ip)
+}
+
+// publicOnly is a net.Dialer Control function that refuses to connect to
+// internal addresses. It runs after DNS resolution and for every redirect,
+// so a public name can't be pointed at an internal address later.
+func publicOnly(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return ErrPrivateDestination
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+
+	defaultTimeout      = 10 * time.Second
+	maxResponseBodySize = 1024
+)
+
+type (
+	Integration interface {
+		Send(context.Context, SendInput) (*SendOutput, error)
+	}
+
+	integration struct {
+		appName string
+		client  *http.Client
+	}
+
+	SendInput struct {
+		URL        string
+		Secret     string
+		DeliveryID string
+		EventType  string
+		Payload    []byte
+	}
+
+	// SendOutput is returned whenever the receiver answered, including
+	// non-2xx responses, so the caller can record what it replied.
+	SendOutput struct {
+		StatusCode int
+		Body       string
+	}
+)
+
+func NewIntegration(cfg *config.ConfigurationService) Integration {
+	timeout := cfg.Webhook.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	// Requests go straight to the receiver, never through a proxy, so
+	// the dialer sees and can check the receiver's address.
+	dialer := &net.Dialer{Timeout: timeout}
+	if !cfg.Webhook.AllowPrivateNetworks {
+		dialer.Control = publicOnly
+	}
+
+	return &integration{
+		appName: cfg.AppName,
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: timeout,
+				MaxIdleConns:        10,
+				IdleConnTimeout:     90 * time.Second,
+			},
+		},
+	}
+}
+
+// Sign returns the signature receivers must compare against the
+// X-Webhook-Signature header: the hex HMAC-SHA256 of "<timestamp>.<body>"
+// keyed with the webhook secret. Including the timestamp lets receivers
+// reject replayed requests.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (i *integration) Send(ctx context.Context, input SendInput) (*SendOutput, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, input.URL, bytes.NewReader(input.Payload))
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp := time.Now().Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", i.userAgent())
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(input.Secret, timestamp, input.Payload))
+	req.Header.Set(EventHeader, input.EventType)
+	req.Header.Set(DeliveryHeader, input.DeliveryID)
+
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+
+	output := &SendOutput{
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return output, fmt.Errorf("receiver responded with status %d", resp.StatusCode)
+	}
+
+	return output, nil
+}
+
+func (i *integration) userAgent() string {
+	if i.appName == "" {
+		return "auth-api-webhooks"
+	}
+
+	return i.appName + "-webhooks"
+}
@@ -0,0 +1,128 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/webhook"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+func TestSign(t *testing.T) {
+	signature := webhook.Sign("secret", 1700000000, []byte(`{"id":"event-1"}`))
+
+	assert.Equal(t, "sha256=", signature[:7])
+	assert.Len(t, signature, 7+64)
+	assert.Equal(t, signature, webhook.Sign("secret", 1700000000, []byte(`{"id":"event-1"}`)))
+	assert.NotEqual(t, signature, webhook.Sign("other", 1700000000, []byte(`{"id":"event-1"}`)))
+	assert.NotEqual(t, signature, webhook.Sign("secret", 1700000001, []byte(`{"id":"event-1"}`)))
+}
+
+func TestIntegration_Send(t *testing.T) {
+	tests := map[string]struct {
+		status         int
+		response       string
+		expectedErr    bool
+		expectedStatus int
+	}{
+		"when the receiver accepts the request": {
+			status:         http.StatusNoContent,
+			expectedStatus: http.StatusNoContent,
+		},
+		"when the receiver rejects the request": {
+			status:         http.StatusBadRequest,
+			response:       "invalid signature",
+			expectedErr:    true,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			payload := []byte(`{"id":"event-1","type":"user.deleted"}`)
+
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				timestamp, err := strconv.ParseInt(r.Header.Get(webhook.TimestampHeader), 10, 64)
+
+				assert.NoError(t, err)
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, webhook.Sign("secret", timestamp, body), r.Header.Get(webhook.SignatureHeader))
+				assert.Equal(t, "user.deleted", r.Header.Get(webhook.EventHeader))
+				assert.Equal(t, "delivery-1", r.Header.Get(webhook.DeliveryHeader))
+				assert.Equal(t, payload, body)
+
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer receiver.Close()
+
+			integration := webhook.NewIntegration(&config.ConfigurationService{
+				Webhook: config.WebhookConfig{AllowPrivateNetworks: true},
+			})
+			output, err := integration.Send(context.Background(), webhook.SendInput{
+				URL:        receiver.URL,
+				Secret:     "secret",
+				DeliveryID: "delivery-1",
+				EventType:  "user.deleted",
+				Payload:    payload,
+			})
+
+			assert.Equal(t, tc.expectedErr, err != nil)
+			assert.Equal(t, tc.expectedStatus, output.StatusCode)
+			assert.Equal(t, tc.response, output.Body)
+		})
+	}
+}
+
+func TestIntegration_SendRefusesPrivateAddresses(t *testing.T) {
+	called := false
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer receiver.Close()
+
+	integration := webhook.NewIntegration(&config.ConfigurationService{})
+	output, err := integration.Send(context.Background(), webhook.SendInput{
+		URL:        receiver.URL,
+		Secret:     "secret",
+		DeliveryID: "delivery-1",
+		EventType:  "user.deleted",
+		Payload:    []byte(`{}`),
+	})
+
+	assert.ErrorIs(t, err, webhook.ErrPrivateDestination)
+	assert.Nil(t, output)
+	assert.False(t, called)
+}
+
+func TestCheckHost(t *testing.T) {
+	tests := map[string]struct {
+		host        string
+		expectedErr error
+	}{
+		"when the host is a public name":         {host: "example.com"},
+		"when the host is a public address":      {host: "93.184.216.34"},
+		"when the host is localhost":             {host: "localhost", expectedErr: webhook.ErrPrivateDestination},
+		"when the host is a localhost subdomain": {host: "api.localhost.", expectedErr: webhook.ErrPrivateDestination},
+		"when the host is loopback":              {host: "127.0.0.1", expectedErr: webhook.ErrPrivateDestination},
+		"when the host is IPv6 loopback":         {host: "::1", expectedErr: webhook.ErrPrivateDestination},
+		"when the host is private":               {host: "10.0.0.5", expectedErr: webhook.ErrPrivateDestination},
+		"when the host is link-local":            {host: "169.254.169.254", expectedErr: webhook.ErrPrivateDestination},
+		"when the host is unspecified":           {host: "0.0.0.0", expectedErr: webhook.ErrPrivateDestination},
+		"when the host is in shared space":       {host: "100.64.0.1", expectedErr: webhook.ErrPrivateDestination},
+		"when the host is an IPv4-mapped IPv6":   {host: "::ffff:192.168.1.1", expectedErr: webhook.ErrPrivateDestination},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedErr, webhook.CheckHost(tc.host))
+		})
+	}
+}
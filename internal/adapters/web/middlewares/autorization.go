@@ -2,25 +2,35 @@ package middlewares
 
 import (
 	"context"
+	"log"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
 	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/audit"
 	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
 )
 
-func AuthorizationMiddleware(usecase user.GetTokenVersionUsecase) gin.HandlerFunc {
+const passwordChangePath = "/user/me/password"
+
+func AuthorizationMiddleware(usecase user.GetTokenVersionUsecase, auditUsecase audit.RecordUsecase) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		reject := func(reason string, metadata map[string]any) {
+			recordAuthorizationFailure(c, auditUsecase, reason, metadata)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": reason})
+		}
+
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing authorization header"})
+			reject("missing authorization header", nil)
 			return
 		}
 
 		tokenParts := strings.Split(authHeader, " ")
 		if len(tokenParts) != 2 || strings.ToLower(tokenParts[0]) != "bearer" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid authorization header format"})
+			reject("invalid authorization header format", nil)
 			return
 		}
 
@@ -28,24 +38,60 @@ func AuthorizationMiddleware(usecase user.GetTokenVersionUsecase) gin.HandlerFun
 
 		claims, err := auth.ValidateToken(token)
 		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired token"})
+			reject("invalid or expired token", nil)
 			return
 		}
 
 		ctx := c.Request.Context()
 		tokenVersion, err := usecase.Execute(ctx, claims.UserID)
 		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "failed to get token version"})
+			reject("failed to get token version", map[string]any{"username": claims.UserID})
 			return
 		}
 
 		if claims.TokenVersion != tokenVersion {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "token version mismatch"})
+			reject("token version mismatch", map[string]any{"username": claims.UserID})
+			return
+		}
+
+		// A token issued for an expired password is only good for
+		// changing it.
+		if claims.Scope == auth.ScopePasswordChange && c.FullPath() != passwordChangePath {
+			recordAuthorizationFailure(c, auditUsecase, "password change required", map[string]any{"username": claims.UserID})
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "password change required"})
 			return
 		}
 
 		ctx = context.WithValue(ctx, "userID", claims.UserID)
+		ctx = context.WithValue(ctx, "emailVerified", claims.EmailVerified)
+		if authenticatedAt := claims.AuthenticatedAt(); !authenticatedAt.IsZero() {
+			// authenticatedAt is checked by RequireRecentAuth on sensitive
+			// routes.
+			ctx = context.WithValue(ctx, "authenticatedAt", authenticatedAt)
+		}
+		ctx = context.WithValue(ctx, "authMethods", claims.AMR)
+		ctx = context.WithValue(ctx, "tokenScope", claims.Scope)
 		c.Request = c.Request.WithContext(ctx)
 		c.Next()
 	}
 }
+
+// recordAuthorizationFailure logs a rejected request to the audit log. A
+// failure to write the event is logged but does not change the response.
+func recordAuthorizationFailure(c *gin.Context, usecase audit.RecordUsecase, reason string, metadata map[string]any) {
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+	metadata["reason"] = reason
+	metadata["method"] = c.Request.Method
+	metadata["path"] = c.Request.URL.Path
+
+	if err := usecase.Execute(c.Request.Context(), domain.NewAuditEvent(
+		domain.AuditActionAuthorizationFailed,
+		domain.AnonymousActor(),
+		domain.AuditTarget{},
+		metadata,
+	)); err != nil {
+		log.Printf("failed to record authorization failure: %v", err)
+	}
+}
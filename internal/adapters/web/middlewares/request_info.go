@@ -0,0 +1,32 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/platform/requestinfo"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+// RequestInfo records the client IP, user agent and request ID so usecases
+// can attach them to audit events. An incoming X-Request-ID is kept,
+// otherwise a new one is generated and echoed in the response.
+func RequestInfo() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		c.Header(RequestIDHeader, requestID)
+
+		info := requestinfo.Info{
+			IP:        c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+			RequestID: requestID,
+		}
+
+		c.Set(requestinfo.ContextKey, info)
+		c.Request = c.Request.WithContext(requestinfo.WithInfo(c.Request.Context(), info))
+		c.Next()
+	}
+}
@@ -0,0 +1,72 @@
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+const reauthenticatePath = "/auth/reauthenticate"
+
+// RequireRecentAuth only lets the request through when the user
+// authenticated within maxAge and, if methods are given, with one of them.
+// Otherwise it answers 401 with a step_up_required error and a
+// WWW-Authenticate challenge (RFC 9470), so the client can call
+// /auth/reauthenticate and retry with the new token. A password-change
+// token is let through: it cannot reauthenticate, it is issued right after
+// the password was checked and AuthorizationMiddleware confines it to the
+// password change route. It must run after AuthorizationMiddleware.
+func RequireRecentAuth(maxAge time.Duration, methods ...string) gin.HandlerFunc {
+	maxAgeSeconds := int(maxAge.Seconds())
+	if methods == nil {
+		methods = []string{}
+	}
+
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		authenticatedAt, _ := ctx.Value("authenticatedAt").(time.Time)
+		authMethods, _ := ctx.Value("authMethods").([]string)
+
+		if scope, _ := ctx.Value("tokenScope").(string); scope == auth.ScopePasswordChange {
+			c.Next()
+			return
+		}
+
+		if !authenticatedAt.IsZero() && time.Since(authenticatedAt) <= maxAge && satisfiesMethods(authMethods, methods) {
+			c.Next()
+			return
+		}
+
+		c.Header("WWW-Authenticate", fmt.Sprintf(
+			`Bearer error="insufficient_user_authentication", error_description="A more recent authentication is required", max_age=%d`,
+			maxAgeSeconds,
+		))
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"error":               "step-up authentication required",
+			"code":                "step_up_required",
+			"max_age":             maxAgeSeconds,
+			"methods":             methods,
+			"reauthenticate_path": reauthenticatePath,
+		})
+	}
+}
+
+// satisfiesMethods reports whether any of the token's amr values is
+// accepted. An empty accepted list allows any method.
+func satisfiesMethods(authMethods, accepted []string) bool {
+	if len(accepted) == 0 {
+		return true
+	}
+
+	for _, method := range authMethods {
+		if slices.Contains(accepted, method) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,99 @@
+package middlewares_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/middlewares"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+func TestRequireRecentAuth(t *testing.T) {
+	tests := map[string]struct {
+		methods            []string
+		context            map[string]any
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"when the user authenticated recently": {
+			context: map[string]any{
+				"authenticatedAt": time.Now().Add(-time.Minute),
+				"authMethods":     []string{"pwd"},
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"when the authentication is too old": {
+			context: map[string]any{
+				"authenticatedAt": time.Now().Add(-time.Hour),
+				"authMethods":     []string{"pwd"},
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedBody:       "step_up_required",
+		},
+		"when the token has no authentication time": {
+			context: map[string]any{
+				"authMethods": []string{"pwd"},
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedBody:       "step_up_required",
+		},
+		"when the method is not accepted": {
+			methods: []string{"otp"},
+			context: map[string]any{
+				"authenticatedAt": time.Now(),
+				"authMethods":     []string{"pwd"},
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedBody:       `"methods":["otp"]`,
+		},
+		"when the method is accepted": {
+			methods: []string{"otp"},
+			context: map[string]any{
+				"authenticatedAt": time.Now(),
+				"authMethods":     []string{"pwd", "otp"},
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"when the token is scoped to a password change": {
+			context: map[string]any{
+				"authenticatedAt": time.Now().Add(-10 * time.Minute),
+				"authMethods":     []string{"pwd"},
+				"tokenScope":      auth.ScopePasswordChange,
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			_, router := gin.CreateTestContext(w)
+
+			router.Use(func(c *gin.Context) {
+				ctx := c.Request.Context()
+				for key, value := range tc.context {
+					ctx = context.WithValue(ctx, key, value)
+				}
+				c.Request = c.Request.WithContext(ctx)
+			})
+			router.PUT("/user/me/password", middlewares.RequireRecentAuth(5*time.Minute, tc.methods...), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/user/me/password", nil))
+
+			assert.Equal(t, tc.expectedStatusCode, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expectedBody)
+			if tc.expectedStatusCode == http.StatusUnauthorized {
+				assert.Contains(t, w.Header().Get("WWW-Authenticate"), `error="insufficient_user_authentication"`)
+				assert.Contains(t, w.Header().Get("WWW-Authenticate"), "max_age=300")
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+// RequireRoles only lets the request through when the authenticated user has
+// at least one of roles. It must run after AuthorizationMiddleware.
+func RequireRoles(usecase user.GetUsecase, roles ...domain.RoleName) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username, ok := c.Request.Context().Value("userID").(string)
+		if !ok || username == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+			return
+		}
+
+		output, err := usecase.Execute(c.Request.Context(), user.GetFilterOptions{
+			Username: username,
+		})
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+			return
+		}
+
+		for _, assigned := range output.Data.Roles {
+			for _, role := range roles {
+				if domain.RoleName(assigned.Name) == role {
+					c.Next()
+					return
+				}
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "insufficient permissions"})
+	}
+}
@@ -0,0 +1,54 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+// RequireVerifiedEmail only lets the request through when the authenticated
+// user has verified their email. A token issued after verification is
+// trusted as is; otherwise the user is looked up, as they may have
+// verified since the token was issued. Setting REQUIRE_VERIFIED_EMAIL to
+// false turns the check off. It must run after AuthorizationMiddleware.
+func RequireVerifiedEmail(usecase user.GetUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !config.GetConfigService().EmailVerification.Required {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+
+		if verified, _ := ctx.Value("emailVerified").(bool); verified {
+			c.Next()
+			return
+		}
+
+		username, ok := ctx.Value("userID").(string)
+		if !ok || username == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+			return
+		}
+
+		output, err := usecase.Execute(ctx, user.GetFilterOptions{
+			Username: username,
+		})
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+			return
+		}
+
+		if !output.Data.VerifiedEmail {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": "email not verified",
+				"code":  "email_not_verified",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,40 @@
+package middlewares
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+)
+
+// SCIMAuthorization authenticates an identity provider by its SCIM bearer
+// token and stores the token ID and tenant in the request context as
+// scimTokenID and scimTenant.
+func SCIMAuthorization(usecase scim.AuthenticateUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reject := func(err error) {
+			status, body := scim.NewErrorResponse(err)
+			c.Header("Content-Type", "application/scim+json")
+			c.Header("WWW-Authenticate", `Bearer realm="scim"`)
+			c.AbortWithStatusJSON(status, body)
+		}
+
+		tokenParts := strings.Split(c.GetHeader("Authorization"), " ")
+		if len(tokenParts) != 2 || strings.ToLower(tokenParts[0]) != "bearer" {
+			reject(scim.ErrInvalidToken)
+			return
+		}
+
+		token, err := usecase.Execute(c.Request.Context(), tokenParts[1])
+		if err != nil {
+			reject(err)
+			return
+		}
+
+		ctx := context.WithValue(c.Request.Context(), "scimTokenID", token.ID)
+		ctx = context.WithValue(ctx, "scimTenant", token.Tenant)
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -1,31 +1,114 @@
 package web
 
 import (
+	"time"
+
 	"github.com/gin-gonic/gin"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/audit"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/health"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/role"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/scim"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/user"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/handlers/webhook"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/web/middlewares"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
 	"github.com/tapiaw38/auth-api-be/internal/usecases"
 )
 
-func RegisterApplicationRoutes(app *gin.Engine, useCases *usecases.Usecases) {
+// recentAuthMaxAge is how recently a user must have authenticated to
+// change credentials or delete their account.
+const recentAuthMaxAge = 5 * time.Minute
+
+func RegisterApplicationRoutes(app *gin.Engine, useCases *usecases.Usecases, healthCheckers ...health.Checker) {
+	scimGroup := app.Group("/scim/v2", middlewares.RequestInfo(), middlewares.SCIMAuthorization(useCases.SCIM.AuthenticateUsecase))
+	scimGroup.GET("ServiceProviderConfig", scim.NewServiceProviderConfigHandler())
+	scimGroup.GET("ResourceTypes", scim.NewResourceTypesHandler())
+	scimGroup.GET("Users", scim.NewListUsersHandler(useCases.SCIM.ListUsersUsecase))
+	scimGroup.POST("Users", scim.NewCreateUserHandler(useCases.SCIM.CreateUserUsecase))
+	scimGroup.GET("Users/:id", scim.NewGetUserHandler(useCases.SCIM.GetUserUsecase))
+	scimGroup.PUT("Users/:id", scim.NewReplaceUserHandler(useCases.SCIM.ReplaceUserUsecase))
+	scimGroup.PATCH("Users/:id", scim.NewPatchUserHandler(useCases.SCIM.PatchUserUsecase))
+	scimGroup.DELETE("Users/:id", scim.NewDeleteUserHandler(useCases.SCIM.DeleteUserUsecase))
+	scimGroup.GET("Groups", scim.NewListGroupsHandler(useCases.SCIM.ListGroupsUsecase))
+	scimGroup.POST("Groups", scim.NewCreateGroupHandler(useCases.SCIM.CreateGroupUsecase))
+	scimGroup.GET("Groups/:id", scim.NewGetGroupHandler(useCases.SCIM.GetGroupUsecase))
+	scimGroup.PUT("Groups/:id", scim.NewReplaceGroupHandler(useCases.SCIM.ReplaceGroupUsecase))
+	scimGroup.PATCH("Groups/:id", scim.NewPatchGroupHandler(useCases.SCIM.PatchGroupUsecase))
+	scimGroup.DELETE("Groups/:id", scim.NewDeleteGroupHandler(useCases.SCIM.DeleteGroupUsecase))
+
 	routeGroup := app.Group("/")
+	routeGroup.Use(middlewares.RequestInfo())
 
 	routeGroup.GET("/ping", func(c *gin.Context) {
 		c.JSON(200, gin.H{
 			"message": "pong",
 		})
 	})
+	routeGroup.GET("/health", health.NewHealthHandler(healthCheckers...))
 
 	routeGroup.POST("auth/register", user.NewRegisterHandler(useCases.User.RegisterUsecase))
 	routeGroup.POST("auth/login", user.NewLoginHandler(useCases.User.LoginUsecase))
 	routeGroup.GET("auth/verify-email", user.NewVerifyEmailHandler(useCases.User.VerifyEmailUsecase))
+	routeGroup.POST("auth/not-me", user.NewReportUnrecognizedLoginHandler(useCases.User.ReportUnrecognizedLoginUsecase))
+	routeGroup.GET("auth/confirm-email", user.NewConfirmEmailChangeHandler(useCases.User.ConfirmEmailChangeUsecase))
+	routeGroup.GET("user/export/download", user.NewDownloadExportHandler(useCases.User.DownloadExportUsecase))
+	routeGroup.POST("auth/forgot-password", user.NewRequestResetPasswordHandler(useCases.User.RequestResetPasswordUsecase))
 	routeGroup.POST("auth/reset-password", user.NewResetPasswordHandler(useCases.User.ResetPasswordUsecase))
 	routeGroup.POST("role/ensure", role.NewEnsureHandler(useCases.Role.EnsureUsecase))
 
-	routeGroup.Use(middlewares.AuthorizationMiddleware(useCases.User.GetTokenVersionUsecase))
+	routeGroup.Use(middlewares.AuthorizationMiddleware(
+		useCases.User.GetTokenVersionUsecase,
+		useCases.Audit.RecordUsecase,
+	))
+	routeGroup.POST("auth/verify-email/resend", user.NewResendVerificationHandler(useCases.User.ResendVerificationUsecase))
+	routeGroup.POST("auth/reauthenticate", user.NewReauthenticateHandler(useCases.User.ReauthenticateUsecase))
 	routeGroup.GET("user/me", user.NewMeHandler(useCases.User.GetUsecase))
-	routeGroup.PUT("user/me/password", user.NewChangePasswordHandler(useCases.User.ChangePasswordUsecase))
-	routeGroup.POST("user/me/password/set", user.NewSetPasswordHandler(useCases.User.SetPasswordUsecase))
+	routeGroup.PATCH("user/me", user.NewUpdateHandler(useCases.User.UpdateUsecase))
+	routeGroup.PUT("user/me/avatar", user.NewUploadAvatarHandler(useCases.User.UploadAvatarUsecase))
+	recentAuth := middlewares.RequireRecentAuth(recentAuthMaxAge)
+	routeGroup.PUT("user/me/password", recentAuth, user.NewChangePasswordHandler(useCases.User.ChangePasswordUsecase))
+	routeGroup.POST("user/me/password/set", recentAuth, user.NewSetPasswordHandler(useCases.User.SetPasswordUsecase))
+
+	verifiedGroup := routeGroup.Group("", middlewares.RequireVerifiedEmail(useCases.User.GetUsecase))
+	verifiedGroup.POST("user/me/email", recentAuth, user.NewRequestEmailChangeHandler(useCases.User.RequestEmailChangeUsecase))
+	verifiedGroup.POST("user/me/phone/verify/start", user.NewStartPhoneVerificationHandler(useCases.User.StartPhoneVerificationUsecase))
+	verifiedGroup.POST("user/me/phone/verify/confirm", user.NewConfirmPhoneVerificationHandler(useCases.User.ConfirmPhoneVerificationUsecase))
+	verifiedGroup.POST("user/me/export", user.NewRequestExportHandler(useCases.User.RequestExportUsecase))
+
+	routeGroup.POST("user/me/delete", recentAuth, user.NewScheduleDeletionHandler(useCases.User.ScheduleDeletionUsecase))
+	routeGroup.DELETE("user/me/delete", user.NewCancelDeletionHandler(useCases.User.CancelDeletionUsecase))
+	routeGroup.GET("user/me/activity", audit.NewListActivityHandler(useCases.Audit.ListActivityUsecase))
 	routeGroup.GET("role/list", role.NewListHandler(useCases.Role.ListUsecase))
+
+	adminGroup := verifiedGroup.Group("admin", middlewares.RequireRoles(
+		useCases.User.GetUsecase,
+		domain.RoleAdmin,
+		domain.RoleSuperAdmin,
+	))
+	adminGroup.POST("role/assign", role.NewAssignHandler(useCases.Role.AssignUsecase))
+	adminGroup.POST("role/revoke", role.NewRevokeHandler(useCases.Role.RevokeUsecase))
+
+	adminGroup.GET("users", user.NewListHandler(useCases.User.ListUsecase))
+	adminGroup.GET("users/search", user.NewSearchHandler(useCases.User.SearchUsecase))
+	adminGroup.POST("users/:id/suspend", user.NewSuspendHandler(useCases.User.SuspendUsecase))
+	adminGroup.POST("users/:id/reactivate", user.NewReactivateHandler(useCases.User.ReactivateUsecase))
+	adminGroup.POST("users/import", user.NewImportUsersHandler(useCases.User.ImportUsersUsecase))
+	adminGroup.GET("users/import/:id", user.NewGetImportJobHandler(useCases.User.GetImportJobUsecase))
+	adminGroup.GET("users/export", user.NewExportUsersHandler(useCases.User.ExportUsersUsecase))
+
+	adminGroup.POST("webhooks", webhook.NewCreateHandler(useCases.Webhook.CreateUsecase))
+	adminGroup.GET("webhooks", webhook.NewListHandler(useCases.Webhook.ListUsecase))
+	adminGroup.GET("webhooks/:id", webhook.NewGetHandler(useCases.Webhook.GetUsecase))
+	adminGroup.PUT("webhooks/:id", webhook.NewUpdateHandler(useCases.Webhook.UpdateUsecase))
+	adminGroup.DELETE("webhooks/:id", webhook.NewDeleteHandler(useCases.Webhook.DeleteUsecase))
+	adminGroup.GET("webhooks/:id/deliveries", webhook.NewListDeliveriesHandler(useCases.Webhook.ListDeliveriesUsecase))
+	adminGroup.GET("webhooks/:id/deliveries/:delivery_id", webhook.NewGetDeliveryHandler(useCases.Webhook.GetDeliveryUsecase))
+	adminGroup.POST("webhooks/:id/deliveries/:delivery_id/redeliver", webhook.NewRedeliverHandler(useCases.Webhook.RedeliverUsecase))
+
+	adminGroup.POST("scim/tokens", scim.NewCreateTokenHandler(useCases.SCIM.CreateTokenUsecase))
+	adminGroup.GET("scim/tokens", scim.NewListTokensHandler(useCases.SCIM.ListTokensUsecase))
+	adminGroup.DELETE("scim/tokens/:id", scim.NewRevokeTokenHandler(useCases.SCIM.RevokeTokenUsecase))
+
+	adminGroup.GET("audit", audit.NewListHandler(useCases.Audit.ListUsecase))
+	adminGroup.GET("audit/verify", audit.NewVerifyHandler(useCases.Audit.VerifyUsecase))
 }
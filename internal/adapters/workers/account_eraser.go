@@ -0,0 +1,102 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+const (
+	defaultErasurePollInterval = time.Minute
+	accountEraserBatchSize     = 50
+
+	// passwordResetRequestRetention only needs to cover the hourly
+	// forgot-password limits.
+	passwordResetRequestRetention = 24 * time.Hour
+)
+
+// AccountEraser erases accounts whose deletion grace period has ended and
+// purges data exports whose download link has expired, along with old
+// forgot-password requests.
+type AccountEraser struct {
+	contextFactory appcontext.Factory
+	erase          user.EraseUsecase
+	pollInterval   time.Duration
+}
+
+func NewAccountEraser(contextFactory appcontext.Factory) *AccountEraser {
+	app := contextFactory()
+
+	eraser := &AccountEraser{
+		contextFactory: contextFactory,
+		erase:          user.NewEraseUsecase(contextFactory),
+		pollInterval:   app.ConfigService.Privacy.ErasurePollInterval,
+	}
+
+	if eraser.pollInterval <= 0 {
+		eraser.pollInterval = defaultErasurePollInterval
+	}
+
+	return eraser
+}
+
+func (e *AccountEraser) Start(ctx context.Context) {
+	go func() {
+		log.Println("Account eraser started")
+		for {
+			erased, err := e.EraseBatch(ctx)
+			if err != nil {
+				log.Printf("Account eraser error: %v", err)
+			}
+
+			if erased == accountEraserBatchSize {
+				continue
+			}
+
+			if _, err := e.contextFactory().Repositories.DataExport.DeleteExpired(ctx); err != nil {
+				log.Printf("Failed to purge expired data exports: %v", err)
+			}
+
+			if _, err := e.contextFactory().Repositories.PasswordReset.DeleteBefore(ctx, time.Now().Add(-passwordResetRequestRetention)); err != nil {
+				log.Printf("Failed to purge old password reset requests: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				log.Println("Account eraser stopped")
+				return
+			case <-time.After(e.pollInterval):
+			}
+		}
+	}()
+}
+
+// EraseBatch erases up to one batch of due accounts and returns how many
+// were erased. Each account is erased in its own transaction, so one
+// failure does not hold back the rest.
+func (e *AccountEraser) EraseBatch(ctx context.Context) (int, error) {
+	app := e.contextFactory()
+
+	deletions, err := app.Repositories.Deletion.ListDue(ctx, accountEraserBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var erased int
+	for _, deletion := range deletions {
+		ok, err := e.erase.Execute(ctx, deletion.UserID)
+		if err != nil {
+			log.Printf("Failed to erase account %s: %v", deletion.UserID, err)
+			continue
+		}
+
+		if ok {
+			erased++
+		}
+	}
+
+	return erased, nil
+}
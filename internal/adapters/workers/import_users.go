@@ -0,0 +1,21 @@
+package workers
+
+import (
+	"context"
+	"log"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+)
+
+// NewImportUsersHandler runs the user import job named by each message.
+// Jobs run one at a time, since a single file can create thousands of
+// users.
+func NewImportUsersHandler(contextFactory appcontext.Factory) Handler[user.ImportUsersMessage] {
+	process := user.NewProcessImportUsecase(contextFactory)
+
+	return func(ctx context.Context, message user.ImportUsersMessage) error {
+		log.Printf("Processing user import job: %s", message.JobID)
+		return process.Execute(ctx, message.JobID)
+	}
+}
@@ -0,0 +1,137 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+const (
+	defaultOutboxPollInterval = time.Second
+	defaultOutboxBatchSize    = 100
+	defaultOutboxMaxAttempts  = 10
+	// Retries wait 10s, 20s, 40s, ... capped at an hour, so the default
+	// attempts cover a broker outage of about an hour and a half.
+	outboxRetryBaseDelay = 10 * time.Second
+	outboxRetryMaxDelay  = time.Hour
+)
+
+// OutboxRelay publishes messages written to the outbox table once the
+// transaction that produced them has committed. Delivery is at-least-once:
+// a crash between publishing and marking a row as sent re-publishes it.
+// Failed publishes are retried with exponential backoff until maxAttempts
+// is reached.
+type OutboxRelay struct {
+	contextFactory appcontext.Factory
+	pollInterval   time.Duration
+	batchSize      int
+	maxAttempts    int
+}
+
+func NewOutboxRelay(contextFactory appcontext.Factory) *OutboxRelay {
+	app := contextFactory()
+	cfg := app.ConfigService.Outbox
+
+	relay := &OutboxRelay{
+		contextFactory: contextFactory,
+		pollInterval:   cfg.PollInterval,
+		batchSize:      cfg.BatchSize,
+		maxAttempts:    cfg.MaxAttempts,
+	}
+
+	if relay.pollInterval <= 0 {
+		relay.pollInterval = defaultOutboxPollInterval
+	}
+	if relay.batchSize <= 0 {
+		relay.batchSize = defaultOutboxBatchSize
+	}
+	if relay.maxAttempts <= 0 {
+		relay.maxAttempts = defaultOutboxMaxAttempts
+	}
+
+	return relay
+}
+
+func (r *OutboxRelay) Start(ctx context.Context) {
+	go func() {
+		log.Println("Outbox relay started")
+		for {
+			published, err := r.RelayBatch(ctx)
+			if err != nil {
+				log.Printf("Outbox relay error: %v", err)
+			}
+
+			// Keep draining while full batches are published.
+			if published == r.batchSize {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				log.Println("Outbox relay stopped")
+				return
+			case <-time.After(r.pollInterval):
+			}
+		}
+	}()
+}
+
+// RelayBatch publishes up to one batch of due messages and returns how
+// many were published. The rows stay locked while they are published, so
+// the batch stops at the first failure: the broker is most likely down and
+// every further publish would hold the locks until it times out.
+func (r *OutboxRelay) RelayBatch(ctx context.Context) (int, error) {
+	app := r.contextFactory()
+
+	var published int
+	err := app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		messages, err := repos.Outbox.ListPending(ctx, outbox.ListPendingOptions{
+			Limit: r.batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, message := range messages {
+			if err := publish(app.Publisher, message); err != nil {
+				log.Printf("Failed to publish outbox message %s: %v", message.ID, err)
+				return repos.Outbox.MarkFailed(ctx, outbox.MarkFailedInput{
+					ID:            message.ID,
+					Error:         err.Error(),
+					MaxAttempts:   r.maxAttempts,
+					NextAttemptAt: time.Now().UTC().Add(retryDelay(outboxRetryBaseDelay, outboxRetryMaxDelay, message.Attempts+1)),
+				})
+			}
+
+			if message.Kind == domain.OutboxKindEvent {
+				if err := enqueueWebhookDeliveries(ctx, repos, message); err != nil {
+					return err
+				}
+			}
+
+			if err := repos.Outbox.MarkSent(ctx, message.ID); err != nil {
+				return err
+			}
+
+			published++
+		}
+
+		return nil
+	})
+
+	return published, err
+}
+
+func publish(publisher queue.Publisher, message domain.OutboxMessage) error {
+	if message.Kind == domain.OutboxKindEvent {
+		return publisher.PublishEvent(message.Topic, message.Payload)
+	}
+
+	return publisher.Publish(queue.Topic(message.Topic), message.Payload)
+}
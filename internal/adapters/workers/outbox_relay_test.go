@@ -0,0 +1,155 @@
+package workers_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/workers"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+type fakePublisher struct {
+	published []queue.Topic
+	events    []string
+	err       error
+}
+
+func (f *fakePublisher) Publish(topic queue.Topic, data interface{}) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, topic)
+	return nil
+}
+
+func (f *fakePublisher) PublishEvent(eventType string, data interface{}) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.events = append(f.events, eventType)
+	return nil
+}
+
+// retryAt matches a retry time about delay from now.
+type retryAt time.Duration
+
+func (d retryAt) Match(value driver.Value) bool {
+	at, ok := value.(time.Time)
+	return ok && at.Sub(time.Now().UTC().Add(time.Duration(d))).Abs() < time.Minute
+}
+
+func TestOutboxRelay_RelayBatch(t *testing.T) {
+	columns := []string{"id", "kind", "topic", "payload", "status", "attempts", "last_error", "created_at", "sent_at"}
+	webhookColumns := []string{"id", "url", "secret", "event_types", "is_active", "created_at", "updated_at"}
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		publishErr        error
+		prepare           func(mock sqlmock.Sqlmock)
+		expectedPublished int
+		expectedTopics    []queue.Topic
+		expectedEvents    []string
+	}{
+		"when pending messages are published and marked as sent": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FROM outbox").
+					WithArgs("pending", sqlmock.AnyArg(), 100).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow("message-1", "job", "send_email", []byte(`{}`), "pending", 0, nil, createdAt, nil))
+				mock.ExpectExec("UPDATE outbox SET status").
+					WithArgs("sent", sqlmock.AnyArg(), "message-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedPublished: 1,
+			expectedTopics:    []queue.Topic{queue.TopicSendEmail},
+		},
+		"when pending events are published to the events exchange": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FROM outbox").
+					WithArgs("pending", sqlmock.AnyArg(), 100).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow("message-1", "event", "user.registered", []byte(`{"id":"event-1"}`), "pending", 0, nil, createdAt, nil))
+				mock.ExpectQuery("FROM webhooks").
+					WithArgs("user.registered").
+					WillReturnRows(sqlmock.NewRows(webhookColumns).
+						AddRow("webhook-1", "https://example.com/hook", "secret", "{}", true, createdAt, createdAt))
+				mock.ExpectQuery("INSERT INTO webhook_deliveries").
+					WithArgs(sqlmock.AnyArg(), "webhook-1", "event-1", "user.registered", []byte(`{"id":"event-1"}`), "pending", sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("delivery-1"))
+				mock.ExpectExec("UPDATE outbox SET status").
+					WithArgs("sent", sqlmock.AnyArg(), "message-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedPublished: 1,
+			expectedEvents:    []string{"user.registered"},
+		},
+		"when publishing fails the attempt is recorded and the batch stops": {
+			publishErr: errors.New("queue is not connected"),
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FROM outbox").
+					WithArgs("pending", sqlmock.AnyArg(), 100).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow("message-1", "job", "send_email", []byte(`{}`), "pending", 2, nil, createdAt, nil).
+						AddRow("message-2", "job", "send_email", []byte(`{}`), "pending", 0, nil, createdAt, nil))
+				mock.ExpectExec("UPDATE outbox").
+					WithArgs("queue is not connected", 10, "failed", retryAt(40*time.Second), "message-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		"when there are no pending messages": {
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FROM outbox").
+					WithArgs("pending", sqlmock.AnyArg(), 100).
+					WillReturnRows(sqlmock.NewRows(columns))
+				mock.ExpectCommit()
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			tc.prepare(mock)
+
+			publisher := &fakePublisher{err: tc.publishErr}
+			ds := datasources.CreateDatasources(db)
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Transactor:    repositories.NewTransactor(ds),
+					Publisher:     publisher,
+					ConfigService: &config.ConfigurationService{},
+				}
+			}
+
+			relay := workers.NewOutboxRelay(contextFactory)
+			published, err := relay.RelayBatch(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedPublished, published)
+			assert.Equal(t, tc.expectedTopics, publisher.published)
+			assert.Equal(t, tc.expectedEvents, publisher.events)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
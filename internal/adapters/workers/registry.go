@@ -0,0 +1,126 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sync"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
+)
+
+const defaultConcurrency = 1
+
+type (
+	// Handler processes a single decoded message of type T.
+	Handler[T any] func(context.Context, T) error
+
+	// Route binds a topic to a raw message handler and its consumption settings.
+	Route struct {
+		Topic       queue.Topic
+		Concurrency int
+		handle      func(context.Context, []byte) error
+	}
+
+	RouteOption func(*Route)
+
+	Registry struct {
+		routes map[queue.Topic]*Route
+		order  []queue.Topic
+		mutex  sync.Mutex
+	}
+)
+
+func NewRegistry() *Registry {
+	return &Registry{
+		routes: make(map[queue.Topic]*Route),
+	}
+}
+
+// WithConcurrency sets how many messages of the topic are processed in
+// parallel. It is also used as the broker prefetch count.
+func WithConcurrency(concurrency int) RouteOption {
+	return func(r *Route) {
+		if concurrency > 0 {
+			r.Concurrency = concurrency
+		}
+	}
+}
+
+// Register binds a typed handler to a topic. Message bodies are decoded from
+// JSON into T before the handler is invoked.
+func Register[T any](registry *Registry, topic queue.Topic, handler Handler[T], opts ...RouteOption) error {
+	route := &Route{
+		Topic:       topic,
+		Concurrency: defaultConcurrency,
+		handle: func(ctx context.Context, body []byte) error {
+			var payload T
+			if err := json.Unmarshal(body, &payload); err != nil {
+				return fmt.Errorf("failed to decode message for topic %s: %w", topic, err)
+			}
+			return handler(ctx, payload)
+		},
+	}
+
+	for _, opt := range opts {
+		opt(route)
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	if _, ok := registry.routes[topic]; ok {
+		return fmt.Errorf("handler for topic %s already registered", topic)
+	}
+
+	registry.routes[topic] = route
+	registry.order = append(registry.order, topic)
+
+	return nil
+}
+
+// Handle runs the route handler for a raw message body, turning panics into
+// errors so a single bad message cannot take the consumer down.
+func (r *Route) Handle(ctx context.Context, body []byte) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			log.Printf("Recovered in handler for topic %s: %v\n%s", r.Topic, rec, debug.Stack())
+			err = fmt.Errorf("panic in handler for topic %s: %v", r.Topic, rec)
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return r.handle(ctx, body)
+}
+
+func (r *Registry) Routes() []*Route {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	routes := make([]*Route, 0, len(r.order))
+	for _, topic := range r.order {
+		routes = append(routes, r.routes[topic])
+	}
+
+	return routes
+}
+
+// Start launches one consumer loop per registered route. Each loop runs until
+// ctx is cancelled or the consumer returns.
+func (r *Registry) Start(ctx context.Context, consumer queue.Consumer) {
+	for _, route := range r.Routes() {
+		go func(route *Route) {
+			log.Printf("Starting consumer for topic: %s (concurrency: %d)", route.Topic, route.Concurrency)
+			if err := consumer.Consume(ctx, route.Topic, route.Concurrency, route.Handle); err != nil {
+				log.Printf("Consumer for topic %s stopped: %v", route.Topic, err)
+				return
+			}
+			log.Printf("Consumer for topic %s stopped", route.Topic)
+		}(route)
+	}
+}
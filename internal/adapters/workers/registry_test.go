@@ -0,0 +1,104 @@
+package workers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/workers"
+)
+
+type testPayload struct {
+	Name string `json:"name"`
+}
+
+func TestRegistry_Handle(t *testing.T) {
+	tests := map[string]struct {
+		body        string
+		handler     workers.Handler[testPayload]
+		cancelled   bool
+		expectedErr string
+	}{
+		"when the payload is decoded and handled successfully": {
+			body: `{"name":"john"}`,
+			handler: func(ctx context.Context, p testPayload) error {
+				if p.Name != "john" {
+					return errors.New("unexpected payload")
+				}
+				return nil
+			},
+		},
+		"when the body is not valid json": {
+			body: `{"name":`,
+			handler: func(ctx context.Context, p testPayload) error {
+				return nil
+			},
+			expectedErr: "failed to decode message for topic test_topic",
+		},
+		"when the handler returns an error": {
+			body: `{"name":"john"}`,
+			handler: func(ctx context.Context, p testPayload) error {
+				return errors.New("handler error")
+			},
+			expectedErr: "handler error",
+		},
+		"when the handler panics": {
+			body: `{"name":"john"}`,
+			handler: func(ctx context.Context, p testPayload) error {
+				panic("boom")
+			},
+			expectedErr: "panic in handler for topic test_topic: boom",
+		},
+		"when the context is cancelled": {
+			body: `{"name":"john"}`,
+			handler: func(ctx context.Context, p testPayload) error {
+				return nil
+			},
+			cancelled:   true,
+			expectedErr: context.Canceled.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			registry := workers.NewRegistry()
+			err := workers.Register(registry, queue.Topic("test_topic"), tc.handler)
+			assert.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			if tc.cancelled {
+				cancel()
+			} else {
+				defer cancel()
+			}
+
+			routes := registry.Routes()
+			assert.Len(t, routes, 1)
+
+			err = routes[0].Handle(ctx, []byte(tc.body))
+			if tc.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRegistry_Register(t *testing.T) {
+	registry := workers.NewRegistry()
+	handler := func(ctx context.Context, p testPayload) error { return nil }
+
+	err := workers.Register(registry, queue.Topic("test_topic"), handler, workers.WithConcurrency(3))
+	assert.NoError(t, err)
+
+	err = workers.Register(registry, queue.Topic("test_topic"), handler)
+	assert.EqualError(t, err, "handler for topic test_topic already registered")
+
+	routes := registry.Routes()
+	assert.Len(t, routes, 1)
+	assert.Equal(t, 3, routes[0].Concurrency)
+}
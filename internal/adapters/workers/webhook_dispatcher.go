@@ -0,0 +1,241 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	webhook_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook"
+	webhook_delivery "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook/delivery"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/webhook"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/utils"
+)
+
+const (
+	defaultWebhookPollInterval = 2 * time.Second
+	defaultWebhookBatchSize    = 20
+	defaultWebhookMaxAttempts  = 8
+	// Retries wait 30s, 1m, 2m, ... capped at six hours.
+	webhookRetryBaseDelay = 30 * time.Second
+	webhookRetryMaxDelay  = 6 * time.Hour
+	// A batch gets one minute to send; claimed deliveries stay hidden from
+	// other dispatchers for five, which leaves time to record the results.
+	webhookBatchTimeout = time.Minute
+	webhookClaimLease   = 5 * time.Minute
+)
+
+// WebhookDispatcher POSTs queued webhook deliveries and records every
+// attempt. Failed deliveries are retried with exponential backoff until
+// maxAttempts is reached.
+type WebhookDispatcher struct {
+	contextFactory appcontext.Factory
+	pollInterval   time.Duration
+	batchSize      int
+	maxAttempts    int
+}
+
+type webhookClaim struct {
+	target   *domain.Webhook
+	delivery domain.WebhookDelivery
+}
+
+func NewWebhookDispatcher(contextFactory appcontext.Factory) *WebhookDispatcher {
+	app := contextFactory()
+	cfg := app.ConfigService.Webhook
+
+	dispatcher := &WebhookDispatcher{
+		contextFactory: contextFactory,
+		pollInterval:   cfg.PollInterval,
+		batchSize:      cfg.BatchSize,
+		maxAttempts:    cfg.MaxAttempts,
+	}
+
+	if dispatcher.pollInterval <= 0 {
+		dispatcher.pollInterval = defaultWebhookPollInterval
+	}
+	if dispatcher.batchSize <= 0 {
+		dispatcher.batchSize = defaultWebhookBatchSize
+	}
+	if dispatcher.maxAttempts <= 0 {
+		dispatcher.maxAttempts = defaultWebhookMaxAttempts
+	}
+
+	return dispatcher
+}
+
+func (d *WebhookDispatcher) Start(ctx context.Context) {
+	go func() {
+		log.Println("Webhook dispatcher started")
+		for {
+			dispatched, err := d.DispatchBatch(ctx)
+			if err != nil {
+				log.Printf("Webhook dispatcher error: %v", err)
+			}
+
+			if dispatched == d.batchSize {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				log.Println("Webhook dispatcher stopped")
+				return
+			case <-time.After(d.pollInterval):
+			}
+		}
+	}()
+}
+
+// DispatchBatch claims up to one batch of due deliveries, sends them in
+// parallel and returns how many were claimed. The claim is committed
+// before any request goes out, so a slow receiver holds no locks; the
+// whole batch shares one deadline, after which pending requests fail and
+// are retried later.
+func (d *WebhookDispatcher) DispatchBatch(ctx context.Context) (int, error) {
+	app := d.contextFactory()
+
+	var claims []webhookClaim
+	err := app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		deliveries, err := repos.WebhookDelivery.ClaimDue(ctx, webhook_delivery.ClaimDueOptions{
+			Limit:      d.batchSize,
+			LeaseUntil: time.Now().UTC().Add(webhookClaimLease),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, delivery := range deliveries {
+			target, err := repos.Webhook.Get(ctx, delivery.WebhookID)
+			if err != nil {
+				return err
+			}
+
+			claims = append(claims, webhookClaim{target: target, delivery: delivery})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, webhookBatchTimeout)
+	defer cancel()
+
+	// Attempts are recorded even when ctx is cancelled mid-batch, so a
+	// request that went out isn't sent again when the lease expires.
+	recordCtx := context.WithoutCancel(ctx)
+
+	errs := make([]error, len(claims))
+	var wg sync.WaitGroup
+	for i, claim := range claims {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			input := d.attempt(sendCtx, app, claim.target, claim.delivery)
+			errs[i] = app.Transactor.WithTransaction(recordCtx, func(repos *repositories.Repositories) error {
+				return repos.WebhookDelivery.RecordAttempt(recordCtx, input)
+			})
+		}()
+	}
+	wg.Wait()
+
+	return len(claims), errors.Join(errs...)
+}
+
+func (d *WebhookDispatcher) attempt(
+	ctx context.Context,
+	app *appcontext.Context,
+	target *domain.Webhook,
+	delivery domain.WebhookDelivery,
+) webhook_delivery.RecordAttemptInput {
+	input := webhook_delivery.RecordAttemptInput{
+		DeliveryID: delivery.ID,
+		Attempt:    delivery.Attempts + 1,
+	}
+
+	if target == nil || !target.IsActive {
+		input.Error = utils.ToPointer("webhook is inactive")
+		input.Status = domain.WebhookDeliveryFailed
+		input.NextAttemptAt = time.Now().UTC()
+		return input
+	}
+
+	started := time.Now()
+	output, err := app.Integrations.Webhook.Send(ctx, webhook.SendInput{
+		URL:        target.URL,
+		Secret:     target.Secret,
+		DeliveryID: delivery.ID,
+		EventType:  string(delivery.EventType),
+		Payload:    delivery.Payload,
+	})
+	input.Duration = time.Since(started)
+
+	if output != nil {
+		input.ResponseStatus = utils.ToPointer(output.StatusCode)
+		input.ResponseBody = utils.ToPointer(output.Body)
+	}
+
+	switch {
+	case err == nil:
+		input.Status = domain.WebhookDeliverySucceeded
+		input.NextAttemptAt = time.Now().UTC()
+	case input.Attempt >= d.maxAttempts:
+		input.Error = utils.ToPointer(err.Error())
+		input.Status = domain.WebhookDeliveryFailed
+		input.NextAttemptAt = time.Now().UTC()
+	default:
+		input.Error = utils.ToPointer(err.Error())
+		input.Status = domain.WebhookDeliveryPending
+		input.NextAttemptAt = time.Now().UTC().Add(retryDelay(webhookRetryBaseDelay, webhookRetryMaxDelay, input.Attempt))
+	}
+
+	return input
+}
+
+// enqueueWebhookDeliveries queues the event carried by message for every
+// active webhook subscribed to its type.
+func enqueueWebhookDeliveries(ctx context.Context, repos *repositories.Repositories, message domain.OutboxMessage) error {
+	eventType := domain.EventType(message.Topic)
+
+	webhooks, err := repos.Webhook.List(ctx, webhook_repo.ListFilterOptions{
+		EventType:  eventType,
+		ActiveOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	var envelope struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(message.Payload, &envelope); err != nil {
+		return err
+	}
+
+	for _, target := range webhooks {
+		if _, err := repos.WebhookDelivery.Create(ctx, domain.WebhookDelivery{
+			ID:        uuid.NewString(),
+			WebhookID: target.ID,
+			EventID:   envelope.ID,
+			EventType: eventType,
+			Payload:   message.Payload,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package workers_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/webhook"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/workers"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+)
+
+func TestWebhookDispatcher_DispatchBatch(t *testing.T) {
+	deliveryColumns := []string{"id", "webhook_id", "event_id", "event_type", "payload", "status", "attempts", "next_attempt_at", "created_at", "updated_at"}
+	webhookColumns := []string{"id", "url", "secret", "event_types", "is_active", "created_at", "updated_at"}
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	payload := []byte(`{"id":"event-1","type":"user.registered"}`)
+
+	tests := map[string]struct {
+		receiverStatus int
+		attempts       int
+		isActive       bool
+		expectRequest  bool
+		expectedStatus string
+	}{
+		"when the receiver accepts the delivery": {
+			receiverStatus: http.StatusOK,
+			isActive:       true,
+			expectRequest:  true,
+			expectedStatus: "succeeded",
+		},
+		"when the receiver fails the delivery is retried": {
+			receiverStatus: http.StatusInternalServerError,
+			isActive:       true,
+			expectRequest:  true,
+			expectedStatus: "pending",
+		},
+		"when the last attempt fails the delivery is marked as failed": {
+			receiverStatus: http.StatusInternalServerError,
+			attempts:       2,
+			isActive:       true,
+			expectRequest:  true,
+			expectedStatus: "failed",
+		},
+		"when the webhook is inactive the delivery is not sent": {
+			isActive:       false,
+			expectedStatus: "failed",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			requests := 0
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+
+				body, _ := io.ReadAll(r.Body)
+				timestamp, err := strconv.ParseInt(r.Header.Get(webhook.TimestampHeader), 10, 64)
+				assert.NoError(t, err)
+				assert.Equal(t, webhook.Sign("secret", timestamp, body), r.Header.Get(webhook.SignatureHeader))
+				assert.Equal(t, "user.registered", r.Header.Get(webhook.EventHeader))
+				assert.Equal(t, "delivery-1", r.Header.Get(webhook.DeliveryHeader))
+				assert.JSONEq(t, string(payload), string(body))
+
+				w.WriteHeader(tc.receiverStatus)
+			}))
+			defer receiver.Close()
+
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectQuery("UPDATE webhook_deliveries\\s+SET next_attempt_at = \\$1.+FOR UPDATE SKIP LOCKED.+RETURNING").
+				WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "pending", 20).
+				WillReturnRows(sqlmock.NewRows(deliveryColumns).
+					AddRow("delivery-1", "webhook-1", "event-1", "user.registered", payload, "pending", tc.attempts, createdAt, createdAt, createdAt))
+			mock.ExpectQuery("FROM webhooks").
+				WithArgs("webhook-1").
+				WillReturnRows(sqlmock.NewRows(webhookColumns).
+					AddRow("webhook-1", receiver.URL, "secret", "{}", tc.isActive, createdAt, createdAt))
+			mock.ExpectCommit()
+			mock.ExpectBegin()
+			mock.ExpectExec("INSERT INTO webhook_delivery_attempts").
+				WithArgs("delivery-1", tc.attempts+1, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec("UPDATE webhook_deliveries").
+				WithArgs(tc.expectedStatus, tc.attempts+1, sqlmock.AnyArg(), sqlmock.AnyArg(), "delivery-1").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			cfg := &config.ConfigurationService{
+				Webhook: config.WebhookConfig{MaxAttempts: 3, AllowPrivateNetworks: true},
+			}
+			ds := datasources.CreateDatasources(db)
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Transactor:    repositories.NewTransactor(ds),
+					Integrations:  &integrations.Integrations{Webhook: webhook.NewIntegration(cfg)},
+					ConfigService: cfg,
+				}
+			}
+
+			dispatcher := workers.NewWebhookDispatcher(contextFactory)
+			claimed, err := dispatcher.DispatchBatch(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, claimed)
+			assert.Equal(t, tc.expectRequest, requests == 1)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWebhookDispatcher_DispatchBatchSendsInParallel(t *testing.T) {
+	deliveryColumns := []string{"id", "webhook_id", "event_id", "event_type", "payload", "status", "attempts", "next_attempt_at", "created_at", "updated_at"}
+	webhookColumns := []string{"id", "url", "secret", "event_types", "is_active", "created_at", "updated_at"}
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	payload := []byte(`{"id":"event-1","type":"user.registered"}`)
+
+	// Neither request is answered until both have arrived, which only
+	// happens when they are sent at the same time.
+	var arrived sync.WaitGroup
+	arrived.Add(2)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		arrived.Done()
+		arrived.Wait()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE webhook_deliveries").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "pending", 20).
+		WillReturnRows(sqlmock.NewRows(deliveryColumns).
+			AddRow("delivery-1", "webhook-1", "event-1", "user.registered", payload, "pending", 0, createdAt, createdAt, createdAt).
+			AddRow("delivery-2", "webhook-1", "event-1", "user.registered", payload, "pending", 0, createdAt, createdAt, createdAt))
+	for range 2 {
+		mock.ExpectQuery("FROM webhooks").
+			WithArgs("webhook-1").
+			WillReturnRows(sqlmock.NewRows(webhookColumns).
+				AddRow("webhook-1", receiver.URL, "secret", "{}", true, createdAt, createdAt))
+	}
+	mock.ExpectCommit()
+
+	// The two attempts are recorded concurrently, in either order.
+	mock.MatchExpectationsInOrder(false)
+	for _, id := range []string{"delivery-1", "delivery-2"} {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO webhook_delivery_attempts").
+			WithArgs(id, 1, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("UPDATE webhook_deliveries").
+			WithArgs("succeeded", 1, sqlmock.AnyArg(), sqlmock.AnyArg(), id).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+	}
+
+	cfg := &config.ConfigurationService{
+		Webhook: config.WebhookConfig{Timeout: 5 * time.Second, AllowPrivateNetworks: true},
+	}
+	ds := datasources.CreateDatasources(db)
+	contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+		return &appcontext.Context{
+			Transactor:    repositories.NewTransactor(ds),
+			Integrations:  &integrations.Integrations{Webhook: webhook.NewIntegration(cfg)},
+			ConfigService: cfg,
+		}
+	}
+
+	dispatcher := workers.NewWebhookDispatcher(contextFactory)
+	claimed, err := dispatcher.DispatchBatch(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, claimed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,186 +2,59 @@ package workers
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"os/signal"
-	"sync"
 	"syscall"
+	"time"
 
-	"github.com/streadway/amqp"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
-	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/notification"
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
 )
 
-type (
-	Worker interface {
-		Start(ctx context.Context) error
-		Stop() error
-	}
-
-	Consumer interface {
-		StartConsumer(topic queue.Topic, handler ConsumerHandler) error
-	}
-
-	ConsumerHandler func(any) error
-
-	ConsumerManager struct {
-		conn      *amqp.Connection
-		consumers map[queue.Topic]*consumer
-		mutex     sync.Mutex
-	}
-
-	consumer struct {
-		topic   queue.Topic
-		ch      *amqp.Channel
-		handler ConsumerHandler
-	}
-)
-
-func NewConsumerManager(conn *amqp.Connection) *ConsumerManager {
-	return &ConsumerManager{
-		conn:      conn,
-		consumers: make(map[queue.Topic]*consumer),
-	}
-}
-
-func (cm *ConsumerManager) GetConsumer(topic queue.Topic, handler ConsumerHandler) error {
-	cm.mutex.Lock()
-	defer cm.mutex.Unlock()
+func RegisterWorkers(ctx context.Context, consumer queue.Consumer, contextFactory appcontext.Factory) error {
+	log.Println("Starting to register workers...")
 
-	if _, ok := cm.consumers[topic]; ok {
-		return nil
-	}
+	app := contextFactory()
 
-	ch, err := cm.conn.Channel()
-	if err != nil {
-		return fmt.Errorf("failed to create channel: %w", err)
-	}
+	registry := NewRegistry()
 
-	cons := &consumer{
-		topic:   topic,
-		ch:      ch,
-		handler: handler,
+	if err := Register(registry, queue.TopicSendEmail, NewSendEmailHandler(app.Integrations), WithConcurrency(4)); err != nil {
+		return fmt.Errorf("failed to register email worker: %w", err)
 	}
 
-	cm.consumers[topic] = cons
-	return nil
-}
-
-func (cm *ConsumerManager) StartConsumer(topic queue.Topic, handler ConsumerHandler) error {
-	log.Printf("Starting consumer for topic: %s", topic)
-
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-	defer stop()
-
-	err := cm.GetConsumer(topic, handler)
-	if err != nil {
-		return fmt.Errorf("failed to create consumer for topic %s: %w", topic, err)
+	if err := Register(registry, queue.TopicImportUsers, NewImportUsersHandler(contextFactory), WithConcurrency(1)); err != nil {
+		return fmt.Errorf("failed to register user import worker: %w", err)
 	}
 
-	log.Printf("Consumer for topic %s created successfully", topic)
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+		log.Println("Shutting down workers gracefully...")
+	}()
 
-	if err := cm.Consume(ctx, topic); err != nil {
-		return fmt.Errorf("failed to start consumer: %w", err)
-	}
+	registry.Start(ctx, consumer)
 
-	log.Printf("Consumer for topic %s is running", topic)
+	NewOutboxRelay(contextFactory).Start(ctx)
+	NewWebhookDispatcher(contextFactory).Start(ctx)
+	NewAccountEraser(contextFactory).Start(ctx)
 
-	<-ctx.Done()
-	log.Println("Shutting down consumer gracefully...")
+	log.Println("All workers registered successfully")
 
 	return nil
 }
 
-func (cm *ConsumerManager) Consume(ctx context.Context, topic queue.Topic) error {
-	cm.mutex.Lock()
-	cons, ok := cm.consumers[topic]
-	cm.mutex.Unlock()
-
-	if !ok {
-		return fmt.Errorf("consumer for topic %s not found", topic)
-	}
-
-	defer cons.ch.Close()
-
-	q, err := cons.ch.QueueDeclare(
-		string(cons.topic), // queue name
-		false,              // durable
-		false,              // delete when unused
-		false,              // exclusive
-		false,              // no-wait
-		nil,                // arguments
-	)
-	if err != nil {
-		return fmt.Errorf("queue declare failed: %w", err)
-	}
-
-	msgs, err := cons.ch.Consume(
-		string(cons.topic), // queue name
-		"",                 // consumer
-		true,               // auto-ack
-		false,              // exclusive
-		false,              // no-local
-		false,              // no-wait
-		nil,                // args
-	)
-	if err != nil {
-		return fmt.Errorf("consume failed: %w", err)
-	}
-
-	log.Printf("Waiting for messages on queue: %s", q.Name)
-
-	for {
-		select {
-		case d, ok := <-msgs:
-			if !ok {
-				log.Println("Message channel closed")
-				return nil
-			}
-			log.Printf("Received message: %s", string(d.Body))
-			func() {
-				defer func() {
-					if r := recover(); r != nil {
-						log.Printf("Recovered in message handler: %v", r)
-					}
-				}()
-
-				if cons.topic == queue.TopicSendEmail {
-					var sendEmailBody notification.SendEmailInput
-					if err := json.Unmarshal(d.Body, &sendEmailBody); err != nil {
-						log.Printf("Error unmarshaling message: %v", err)
-						return
-					}
-
-					if err := cons.handler(sendEmailBody); err != nil {
-						log.Printf("Error handling message: %v", err)
-					}
-				}
-			}()
-		case <-ctx.Done():
-			log.Println("Consumer context cancelled")
-			return nil
+// retryDelay doubles base after every failed attempt, capped at max.
+func retryDelay(base, max time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
 		}
 	}
-}
-
-func RegisterWorkers(ctx context.Context, mq *queue.RabbitMQ, contextFactory appcontext.Factory) error {
-	log.Println("Starting to register workers...")
-
-	consumerManager := NewConsumerManager(mq.GetConnection())
-
-	app := contextFactory()
-	integrations := app.Integrations
 
-	emailWorker := NewEmailWorker(consumerManager, integrations)
-	if err := emailWorker.Start(ctx); err != nil {
-		return fmt.Errorf("failed to register email worker: %w", err)
-	}
-
-	log.Println("All workers registered successfully")
-
-	return nil
+	return delay
 }
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+const (
+	AuditActionLogin                  AuditAction = "auth.login"
+	AuditActionLoginFailed            AuditAction = "auth.login_failed"
+	AuditActionAuthorizationFailed    AuditAction = "auth.authorization_failed"
+	AuditActionReauthenticated        AuditAction = "auth.reauthenticated"
+	AuditActionReauthenticationFailed AuditAction = "auth.reauthentication_failed"
+	AuditActionSuspiciousLogin        AuditAction = "auth.suspicious_login"
+	AuditActionLoginReported          AuditAction = "auth.login_reported"
+	AuditActionUserRegistered         AuditAction = "user.registered"
+	AuditActionEmailVerified          AuditAction = "user.email_verified"
+	AuditActionUserUpdated            AuditAction = "user.updated"
+	AuditActionEmailChangeRequested   AuditAction = "user.email_change_requested"
+	AuditActionEmailChanged           AuditAction = "user.email_changed"
+	AuditActionPhoneVerified          AuditAction = "user.phone_verified"
+	AuditActionPasswordChanged        AuditAction = "user.password_changed"
+	AuditActionPasswordSet            AuditAction = "user.password_set"
+	AuditActionPasswordResetRequested AuditAction = "user.password_reset_requested"
+	AuditActionPasswordReset          AuditAction = "user.password_reset"
+	AuditActionUserDeleted            AuditAction = "user.deleted"
+	AuditActionUserSuspended          AuditAction = "user.suspended"
+	AuditActionUserReactivated        AuditAction = "user.reactivated"
+	AuditActionDataExported           AuditAction = "user.data_exported"
+	AuditActionDeletionScheduled      AuditAction = "user.deletion_scheduled"
+	AuditActionDeletionCancelled      AuditAction = "user.deletion_cancelled"
+	AuditActionUsersImportStarted     AuditAction = "user.import_started"
+	AuditActionUsersExported          AuditAction = "user.bulk_exported"
+	AuditActionRoleAssigned           AuditAction = "role.assigned"
+	AuditActionRoleRevoked            AuditAction = "role.revoked"
+	AuditActionRoleCreated            AuditAction = "role.created"
+	AuditActionRoleUpdated            AuditAction = "role.updated"
+	AuditActionRoleDeleted            AuditAction = "role.deleted"
+	AuditActionSCIMTokenCreated       AuditAction = "scim.token_created"
+	AuditActionSCIMTokenRevoked       AuditAction = "scim.token_revoked"
+)
+
+const (
+	// ActorTypeAnonymous is used for requests that could not be tied to an
+	// account, such as failed logins and rejected tokens.
+	ActorTypeAnonymous ActorType = "anonymous"
+
+	AuditTargetUser AuditTargetType = "user"
+	AuditTargetRole AuditTargetType = "role"
+)
+
+type (
+	AuditAction     string
+	AuditTargetType string
+
+	// AuditTarget is the record an audit event is about.
+	AuditTarget struct {
+		Type AuditTargetType
+		ID   string
+	}
+
+	// AuditEvent is one row of the append-only security log. Actor.ID and
+	// Target.ID are user IDs, not usernames, so the history survives a
+	// username change. Hash covers every other field plus PrevHash, which
+	// links the row to the one written before it.
+	AuditEvent struct {
+		ID        int64
+		Action    AuditAction
+		Actor     EventActor
+		Target    AuditTarget
+		IP        string
+		UserAgent string
+		RequestID string
+		Metadata  map[string]any
+		PrevHash  string
+		Hash      string
+		CreatedAt time.Time
+		// RedactedAt is set once the personal data of an erased user has
+		// been removed from the row. Hash still covers the original
+		// contents.
+		RedactedAt *time.Time
+	}
+)
+
+func NewAuditEvent(action AuditAction, actor EventActor, target AuditTarget, metadata map[string]any) AuditEvent {
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+
+	return AuditEvent{
+		Action:   action,
+		Actor:    actor,
+		Target:   target,
+		Metadata: metadata,
+	}
+}
+
+func AnonymousActor() EventActor {
+	return EventActor{
+		Type: ActorTypeAnonymous,
+	}
+}
+
+func UserTarget(userID string) AuditTarget {
+	return AuditTarget{
+		Type: AuditTargetUser,
+		ID:   userID,
+	}
+}
+
+func RoleTarget(roleID string) AuditTarget {
+	return AuditTarget{
+		Type: AuditTargetRole,
+		ID:   roleID,
+	}
+}
+
+// ComputeHash returns the hex SHA-256 of the event contents chained to
+// PrevHash. CreatedAt is hashed at microsecond precision, which is what the
+// database stores.
+func (e AuditEvent) ComputeHash() (string, error) {
+	metadata := e.Metadata
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+
+	body, err := json.Marshal(struct {
+		PrevHash   string          `json:"prev_hash"`
+		Action     AuditAction     `json:"action"`
+		ActorType  ActorType       `json:"actor_type"`
+		ActorID    string          `json:"actor_id"`
+		TargetType AuditTargetType `json:"target_type"`
+		TargetID   string          `json:"target_id"`
+		IP         string          `json:"ip"`
+		UserAgent  string          `json:"user_agent"`
+		RequestID  string          `json:"request_id"`
+		Metadata   map[string]any  `json:"metadata"`
+		CreatedAt  string          `json:"created_at"`
+	}{
+		PrevHash:   e.PrevHash,
+		Action:     e.Action,
+		ActorType:  e.Actor.Type,
+		ActorID:    e.Actor.ID,
+		TargetType: e.Target.Type,
+		TargetID:   e.Target.ID,
+		IP:         e.IP,
+		UserAgent:  e.UserAgent,
+		RequestID:  e.RequestID,
+		Metadata:   metadata,
+		CreatedAt:  e.CreatedAt.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(body)
+
+	return hex.EncodeToString(sum[:]), nil
+}
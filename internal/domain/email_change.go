@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// EmailChange is a pending request to move an account to NewEmail. The
+// address is only swapped once the token sent to NewEmail, whose hash is
+// TokenHash, is confirmed.
+type EmailChange struct {
+	ID          string
+	UserID      string
+	NewEmail    string
+	TokenHash   string
+	ExpiresAt   time.Time
+	ConfirmedAt *time.Time
+	CreatedAt   time.Time
+}
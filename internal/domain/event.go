@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventVersion is bumped whenever an event payload changes in a way that is
+// not backwards compatible. Consumers should ignore versions they do not know.
+const EventVersion = 1
+
+const (
+	// EventUserRegistered is emitted when an account is created, either by
+	// registration or by a first SSO login. Data: UserEventData.
+	EventUserRegistered EventType = "user.registered"
+	// EventUserEmailVerified is emitted when the user confirms their email
+	// address. Data: UserEventData.
+	EventUserEmailVerified EventType = "user.email_verified"
+	// EventUserUpdated is emitted when profile fields change.
+	// Data: UserEventData.
+	EventUserUpdated EventType = "user.updated"
+	// EventUserEmailChanged is emitted when a new email address is
+	// confirmed. Data: UserEventData with the new address.
+	EventUserEmailChanged EventType = "user.email_changed"
+	// EventUserPhoneVerified is emitted when the user confirms their phone
+	// number with an SMS code. Data: UserEventData.
+	EventUserPhoneVerified EventType = "user.phone_verified"
+	// EventUserPasswordChanged is emitted when the password is changed, reset
+	// or set for the first time. Data: UserEventData.
+	EventUserPasswordChanged EventType = "user.password_changed"
+	// EventUserDeactivated is emitted when an account is suspended.
+	// Data: UserEventData.
+	EventUserDeactivated EventType = "user.deactivated"
+	// EventUserReactivated is emitted when a suspension is lifted, either
+	// by an admin or because it reached its end date. Data: UserEventData.
+	EventUserReactivated EventType = "user.reactivated"
+	// EventUserDeleted is emitted when an account is removed.
+	// Data: UserEventData. Erasure sends only UserID.
+	EventUserDeleted EventType = "user.deleted"
+	// EventUserRoleAssigned is emitted when a role is granted to a user.
+	// Data: UserRoleEventData.
+	EventUserRoleAssigned EventType = "user.role_assigned"
+	// EventUserRoleRevoked is emitted when a role is removed from a user.
+	// Data: UserRoleEventData.
+	EventUserRoleRevoked EventType = "user.role_revoked"
+)
+
+const (
+	ActorTypeUser   ActorType = "user"
+	ActorTypeSystem ActorType = "system"
+	// ActorTypeSCIM is an identity provider calling the SCIM endpoints.
+	// The ID is the SCIM token ID.
+	ActorTypeSCIM ActorType = "scim"
+)
+
+type (
+	EventType string
+	ActorType string
+
+	// Event is the versioned envelope published for every domain event.
+	Event struct {
+		ID         string     `json:"id"`
+		Type       EventType  `json:"type"`
+		Version    int        `json:"version"`
+		OccurredAt time.Time  `json:"occurred_at"`
+		Actor      EventActor `json:"actor"`
+		Data       any        `json:"data"`
+	}
+
+	// EventActor identifies who caused the event. ID is the username for
+	// user actors, the token ID for SCIM actors and empty for system
+	// actors.
+	EventActor struct {
+		Type ActorType `json:"type"`
+		ID   string    `json:"id,omitempty"`
+	}
+
+	// UserEventData is the payload of user lifecycle events.
+	UserEventData struct {
+		UserID        string `json:"user_id"`
+		Username      string `json:"username"`
+		Email         string `json:"email"`
+		FirstName     string `json:"first_name"`
+		LastName      string `json:"last_name"`
+		IsActive      bool   `json:"is_active"`
+		VerifiedEmail bool   `json:"verified_email"`
+		VerifiedPhone bool   `json:"verified_phone"`
+		AuthMethod    string `json:"auth_method"`
+	}
+
+	// UserRoleEventData is the payload of role assignment events.
+	UserRoleEventData struct {
+		UserID   string   `json:"user_id"`
+		RoleID   string   `json:"role_id"`
+		RoleName RoleName `json:"role_name"`
+	}
+)
+
+// EventTypes lists every event type that can be published.
+var EventTypes = []EventType{
+	EventUserRegistered,
+	EventUserEmailVerified,
+	EventUserUpdated,
+	EventUserEmailChanged,
+	EventUserPhoneVerified,
+	EventUserPasswordChanged,
+	EventUserDeactivated,
+	EventUserReactivated,
+	EventUserDeleted,
+	EventUserRoleAssigned,
+	EventUserRoleRevoked,
+}
+
+func (t EventType) IsValid() bool {
+	for _, eventType := range EventTypes {
+		if eventType == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+func NewEvent(eventType EventType, actor EventActor, data any) Event {
+	return Event{
+		ID:         uuid.NewString(),
+		Type:       eventType,
+		Version:    EventVersion,
+		OccurredAt: time.Now().UTC(),
+		Actor:      actor,
+		Data:       data,
+	}
+}
+
+func UserActor(username string) EventActor {
+	return EventActor{
+		Type: ActorTypeUser,
+		ID:   username,
+	}
+}
+
+func SystemActor() EventActor {
+	return EventActor{
+		Type: ActorTypeSystem,
+	}
+}
+
+func SCIMActor(tokenID string) EventActor {
+	return EventActor{
+		Type: ActorTypeSCIM,
+		ID:   tokenID,
+	}
+}
+
+func NewUserEventData(user *User) UserEventData {
+	return UserEventData{
+		UserID:        user.ID,
+		Username:      user.Username,
+		Email:         user.Email,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		IsActive:      user.IsActive,
+		VerifiedEmail: user.VerifiedEmail,
+		VerifiedPhone: user.VerifiedPhone,
+		AuthMethod:    user.AuthMethod,
+	}
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	OutboxStatusPending OutboxStatus = "pending"
+	OutboxStatusSent    OutboxStatus = "sent"
+	OutboxStatusFailed  OutboxStatus = "failed"
+)
+
+const (
+	// OutboxKindJob messages are published to a work queue named by Topic.
+	OutboxKindJob OutboxKind = "job"
+	// OutboxKindEvent messages are domain events published to the events
+	// exchange with Topic as the routing key.
+	OutboxKindEvent OutboxKind = "event"
+)
+
+type (
+	OutboxStatus string
+	OutboxKind   string
+
+	OutboxMessage struct {
+		ID        string
+		Kind      OutboxKind
+		Topic     string
+		Payload   json.RawMessage
+		Status    OutboxStatus
+		Attempts  int
+		LastError *string
+		CreatedAt time.Time
+		SentAt    *time.Time
+	}
+)
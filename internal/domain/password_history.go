@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// PasswordHistoryEntry is a hash the user has had as their password.
+// CreatedAt is when that password was set.
+type PasswordHistoryEntry struct {
+	ID           string
+	UserID       string
+	PasswordHash string
+	CreatedAt    time.Time
+}
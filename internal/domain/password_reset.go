@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// PasswordResetRequest records a forgot-password request for Email, which
+// may not belong to any account.
+type PasswordResetRequest struct {
+	ID        string
+	Email     string
+	IP        string
+	CreatedAt time.Time
+}
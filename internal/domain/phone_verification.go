@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// PhoneVerification is a one-time code sent by SMS to PhoneNumber. Only a
+// hash of the code is kept.
+type PhoneVerification struct {
+	ID          string
+	UserID      string
+	PhoneNumber string
+	CodeHash    string
+	Attempts    int
+	ExpiresAt   time.Time
+	VerifiedAt  *time.Time
+	CreatedAt   time.Time
+}
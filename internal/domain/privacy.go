@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// DataExport is a generated archive of everything stored about a user.
+// It can be downloaded with the token whose hash is TokenHash until
+// ExpiresAt.
+type DataExport struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	Payload   []byte
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// AccountDeletion is a pending request to erase an account. The user can
+// cancel it until ScheduledFor, after which the account is erased.
+type AccountDeletion struct {
+	UserID       string
+	RequestedAt  time.Time
+	ScheduledFor time.Time
+}
@@ -1,11 +1,17 @@
 package domain
 
+import "errors"
+
 const (
 	RoleSuperAdmin RoleName = "superadmin"
 	RoleAdmin      RoleName = "admin"
 	RoleUser       RoleName = "user"
 )
 
+// ErrInsufficientRole is returned when a caller acts on a user, or grants
+// or revokes a role, ranking at or above its own.
+var ErrInsufficientRole = errors.New("you cannot manage a user or role at or above your own")
+
 type (
 	RoleName string
 
@@ -14,3 +20,32 @@ type (
 		Name RoleName
 	}
 )
+
+// Rank orders the built-in roles by privilege. Roles created at runtime
+// grant nothing by themselves and rank with RoleUser.
+func (n RoleName) Rank() int {
+	switch n {
+	case RoleSuperAdmin:
+		return 2
+	case RoleAdmin:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// HighestRank returns the highest Rank among roles.
+func HighestRank(roles []Role) int {
+	rank := RoleUser.Rank()
+	for _, role := range roles {
+		rank = max(rank, role.Name.Rank())
+	}
+
+	return rank
+}
+
+// Outranks reports whether a user holding roles may manage a user or
+// role of the given rank, which must be strictly below its own.
+func Outranks(roles []Role, rank int) bool {
+	return HighestRank(roles) > rank
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+// SCIMToken authenticates one identity provider connection to the SCIM
+// endpoints. The plain token is shown once, when it is created. A token
+// only reaches the users provisioned by tokens of the same Tenant.
+type SCIMToken struct {
+	ID         string
+	Name       string
+	Tenant     string
+	TokenHash  string
+	CreatedBy  string
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
@@ -30,6 +30,7 @@ type (
 		Address                  *string
 		IsActive                 bool
 		VerifiedEmail            bool
+		VerifiedPhone            bool
 		VerifiedEmailToken       string
 		VerifiedEmailTokenExpiry time.Time
 		PasswordResetToken       *string
@@ -37,6 +38,10 @@ type (
 		TokenVersion             uint
 		AuthMethod               string
 		Roles                    []Role
+		SuspendedAt              *time.Time
+		SuspendedUntil           *time.Time
+		SuspensionReason         *string
+		DeletedAt                *time.Time
 		CreatedAt                time.Time
 		UpdatedAt                time.Time
 	}
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// UserDevice is a device a user has logged in from. Fingerprint identifies
+// it across logins; IP and the location fields are from the latest one.
+// Latitude and Longitude are nil when the location is unknown.
+type UserDevice struct {
+	ID                   string
+	UserID               string
+	Fingerprint          string
+	UserAgent            string
+	IP                   string
+	Country              string
+	Region               string
+	City                 string
+	Latitude             *float64
+	Longitude            *float64
+	RevokeTokenHash      *string
+	RevokeTokenExpiresAt *time.Time
+	FirstSeenAt          time.Time
+	LastSeenAt           time.Time
+}
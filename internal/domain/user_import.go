@@ -0,0 +1,58 @@
+package domain
+
+import "time"
+
+type (
+	UserImportFormat    string
+	UserImportJobStatus string
+)
+
+const (
+	UserImportFormatCSV    UserImportFormat = "csv"
+	UserImportFormatNDJSON UserImportFormat = "ndjson"
+
+	UserImportJobPending   UserImportJobStatus = "pending"
+	UserImportJobRunning   UserImportJobStatus = "running"
+	UserImportJobCompleted UserImportJobStatus = "completed"
+	UserImportJobFailed    UserImportJobStatus = "failed"
+)
+
+// UserImportJob is a bulk import uploaded by an admin. The file is kept in
+// Payload until a worker processes it, after which only the counts and the
+// per-row errors remain.
+type UserImportJob struct {
+	ID          string
+	Format      UserImportFormat
+	DryRun      bool
+	SendInvites bool
+	Status      UserImportJobStatus
+	Payload     []byte
+	TotalRows   int
+	CreatedRows int
+	SkippedRows int
+	FailedRows  int
+	Errors      []UserImportRowError
+	Error       *string
+	CreatedBy   string
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+}
+
+// UserImportRowError explains why a row of an import was rejected. Row is
+// the 1-based record number, not counting a CSV header.
+type UserImportRowError struct {
+	Row   int    `json:"row"`
+	Email string `json:"email,omitempty"`
+	Error string `json:"error"`
+}
+
+// UserImportResult is what a finished job reports.
+type UserImportResult struct {
+	TotalRows   int
+	CreatedRows int
+	SkippedRows int
+	FailedRows  int
+	Errors      []UserImportRowError
+	Error       *string
+}
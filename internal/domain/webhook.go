@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+type (
+	WebhookDeliveryStatus string
+
+	// Webhook is an HTTP subscription to domain events. An empty EventTypes
+	// list subscribes to every event.
+	Webhook struct {
+		ID         string
+		URL        string
+		Secret     string
+		EventTypes []EventType
+		IsActive   bool
+		CreatedAt  time.Time
+		UpdatedAt  time.Time
+	}
+
+	// WebhookDelivery is one event queued for one webhook. Payload is the
+	// event envelope exactly as it is POSTed.
+	WebhookDelivery struct {
+		ID            string
+		WebhookID     string
+		EventID       string
+		EventType     EventType
+		Payload       json.RawMessage
+		Status        WebhookDeliveryStatus
+		Attempts      int
+		NextAttemptAt time.Time
+		CreatedAt     time.Time
+		UpdatedAt     time.Time
+	}
+
+	// WebhookDeliveryAttempt records the outcome of a single HTTP request.
+	WebhookDeliveryAttempt struct {
+		ID             int64
+		DeliveryID     string
+		Attempt        int
+		ResponseStatus *int
+		ResponseBody   *string
+		Error          *string
+		Duration       time.Duration
+		CreatedAt      time.Time
+	}
+)
+
+// Subscribes reports whether the webhook should receive events of eventType.
+func (w Webhook) Subscribes(eventType EventType) bool {
+	if len(w.EventTypes) == 0 {
+		return true
+	}
+
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
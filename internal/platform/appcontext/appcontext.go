@@ -10,6 +10,7 @@ import (
 
 type Context struct {
 	Repositories  *repositories.Repositories
+	Transactor    repositories.Transactor
 	Integrations  *integrations.Integrations
 	Publisher     queue.Publisher
 	ConfigService *config.ConfigurationService
@@ -28,6 +29,7 @@ func NewFactory(
 	return func(opts ...Option) *Context {
 		return &Context{
 			Repositories:  repositories.NewFactory(datasources, configService)(),
+			Transactor:    repositories.NewTransactor(datasources),
 			Integrations:  integrations,
 			Publisher:     publisher,
 			ConfigService: configService,
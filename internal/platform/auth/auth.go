@@ -9,20 +9,58 @@ import (
 	"github.com/tapiaw38/auth-api-be/internal/platform/config"
 )
 
+// ScopePasswordChange marks a token that may only be used to change an
+// expired password.
+const ScopePasswordChange = "password_change"
+
+// Authentication methods recorded in the amr claim.
+const (
+	AMRPassword = "pwd"
+	AMRGoogle   = "google"
+)
+
 type (
+	// Authentication describes how and when the user last proved who
+	// they are. It becomes the auth_time and amr claims.
+	Authentication struct {
+		Time    time.Time
+		Methods []string
+	}
+
 	CustomClaims struct {
 		UserID       string `json:"user_id"`
 		TokenVersion uint   `json:"token_version"`
+		Scope        string `json:"scope,omitempty"`
+		// EmailVerified is the user's state when the token was issued, so
+		// other services can require a verified email without a lookup.
+		EmailVerified bool     `json:"email_verified"`
+		AuthTime      int64    `json:"auth_time,omitempty"`
+		AMR           []string `json:"amr,omitempty"`
 		jwt.StandardClaims
 	}
 )
 
-func GenerateToken(user *domain.User, expiration time.Duration) (string, error) {
+func GenerateToken(user *domain.User, authn Authentication, expiration time.Duration) (string, error) {
+	return generateToken(user, "", authn, expiration)
+}
+
+// GeneratePasswordChangeToken issues a token restricted to
+// ScopePasswordChange.
+func GeneratePasswordChangeToken(user *domain.User, authn Authentication, expiration time.Duration) (string, error) {
+	return generateToken(user, ScopePasswordChange, authn, expiration)
+}
+
+func generateToken(user *domain.User, scope string, authn Authentication, expiration time.Duration) (string, error) {
 	claims := CustomClaims{
-		UserID:       user.Username,
-		TokenVersion: user.TokenVersion,
+		UserID:        user.Username,
+		TokenVersion:  user.TokenVersion,
+		Scope:         scope,
+		EmailVerified: user.VerifiedEmail,
+		AuthTime:      authn.Time.Unix(),
+		AMR:           authn.Methods,
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: time.Now().Add(expiration).Unix(),
+			IssuedAt:  time.Now().Unix(),
 		},
 	}
 
@@ -53,3 +91,16 @@ func ValidateToken(tokenStr string) (*CustomClaims, error) {
 
 	return claims, nil
 }
+
+// AuthenticatedAt returns when the user last authenticated. Tokens issued
+// before auth_time was added fall back to their issue time, which was the
+// login time.
+func (c *CustomClaims) AuthenticatedAt() time.Time {
+	if c.AuthTime > 0 {
+		return time.Unix(c.AuthTime, 0)
+	}
+	if c.IssuedAt > 0 {
+		return time.Unix(c.IssuedAt, 0)
+	}
+	return time.Time{}
+}
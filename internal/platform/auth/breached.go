@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// breachedPrefixLength is the length of the hash prefix the corpus is
+// bucketed by, the same split the Pwned Passwords range API uses.
+const breachedPrefixLength = 5
+
+// BreachedPasswords is a local corpus of SHA-1 hashes of leaked passwords,
+// bucketed by hash prefix. A nil corpus contains nothing.
+type BreachedPasswords struct {
+	suffixes map[string][]string
+}
+
+var breachedPasswords *BreachedPasswords
+
+// SetBreachedPasswords sets the corpus ValidatePassword checks against.
+func SetBreachedPasswords(corpus *BreachedPasswords) {
+	breachedPasswords = corpus
+}
+
+// LoadBreachedPasswordsFile reads a corpus from path. See
+// LoadBreachedPasswords for the format.
+func LoadBreachedPasswordsFile(path string) (*BreachedPasswords, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return LoadBreachedPasswords(file)
+}
+
+// LoadBreachedPasswords reads one uppercase or lowercase SHA-1 hash per
+// line, optionally followed by a colon and a count, which is the format of
+// the Pwned Passwords downloader. Blank lines and lines starting with # are
+// skipped.
+func LoadBreachedPasswords(r io.Reader) (*BreachedPasswords, error) {
+	corpus := &BreachedPasswords{suffixes: map[string][]string{}}
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		hash, _, _ := strings.Cut(text, ":")
+		hash = strings.ToUpper(strings.TrimSpace(hash))
+		if len(hash) != sha1.Size*2 {
+			return nil, fmt.Errorf("breached passwords line %d: expected a SHA-1 hash", line)
+		}
+		if _, err := hex.DecodeString(hash); err != nil {
+			return nil, fmt.Errorf("breached passwords line %d: expected a SHA-1 hash", line)
+		}
+
+		prefix := hash[:breachedPrefixLength]
+		corpus.suffixes[prefix] = append(corpus.suffixes[prefix], hash[breachedPrefixLength:])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, suffixes := range corpus.suffixes {
+		sort.Strings(suffixes)
+	}
+
+	return corpus, nil
+}
+
+// Contains reports whether password is in the corpus.
+func (b *BreachedPasswords) Contains(password string) bool {
+	if b == nil {
+		return false
+	}
+
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	suffixes := b.suffixes[hash[:breachedPrefixLength]]
+	suffix := hash[breachedPrefixLength:]
+	i := sort.SearchStrings(suffixes, suffix)
+
+	return i < len(suffixes) && suffixes[i] == suffix
+}
+
+// Len returns the number of hashes in the corpus.
+func (b *BreachedPasswords) Len() int {
+	if b == nil {
+		return 0
+	}
+
+	total := 0
+	for _, suffixes := range b.suffixes {
+		total += len(suffixes)
+	}
+
+	return total
+}
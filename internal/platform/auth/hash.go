@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const argon2idPrefix = "$argon2id$"
+
+var errUnsupportedHash = errors.New("unsupported password hash format, expected bcrypt or argon2id")
+
+// PasswordHashParams are the argon2id parameters for new hashes. Memory is
+// in KiB; lengths are in bytes.
+type PasswordHashParams struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultPasswordHashParams follow the OWASP recommendation for argon2id.
+var DefaultPasswordHashParams = PasswordHashParams{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+var hashParams = DefaultPasswordHashParams
+
+// SetPasswordHashParams changes the parameters used by HashedPassword. It
+// is meant to be called once at startup; zero fields keep their defaults.
+func SetPasswordHashParams(params PasswordHashParams) {
+	if params.Memory == 0 {
+		params.Memory = DefaultPasswordHashParams.Memory
+	}
+	if params.Iterations == 0 {
+		params.Iterations = DefaultPasswordHashParams.Iterations
+	}
+	if params.Parallelism == 0 {
+		params.Parallelism = DefaultPasswordHashParams.Parallelism
+	}
+	if params.SaltLength == 0 {
+		params.SaltLength = DefaultPasswordHashParams.SaltLength
+	}
+	if params.KeyLength == 0 {
+		params.KeyLength = DefaultPasswordHashParams.KeyLength
+	}
+
+	hashParams = params
+}
+
+type argon2idHash struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+// ValidatePasswordHash checks that hash is a bcrypt or argon2id hash this
+// package can verify, so pre-hashed passwords can be imported as is.
+func ValidatePasswordHash(hash string) error {
+	if strings.HasPrefix(hash, argon2idPrefix) {
+		_, err := parseArgon2id(hash)
+		return err
+	}
+
+	if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+		return errUnsupportedHash
+	}
+
+	return nil
+}
+
+// NeedsRehash reports whether hash should be replaced after the password
+// has been verified: bcrypt hashes, and argon2id hashes weaker than the
+// current parameters. Hashes it cannot parse are left alone.
+func NeedsRehash(hash string) bool {
+	if !strings.HasPrefix(hash, argon2idPrefix) {
+		_, err := bcrypt.Cost([]byte(hash))
+		return err == nil
+	}
+
+	parsed, err := parseArgon2id(hash)
+	if err != nil {
+		return false
+	}
+
+	return parsed.memory < hashParams.Memory ||
+		parsed.time < hashParams.Iterations ||
+		parsed.threads < hashParams.Parallelism ||
+		uint32(len(parsed.salt)) < hashParams.SaltLength ||
+		uint32(len(parsed.key)) < hashParams.KeyLength
+}
+
+func hashArgon2id(password string, params PasswordHashParams) (string, error) {
+	salt := make([]byte, params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	return fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		params.Memory,
+		params.Iterations,
+		params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// parseArgon2id decodes the PHC string format used by the reference
+// implementation: $argon2id$v=19$m=65536,t=3,p=4$<salt>$<key>.
+func parseArgon2id(hash string) (*argon2idHash, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, errUnsupportedHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, errors.New("unsupported argon2id version")
+	}
+
+	parsed := &argon2idHash{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &parsed.memory, &parsed.time, &parsed.threads); err != nil {
+		return nil, errors.New("invalid argon2id parameters")
+	}
+	if parsed.memory == 0 || parsed.time == 0 || parsed.threads == 0 {
+		return nil, errors.New("invalid argon2id parameters")
+	}
+
+	var err error
+	if parsed.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, errors.New("invalid argon2id salt")
+	}
+	if parsed.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(parsed.key) == 0 {
+		return nil, errors.New("invalid argon2id key")
+	}
+
+	return parsed, nil
+}
+
+func compareArgon2id(password, hash string) error {
+	parsed, err := parseArgon2id(hash)
+	if err != nil {
+		return err
+	}
+
+	key := argon2.IDKey([]byte(password), parsed.salt, parsed.time, parsed.memory, parsed.threads, uint32(len(parsed.key)))
+	if subtle.ConstantTimeCompare(key, parsed.key) != 1 {
+		return errors.New("invalid credentials")
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package auth_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func argon2idHash(password string) string {
+	salt := []byte("0123456789abcdef")
+	key := argon2.IDKey([]byte(password), salt, 1, 8*1024, 1, 32)
+
+	return fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, 8*1024, 1, 1,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)
+}
+
+func TestValidatePasswordHash(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("Password123!"), bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	tests := map[string]struct {
+		hash        string
+		expectedErr string
+	}{
+		"when the hash is bcrypt": {
+			hash: string(bcryptHash),
+		},
+		"when the hash is argon2id": {
+			hash: argon2idHash("Password123!"),
+		},
+		"when the argon2id parameters are malformed": {
+			hash:        "$argon2id$v=19$m=abc$c2FsdA$a2V5",
+			expectedErr: "invalid argon2id parameters",
+		},
+		"when the argon2id version is unknown": {
+			hash:        "$argon2id$v=16$m=65536,t=3,p=4$c2FsdA$a2V5",
+			expectedErr: "unsupported argon2id version",
+		},
+		"when the hash is plain text": {
+			hash:        "Password123!",
+			expectedErr: "unsupported password hash format, expected bcrypt or argon2id",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := auth.ValidatePasswordHash(tc.hash)
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestComparePassword_Argon2id(t *testing.T) {
+	hash := argon2idHash("Password123!")
+
+	assert.NoError(t, auth.ComparePassword("Password123!", hash))
+	assert.EqualError(t, auth.ComparePassword("wrong", hash), "invalid credentials")
+}
+
+func TestHashedPassword(t *testing.T) {
+	hash, err := auth.HashedPassword("Password123!")
+	assert.NoError(t, err)
+
+	assert.Contains(t, string(hash), "$argon2id$v=19$m=65536,t=3,p=2$")
+	assert.NoError(t, auth.ValidatePasswordHash(string(hash)))
+	assert.NoError(t, auth.ComparePassword("Password123!", string(hash)))
+	assert.False(t, auth.NeedsRehash(string(hash)))
+}
+
+func TestNeedsRehash(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("Password123!"), bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	current, err := auth.HashedPassword("Password123!")
+	assert.NoError(t, err)
+
+	tests := map[string]struct {
+		hash     string
+		expected bool
+	}{
+		"when the hash is bcrypt": {
+			hash:     string(bcryptHash),
+			expected: true,
+		},
+		"when the argon2id parameters are weaker": {
+			hash:     argon2idHash("Password123!"),
+			expected: true,
+		},
+		"when the argon2id parameters are current": {
+			hash:     string(current),
+			expected: false,
+		},
+		"when the hash cannot be parsed": {
+			hash:     "Password123!",
+			expected: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, auth.NeedsRehash(tc.hash))
+		})
+	}
+}
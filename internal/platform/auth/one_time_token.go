@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+)
+
+const oneTimeTokenBytes = 32
+
+// OneTimeToken is a random secret sent to a user by email, such as an
+// email verification or password reset link. Only Hash is stored, so a
+// copy of the database can't be used to take over accounts.
+type OneTimeToken struct {
+	Plain string
+	Hash  string
+}
+
+// NewOneTimeToken returns a fresh token and its hash.
+func NewOneTimeToken() (OneTimeToken, error) {
+	b := make([]byte, oneTimeTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return OneTimeToken{}, err
+	}
+
+	plain := hex.EncodeToString(b)
+
+	return OneTimeToken{
+		Plain: plain,
+		Hash:  HashOneTimeToken(plain),
+	}, nil
+}
+
+// HashOneTimeToken returns the hex SHA-256 of token, used to look the
+// token up.
+func HashOneTimeToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// OneTimeTokenMatches reports whether token hashes to hash, comparing in
+// constant time.
+func OneTimeTokenMatches(token, hash string) bool {
+	if token == "" || hash == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(HashOneTimeToken(token)), []byte(hash)) == 1
+}
@@ -0,0 +1,35 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+func TestOneTimeToken(t *testing.T) {
+	token, err := auth.NewOneTimeToken()
+	assert.NoError(t, err)
+	assert.Len(t, token.Plain, 64)
+	assert.Len(t, token.Hash, 64)
+	assert.NotEqual(t, token.Plain, token.Hash)
+	assert.Equal(t, auth.HashOneTimeToken(token.Plain), token.Hash)
+
+	tests := map[string]struct {
+		token  string
+		hash   string
+		expect bool
+	}{
+		"when the token matches":      {token: token.Plain, hash: token.Hash, expect: true},
+		"when the token is different": {token: "other", hash: token.Hash},
+		"when the hash is presented":  {token: token.Hash, hash: token.Hash},
+		"when the token is empty":     {token: "", hash: auth.HashOneTimeToken("")},
+		"when no token is stored":     {token: token.Plain, hash: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, auth.OneTimeTokenMatches(tt.token, tt.hash))
+		})
+	}
+}
@@ -4,23 +4,32 @@ import (
 	"errors"
 	"regexp"
 	"strings"
-	"unicode"
 
 	"golang.org/x/crypto/bcrypt"
 	"github.com/tapiaw38/auth-api-be/internal/platform/utils"
 )
 
+// HashedPassword hashes password with argon2id using the parameters set
+// with SetPasswordHashParams.
 func HashedPassword(password string) ([]byte, error) {
-	hashCost := 8
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), hashCost)
+	hash, err := hashArgon2id(password, hashParams)
 	if err != nil {
 		return nil, err
 	}
 
-	return bytes, nil
+	return []byte(hash), nil
 }
 
+// ComparePassword checks password against an argon2id hash, or a bcrypt
+// hash from before argon2id was the default or brought in by an import.
 func ComparePassword(password, hash string) error {
+	if strings.HasPrefix(hash, argon2idPrefix) {
+		if err := compareArgon2id(password, hash); err != nil {
+			return errors.New("invalid credentials")
+		}
+		return nil
+	}
+
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	if err != nil {
 		return errors.New("invalid credentials")
@@ -29,50 +38,10 @@ func ComparePassword(password, hash string) error {
 	return nil
 }
 
+// ValidatePasswordStrength is ValidatePassword without personal
+// information to compare against.
 func ValidatePasswordStrength(password string) error {
-	if len(password) < 8 {
-		return errors.New("password must be at least 8 characters long")
-	}
-
-	var (
-		hasUpper   bool
-		hasLower   bool
-		hasNumber  bool
-		hasSpecial bool
-	)
-
-	specialChars := "!@#$%^&*()_+-=[]{}|;:,.<>?"
-
-	for _, char := range password {
-		switch {
-		case unicode.IsUpper(char):
-			hasUpper = true
-		case unicode.IsLower(char):
-			hasLower = true
-		case unicode.IsNumber(char):
-			hasNumber = true
-		case strings.ContainsRune(specialChars, char):
-			hasSpecial = true
-		}
-	}
-
-	if !hasUpper {
-		return errors.New("password must contain at least one uppercase letter")
-	}
-
-	if !hasLower {
-		return errors.New("password must contain at least one lowercase letter")
-	}
-
-	if !hasNumber {
-		return errors.New("password must contain at least one number")
-	}
-
-	if !hasSpecial {
-		return errors.New("password must contain at least one special character (!@#$%^&*()_+-=[]{}|;:,.<>?)")
-	}
-
-	return nil
+	return ValidatePassword(password)
 }
 
 // ValidateEmail validates the email format
@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordErrorCode identifies why a password was rejected, so clients can
+// show their own message.
+type PasswordErrorCode string
+
+const (
+	PasswordTooShort             PasswordErrorCode = "password_too_short"
+	PasswordTooLong              PasswordErrorCode = "password_too_long"
+	PasswordMissingUppercase     PasswordErrorCode = "password_missing_uppercase"
+	PasswordMissingLowercase     PasswordErrorCode = "password_missing_lowercase"
+	PasswordMissingNumber        PasswordErrorCode = "password_missing_number"
+	PasswordMissingSpecial       PasswordErrorCode = "password_missing_special"
+	PasswordTooWeak              PasswordErrorCode = "password_too_weak"
+	PasswordContainsPersonalInfo PasswordErrorCode = "password_contains_personal_info"
+	PasswordBreached             PasswordErrorCode = "password_breached"
+	PasswordReused               PasswordErrorCode = "password_reused"
+)
+
+// PasswordPolicyError is returned when a password does not meet the policy.
+type PasswordPolicyError struct {
+	Code    PasswordErrorCode
+	Message string
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return e.Message
+}
+
+func newPasswordPolicyError(code PasswordErrorCode, format string, args ...any) *PasswordPolicyError {
+	return &PasswordPolicyError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// PasswordPolicy is the set of rules new passwords must follow. MinScore is
+// compared with PasswordScore, from 0 to 4; 0 disables the check.
+type PasswordPolicy struct {
+	MinLength          int
+	MaxLength          int
+	RequireUppercase   bool
+	RequireLowercase   bool
+	RequireNumber      bool
+	RequireSpecial     bool
+	SpecialCharacters  string
+	MinScore           int
+	RejectPersonalInfo bool
+}
+
+var DefaultPasswordPolicy = PasswordPolicy{
+	MinLength:          8,
+	MaxLength:          128,
+	RequireUppercase:   true,
+	RequireLowercase:   true,
+	RequireNumber:      true,
+	RequireSpecial:     true,
+	SpecialCharacters:  "!@#$%^&*()_+-=[]{}|;:,.<>?",
+	MinScore:           2,
+	RejectPersonalInfo: true,
+}
+
+var passwordPolicy = DefaultPasswordPolicy
+
+// SetPasswordPolicy changes the policy used by ValidatePassword. It is
+// meant to be called once at startup.
+func SetPasswordPolicy(policy PasswordPolicy) {
+	if policy.SpecialCharacters == "" {
+		policy.SpecialCharacters = DefaultPasswordPolicy.SpecialCharacters
+	}
+
+	passwordPolicy = policy
+}
+
+// ValidatePassword checks password against the configured policy and the
+// breached password corpus. personal holds the user's names, username and
+// email, none of which may appear in the password. Failures are
+// *PasswordPolicyError.
+func ValidatePassword(password string, personal ...string) error {
+	if err := passwordPolicy.Validate(password, personal...); err != nil {
+		return err
+	}
+
+	if breachedPasswords.Contains(password) {
+		return newPasswordPolicyError(PasswordBreached, "password has appeared in a data breach, please choose another one")
+	}
+
+	return nil
+}
+
+// Validate checks the rules of p. It does not consult the breached password
+// corpus.
+func (p PasswordPolicy) Validate(password string, personal ...string) error {
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength {
+		return newPasswordPolicyError(PasswordTooShort, "password must be at least %d characters long", p.MinLength)
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		return newPasswordPolicyError(PasswordTooLong, "password must not exceed %d characters", p.MaxLength)
+	}
+
+	var hasUpper, hasLower, hasNumber, hasSpecial bool
+	for _, char := range password {
+		switch {
+		case unicode.IsUpper(char):
+			hasUpper = true
+		case unicode.IsLower(char):
+			hasLower = true
+		case unicode.IsNumber(char):
+			hasNumber = true
+		case strings.ContainsRune(p.SpecialCharacters, char):
+			hasSpecial = true
+		}
+	}
+
+	switch {
+	case p.RequireUppercase && !hasUpper:
+		return newPasswordPolicyError(PasswordMissingUppercase, "password must contain at least one uppercase letter")
+	case p.RequireLowercase && !hasLower:
+		return newPasswordPolicyError(PasswordMissingLowercase, "password must contain at least one lowercase letter")
+	case p.RequireNumber && !hasNumber:
+		return newPasswordPolicyError(PasswordMissingNumber, "password must contain at least one number")
+	case p.RequireSpecial && !hasSpecial:
+		return newPasswordPolicyError(PasswordMissingSpecial, "password must contain at least one special character (%s)", p.SpecialCharacters)
+	}
+
+	if p.RejectPersonalInfo && containsPersonalInfo(password, personal) {
+		return newPasswordPolicyError(PasswordContainsPersonalInfo, "password must not contain your name or email")
+	}
+
+	if p.MinScore > 0 && PasswordScore(password) < p.MinScore {
+		return newPasswordPolicyError(PasswordTooWeak, "password is too easy to guess")
+	}
+
+	return nil
+}
+
+// containsPersonalInfo reports whether password contains one of the values
+// in personal, or a part of one. Emails are split at the @ and at common
+// separators. Parts shorter than three characters are ignored.
+func containsPersonalInfo(password string, personal []string) bool {
+	lower := strings.ToLower(password)
+
+	for _, value := range personal {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if at := strings.LastIndex(value, "@"); at >= 0 {
+			value = value[:at]
+		}
+
+		parts := strings.FieldsFunc(value, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+		for _, part := range parts {
+			if utf8.RuneCountInString(part) >= 3 && strings.Contains(lower, part) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,120 @@
+package auth_test
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	policy := auth.DefaultPasswordPolicy
+	policy.MinLength = 10
+	policy.MaxLength = 20
+	policy.MinScore = 3
+
+	tests := map[string]struct {
+		password     string
+		personal     []string
+		expectedCode auth.PasswordErrorCode
+	}{
+		"when the password meets the policy": {
+			password: "Tr0ub4dor&3x",
+			personal: []string{"John", "Doe", "john.doe@example.com"},
+		},
+		"when the password is too short": {
+			password:     "Sh0rt!pw",
+			expectedCode: auth.PasswordTooShort,
+		},
+		"when the password is too long": {
+			password:     "Tr0ub4dor&3xTr0ub4dor&3x",
+			expectedCode: auth.PasswordTooLong,
+		},
+		"when a character class is missing": {
+			password:     "tr0ub4dor&3x",
+			expectedCode: auth.PasswordMissingUppercase,
+		},
+		"when the password contains the user's name": {
+			password:     "Johnathan&2024",
+			personal:     []string{"John", "Doe", "jd@example.com"},
+			expectedCode: auth.PasswordContainsPersonalInfo,
+		},
+		"when the password contains the email local part": {
+			password:     "Xy!9doeville",
+			personal:     []string{"Jane", "Roe", "jane.doeville@example.com"},
+			expectedCode: auth.PasswordContainsPersonalInfo,
+		},
+		"when the password is a common word with substitutions": {
+			password:     "P@ssw0rd!!!",
+			expectedCode: auth.PasswordTooWeak,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := policy.Validate(tc.password, tc.personal...)
+
+			if tc.expectedCode == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var policyErr *auth.PasswordPolicyError
+			if assert.ErrorAs(t, err, &policyErr) {
+				assert.Equal(t, tc.expectedCode, policyErr.Code)
+			}
+		})
+	}
+}
+
+func TestPasswordScore(t *testing.T) {
+	tests := map[string]struct {
+		password string
+		expected int
+	}{
+		"when the password is empty":             {password: "", expected: 0},
+		"when the password is a repeated digit":  {password: "1111111", expected: 0},
+		"when the password is a common word":     {password: "password", expected: 1},
+		"when the password is a word and digits": {password: "Password1!", expected: 2},
+		"when the password is random":            {password: "xK9#mP2$vL", expected: 4},
+		"when the password is a long passphrase": {password: "correct horse battery staple", expected: 4},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, auth.PasswordScore(tc.password))
+		})
+	}
+}
+
+func TestBreachedPasswords(t *testing.T) {
+	sum := sha1.Sum([]byte("Summer2024!"))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	corpus, err := auth.LoadBreachedPasswords(strings.NewReader(strings.Join([]string{
+		"# sample",
+		hash + ":3861493",
+		"",
+		"0000000CAEF405439D57847A8657218C618160B2:2",
+	}, "\n")))
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, corpus.Len())
+	assert.True(t, corpus.Contains("Summer2024!"))
+	assert.False(t, corpus.Contains("Winter2024!"))
+
+	auth.SetBreachedPasswords(corpus)
+	defer auth.SetBreachedPasswords(nil)
+
+	var policyErr *auth.PasswordPolicyError
+	if assert.ErrorAs(t, auth.ValidatePassword("Summer2024!"), &policyErr) {
+		assert.Equal(t, auth.PasswordBreached, policyErr.Code)
+	}
+	assert.NoError(t, auth.ValidatePassword("Winter2024!"))
+
+	_, err = auth.LoadBreachedPasswords(strings.NewReader("not-a-hash\n"))
+	assert.EqualError(t, err, "breached passwords line 1: expected a SHA-1 hash")
+}
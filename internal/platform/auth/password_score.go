@@ -0,0 +1,188 @@
+package auth
+
+import (
+	"math"
+	"unicode"
+)
+
+// commonWordBits is what a dictionary word costs an attacker, roughly the
+// rank of a word in the common password lists.
+const commonWordBits = 11
+
+// commonPasswordWords are fragments that guessing tools try first.
+var commonPasswordWords = []string{
+	"password", "passwort", "contraseña", "contrasena", "iloveyou", "princess",
+	"football", "baseball", "sunshine", "superman", "welcome", "monkey", "dragon",
+	"letmein", "master", "shadow", "qwerty", "asdfgh", "zxcvbn", "admin", "login",
+	"secret", "hello", "abc123", "trustno1", "access", "flower", "michael", "summer",
+	"winter", "spring", "autumn", "soccer", "hockey", "batman", "starwars", "pokemon",
+	"charlie", "freedom", "whatever", "qazwsx", "killer", "hunter", "ninja", "pass",
+	"love", "god",
+}
+
+// leetSubstitutions maps the usual character swaps back to letters.
+var leetSubstitutions = map[rune]rune{
+	'@': 'a', '4': 'a', '8': 'b', '(': 'c', '3': 'e', '6': 'g', '1': 'i',
+	'!': 'i', '|': 'l', '0': 'o', '$': 's', '5': 's', '7': 't', '2': 'z',
+}
+
+// PasswordScore estimates how hard password is to guess, from 0 (trivial)
+// to 4 (strong), on the same guess thresholds as zxcvbn. It charges
+// dictionary words, repeated characters and sequences as single guesses
+// instead of per character.
+func PasswordScore(password string) int {
+	bits := passwordEntropy(password)
+
+	switch {
+	case bits < 10:
+		return 0
+	case bits < 20:
+		return 1
+	case bits < 26.6:
+		return 2
+	case bits < 33.2:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func passwordEntropy(password string) float64 {
+	runes := []rune(password)
+	if len(runes) == 0 {
+		return 0
+	}
+
+	perChar := math.Log2(float64(charsetSize(runes)))
+
+	// Words are matched with the substitutions undone; repeats and
+	// sequences on the lowercased password, so 123 stays a sequence.
+	lowered := make([]rune, len(runes))
+	normalized := make([]rune, len(runes))
+	for i, r := range runes {
+		lowered[i] = unicode.ToLower(r)
+		normalized[i] = lowered[i]
+		if letter, ok := leetSubstitutions[lowered[i]]; ok {
+			normalized[i] = letter
+		}
+	}
+
+	covered := make([]bool, len(runes))
+	bits := 0.0
+
+	for _, word := range commonPasswordWords {
+		needle := []rune(word)
+		for start := 0; start+len(needle) <= len(normalized); start++ {
+			if !matchesAt(normalized, needle, start, covered) {
+				continue
+			}
+
+			bits += commonWordBits
+			segment := runes[start : start+len(needle)]
+			if hasUpper(segment) {
+				bits++
+			}
+			if string(lowered[start:start+len(needle)]) != word {
+				bits++
+			}
+
+			for i := start; i < start+len(needle); i++ {
+				covered[i] = true
+			}
+			start += len(needle) - 1
+		}
+	}
+
+	for i := 0; i < len(normalized); {
+		if covered[i] {
+			i++
+			continue
+		}
+
+		end := patternEnd(lowered, covered, i)
+		if length := end - i; length >= 3 {
+			bits += perChar + math.Log2(float64(length))
+		} else {
+			bits += perChar * float64(length)
+		}
+		i = end
+	}
+
+	return bits
+}
+
+// patternEnd returns the end of the repeat or sequence starting at start,
+// or start+1 when there is none.
+func patternEnd(runes []rune, covered []bool, start int) int {
+	end := start + 1
+	if end >= len(runes) || covered[end] {
+		return end
+	}
+
+	step := runes[end] - runes[start]
+	if step < -1 || step > 1 {
+		return end
+	}
+
+	for end < len(runes) && !covered[end] && runes[end]-runes[end-1] == step {
+		end++
+	}
+
+	if end-start < 3 {
+		return start + 1
+	}
+
+	return end
+}
+
+func matchesAt(haystack, needle []rune, start int, covered []bool) bool {
+	for i, r := range needle {
+		if covered[start+i] || haystack[start+i] != r {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasUpper(runes []rune) bool {
+	for _, r := range runes {
+		if unicode.IsUpper(r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func charsetSize(runes []rune) int {
+	var lower, upper, digit, other bool
+	for _, r := range runes {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+
+	size := 0
+	if lower {
+		size += 26
+	}
+	if upper {
+		size += 26
+	}
+	if digit {
+		size += 10
+	}
+	if other {
+		size += 33
+	}
+
+	return size
+}
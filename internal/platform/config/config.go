@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 var configService *ConfigurationService
 
 const (
@@ -11,21 +13,35 @@ type (
 	GinModeServer string
 
 	ConfigurationService struct {
-		AppName      string
-		ServerConfig ServerConfig
-		DBConfig     DBConfig
-		S3Config     S3Config
-		GCPConfig    GCPConfig
-		RabbitMQ     RabbitMQConfig
-		Notification NotificationConfig
-		InitConfig   InitConfig
+		AppName           string
+		ServerConfig      ServerConfig
+		DBConfig          DBConfig
+		S3Config          S3Config
+		Storage           StorageConfig
+		GCPConfig         GCPConfig
+		RabbitMQ          RabbitMQConfig
+		Queue             QueueConfig
+		Outbox            OutboxConfig
+		Webhook           WebhookConfig
+		Notification      NotificationConfig
+		Privacy           PrivacyConfig
+		PasswordHash      PasswordHashConfig
+		PasswordPolicy    PasswordPolicyConfig
+		PasswordReset     PasswordResetConfig
+		EmailVerification EmailVerificationConfig
+		LoginSecurity     LoginSecurityConfig
+		InitConfig        InitConfig
 	}
 
+	// ServerConfig.TrustedProxies lists the proxies whose X-Forwarded-For
+	// header is believed when resolving the client IP. When empty, no proxy
+	// is trusted and the client IP is the address of the connection.
 	ServerConfig struct {
-		GinMode   GinModeServer
-		Port      string
-		Host      string
-		JWTSecret string
+		GinMode        GinModeServer
+		Port           string
+		Host           string
+		JWTSecret      string
+		TrustedProxies []string
 	}
 
 	DBConfig struct {
@@ -37,6 +53,15 @@ type (
 		AWSAccessKeyID     string
 		AWSSecretAccessKey string
 		AWSBucket          string
+		Endpoint           string
+		PublicURL          string
+	}
+
+	StorageConfig struct {
+		Backend       string
+		LocalPath     string
+		LocalURL      string
+		MaxAvatarSize int64
 	}
 
 	OAuth2Config struct {
@@ -50,7 +75,32 @@ type (
 	}
 
 	RabbitMQConfig struct {
-		URL string
+		URL            string
+		ConfirmTimeout time.Duration
+		EventsExchange string
+	}
+
+	QueueConfig struct {
+		Backend          string
+		PollInterval     time.Duration
+		MaxAttempts      int
+		MemoryBufferSize int
+	}
+
+	OutboxConfig struct {
+		PollInterval time.Duration
+		BatchSize    int
+		MaxAttempts  int
+	}
+
+	// WebhookConfig.AllowPrivateNetworks lets webhooks target loopback and
+	// private addresses, for local development only.
+	WebhookConfig struct {
+		Timeout              time.Duration
+		PollInterval         time.Duration
+		BatchSize            int
+		MaxAttempts          int
+		AllowPrivateNetworks bool
 	}
 
 	EmailConfig struct {
@@ -60,10 +110,79 @@ type (
 		Password string
 	}
 
+	SMSConfig struct {
+		Provider string
+		URL      string
+		APIKey   string
+		From     string
+		Timeout  time.Duration
+	}
+
 	NotificationConfig struct {
 		Email EmailConfig
+		SMS   SMSConfig
+	}
+
+	PrivacyConfig struct {
+		DeletionGracePeriod time.Duration
+		ExportTTL           time.Duration
+		ErasurePollInterval time.Duration
+	}
+
+	// PasswordHashConfig holds the argon2id parameters for new password
+	// hashes. Memory is in KiB.
+	PasswordHashConfig struct {
+		Memory      int
+		Iterations  int
+		Parallelism int
+	}
+
+	// PasswordPolicyConfig holds the rules for new passwords.
+	// BreachedPasswordsFile, when set, is a file of SHA-1 hashes of leaked
+	// passwords to reject. HistorySize is how many previous passwords can't
+	// be reused, and ExpiryDays maps a role name to the maximum password age.
+	PasswordPolicyConfig struct {
+		MinLength             int
+		MaxLength             int
+		RequireUppercase      bool
+		RequireLowercase      bool
+		RequireNumber         bool
+		RequireSpecial        bool
+		MinScore              int
+		RejectPersonalInfo    bool
+		BreachedPasswordsFile string
+		HistorySize           int
+		ExpiryDays            map[string]int
+	}
+
+	// PasswordResetConfig throttles forgot-password requests per email and
+	// per client IP. Every request takes at least MinResponseTime, so the
+	// response time doesn't reveal whether the account exists.
+	PasswordResetConfig struct {
+		EmailHourlyLimit int
+		IPHourlyLimit    int
+		MinResponseTime  time.Duration
+	}
+
+	// EmailVerificationConfig sets how long a user must wait before asking
+	// for another verification email, and whether routes guarded by
+	// RequireVerifiedEmail enforce it.
+	EmailVerificationConfig struct {
+		ResendInterval time.Duration
+		Required       bool
 	}
-	
+
+	// LoginSecurityConfig points at the offline GeoIP database and IP
+	// blocklist used to locate logins and flag suspicious ones. Logins
+	// implying travel faster than MaxTravelSpeedKmh are flagged as
+	// impossible travel.
+	LoginSecurityConfig struct {
+		GeoIPDatabaseFile string
+		IPBlocklistFile   string
+		MaxTravelSpeedKmh int
+		NotifyNewDevice   bool
+	}
+
 	InitConfig struct {
 		EnsureDefaultRoles bool
 	}
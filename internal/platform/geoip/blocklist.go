@@ -0,0 +1,110 @@
+package geoip
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"strings"
+)
+
+// Blocklist is a set of IP ranges known for abuse, such as Tor exit nodes
+// or hijacked networks. A nil blocklist contains nothing.
+type Blocklist struct {
+	prefixes []netip.Prefix
+}
+
+var blocklist *Blocklist
+
+// SetBlocklist sets the blocklist IsBlocked checks against.
+func SetBlocklist(list *Blocklist) {
+	blocklist = list
+}
+
+// IsBlocked reports whether ip is in the blocklist set with SetBlocklist.
+func IsBlocked(ip string) bool {
+	return blocklist.Contains(ip)
+}
+
+// LoadBlocklistFile reads a blocklist from path. See LoadBlocklist for the
+// format.
+func LoadBlocklistFile(path string) (*Blocklist, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return LoadBlocklist(file)
+}
+
+// LoadBlocklist reads one CIDR range or single address per line. Anything
+// after a # or ; is a comment, which covers both the FireHOL and the
+// Spamhaus DROP list formats.
+func LoadBlocklist(r io.Reader) (*Blocklist, error) {
+	list := &Blocklist{}
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		text, _, _ := strings.Cut(scanner.Text(), "#")
+		text, _, _ = strings.Cut(text, ";")
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+
+		if !strings.Contains(text, "/") {
+			addr, err := netip.ParseAddr(text)
+			if err != nil {
+				return nil, fmt.Errorf("ip blocklist line %d: %w", line, err)
+			}
+			addr = addr.Unmap()
+			list.prefixes = append(list.prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(text)
+		if err != nil {
+			return nil, fmt.Errorf("ip blocklist line %d: %w", line, err)
+		}
+		list.prefixes = append(list.prefixes, prefix.Masked())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// Len returns the number of ranges in the blocklist.
+func (l *Blocklist) Len() int {
+	if l == nil {
+		return 0
+	}
+	return len(l.prefixes)
+}
+
+// Contains reports whether ip falls in any range of the blocklist.
+func (l *Blocklist) Contains(ip string) bool {
+	if l == nil {
+		return false
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, prefix := range l.prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
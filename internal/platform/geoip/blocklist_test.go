@@ -0,0 +1,45 @@
+package geoip_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/platform/geoip"
+)
+
+func TestBlocklist_Contains(t *testing.T) {
+	list, err := geoip.LoadBlocklist(strings.NewReader(`# FireHOL style
+198.51.100.0/24
+203.0.113.7
+1.10.16.0/20 ; SBL256894
+2001:db8:bad::/48
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, list.Len())
+
+	tests := map[string]struct {
+		ip       string
+		expected bool
+	}{
+		"when the address is in a range":         {ip: "198.51.100.42", expected: true},
+		"when the address is listed alone":       {ip: "203.0.113.7", expected: true},
+		"when the range has a trailing comment":  {ip: "1.10.31.255", expected: true},
+		"when the address is IPv6":               {ip: "2001:db8:bad::1", expected: true},
+		"when the address is IPv4-mapped":        {ip: "::ffff:198.51.100.1", expected: true},
+		"when the address is next to an address": {ip: "203.0.113.8"},
+		"when the address is invalid":            {ip: ""},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, list.Contains(tc.ip))
+		})
+	}
+}
+
+func TestLoadBlocklist_InvalidLine(t *testing.T) {
+	_, err := geoip.LoadBlocklist(strings.NewReader("10.0.0.0/8\nnot a range\n"))
+
+	assert.ErrorContains(t, err, "ip blocklist line 2")
+}
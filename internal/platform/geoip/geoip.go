@@ -0,0 +1,187 @@
+// Package geoip resolves IP addresses to an approximate location from an
+// offline database, and checks them against a list of known-bad ranges.
+package geoip
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const earthRadiusKm = 6371.0
+
+// Location is where an IP address is roughly located. Coordinates are
+// only meaningful when HasCoordinates is set.
+type Location struct {
+	Country        string
+	Region         string
+	City           string
+	Latitude       float64
+	Longitude      float64
+	HasCoordinates bool
+}
+
+// String returns the location as "City, Region, Country", skipping empty
+// parts.
+func (l Location) String() string {
+	var parts []string
+	for _, part := range []string{l.City, l.Region, l.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// DistanceKm returns the great-circle distance between two locations.
+func DistanceKm(a, b Location) float64 {
+	lat1 := a.Latitude * math.Pi / 180
+	lat2 := b.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+type ipRange struct {
+	start    netip.Addr
+	end      netip.Addr
+	location Location
+}
+
+// Database maps IP ranges to locations. A nil database locates nothing.
+type Database struct {
+	ranges []ipRange
+}
+
+var database *Database
+
+// SetDatabase sets the database Lookup uses.
+func SetDatabase(db *Database) {
+	database = db
+}
+
+// Lookup locates ip in the database set with SetDatabase.
+func Lookup(ip string) (Location, bool) {
+	return database.Lookup(ip)
+}
+
+// LoadDatabaseFile reads a database from path. See LoadDatabase for the
+// format.
+func LoadDatabaseFile(path string) (*Database, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return LoadDatabase(file)
+}
+
+// LoadDatabase reads the CSV layout of the DB-IP "IP to City Lite"
+// download: ip_start, ip_end, continent, country, region, city, latitude,
+// longitude, without a header. Ranges must not overlap.
+func LoadDatabase(r io.Reader) (*Database, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	db := &Database{}
+	line := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line++
+
+		if len(record) < 6 {
+			return nil, fmt.Errorf("geoip database line %d: expected at least 6 fields", line)
+		}
+
+		start, err := netip.ParseAddr(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("geoip database line %d: %w", line, err)
+		}
+		end, err := netip.ParseAddr(strings.TrimSpace(record[1]))
+		if err != nil {
+			return nil, fmt.Errorf("geoip database line %d: %w", line, err)
+		}
+		if start.Is4() != end.Is4() || end.Less(start) {
+			return nil, fmt.Errorf("geoip database line %d: invalid range", line)
+		}
+
+		location := Location{
+			Country: strings.TrimSpace(record[3]),
+			Region:  strings.TrimSpace(record[4]),
+			City:    strings.TrimSpace(record[5]),
+		}
+		if len(record) >= 8 {
+			latitude, latErr := strconv.ParseFloat(strings.TrimSpace(record[6]), 64)
+			longitude, lonErr := strconv.ParseFloat(strings.TrimSpace(record[7]), 64)
+			if latErr == nil && lonErr == nil {
+				location.Latitude = latitude
+				location.Longitude = longitude
+				location.HasCoordinates = true
+			}
+		}
+
+		db.ranges = append(db.ranges, ipRange{start: start.Unmap(), end: end.Unmap(), location: location})
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return db.ranges[i].start.Less(db.ranges[j].start)
+	})
+
+	return db, nil
+}
+
+// Len returns the number of ranges in the database.
+func (db *Database) Len() int {
+	if db == nil {
+		return 0
+	}
+	return len(db.ranges)
+}
+
+// Lookup returns the location of the range containing ip.
+func (db *Database) Lookup(ip string) (Location, bool) {
+	if db == nil {
+		return Location{}, false
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return Location{}, false
+	}
+	addr = addr.Unmap()
+
+	// The first range starting after addr; the candidate is the one
+	// before it.
+	i := sort.Search(len(db.ranges), func(i int) bool {
+		return addr.Less(db.ranges[i].start)
+	})
+	if i == 0 {
+		return Location{}, false
+	}
+
+	candidate := db.ranges[i-1]
+	if candidate.end.Less(addr) || candidate.start.Is4() != addr.Is4() {
+		return Location{}, false
+	}
+
+	return candidate.location, true
+}
@@ -0,0 +1,58 @@
+package geoip_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/platform/geoip"
+)
+
+const testDatabase = `1.0.0.0,1.0.0.255,OC,AU,Queensland,South Brisbane,-27.4748,153.017
+81.2.69.0,81.2.69.255,EU,GB,England,London,51.5142,-0.0931
+190.0.0.0,190.0.255.255,SA,AR,Buenos Aires,Buenos Aires,-34.6037,-58.3816
+2001:db8::,2001:db8::ffff,EU,DE,Berlin,Berlin,52.52,13.405
+`
+
+func TestDatabase_Lookup(t *testing.T) {
+	db, err := geoip.LoadDatabase(strings.NewReader(testDatabase))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, db.Len())
+
+	tests := map[string]struct {
+		ip       string
+		expected string
+		found    bool
+	}{
+		"when the address starts a range":      {ip: "81.2.69.0", expected: "London, England, GB", found: true},
+		"when the address ends a range":        {ip: "190.0.255.255", expected: "Buenos Aires, Buenos Aires, AR", found: true},
+		"when the address is IPv6":             {ip: "2001:db8::1", expected: "Berlin, Berlin, DE", found: true},
+		"when the address is IPv4-mapped":      {ip: "::ffff:1.0.0.1", expected: "South Brisbane, Queensland, AU", found: true},
+		"when the address is between ranges":   {ip: "81.2.70.1"},
+		"when the address is before any range": {ip: "0.0.0.1"},
+		"when the address is invalid":          {ip: "not-an-ip"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			location, found := db.Lookup(tc.ip)
+
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.expected, location.String())
+		})
+	}
+}
+
+func TestLoadDatabase_InvalidRange(t *testing.T) {
+	_, err := geoip.LoadDatabase(strings.NewReader("10.0.0.9,10.0.0.1,EU,ES,Madrid,Madrid,40.4,-3.7\n"))
+
+	assert.EqualError(t, err, "geoip database line 1: invalid range")
+}
+
+func TestDistanceKm(t *testing.T) {
+	london := geoip.Location{Latitude: 51.5142, Longitude: -0.0931}
+	buenosAires := geoip.Location{Latitude: -34.6037, Longitude: -58.3816}
+
+	assert.InDelta(t, 11130, geoip.DistanceKm(london, buenosAires), 50)
+	assert.Zero(t, geoip.DistanceKm(london, london))
+}
@@ -0,0 +1,142 @@
+// Package imaging decodes untrusted uploads and produces re-encoded
+// variants. Re-encoding drops every metadata segment, EXIF included, after
+// the EXIF orientation has been applied to the pixels.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+
+	maxDimension = 8000
+	maxPixels    = 40_000_000
+	jpegQuality  = 85
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("image must be a JPEG or PNG")
+	ErrTooLarge          = fmt.Errorf("image dimensions must not exceed %dx%d", maxDimension, maxDimension)
+)
+
+var contentTypes = map[string]string{
+	FormatJPEG: "image/jpeg",
+	FormatPNG:  "image/png",
+}
+
+// Decode sniffs the content rather than trusting the declared type, and
+// checks the dimensions before allocating the pixels.
+func Decode(data []byte) (image.Image, string, error) {
+	format := ""
+	switch http.DetectContentType(data) {
+	case "image/jpeg":
+		format = FormatJPEG
+	case "image/png":
+		format = FormatPNG
+	default:
+		return nil, "", ErrUnsupportedFormat
+	}
+
+	cfg, configFormat, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || configFormat != format {
+		return nil, "", ErrUnsupportedFormat
+	}
+
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width > maxDimension || cfg.Height > maxDimension ||
+		cfg.Width*cfg.Height > maxPixels {
+		return nil, "", ErrTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrUnsupportedFormat
+	}
+
+	if format == FormatJPEG {
+		img = orient(img, jpegOrientation(data))
+	}
+
+	return img, format, nil
+}
+
+// Square crops the centre of img and scales it down to size x size with an
+// area average. Images smaller than size are not enlarged.
+func Square(img image.Image, size int) image.Image {
+	bounds := img.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	size = min(size, side)
+
+	offset := image.Pt(bounds.Min.X+(bounds.Dx()-side)/2, bounds.Min.Y+(bounds.Dy()-side)/2)
+	src := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(src, src.Bounds(), img, offset, draw.Src)
+
+	if size == side {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		y0, y1 := y*side/size, (y+1)*side/size
+		for x := 0; x < size; x++ {
+			x0, x1 := x*side/size, (x+1)*side/size
+
+			var r, g, b, a, n uint32
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride+x0*4 : sy*src.Stride+x1*4]
+				for i := 0; i < len(row); i += 4 {
+					r += uint32(row[i])
+					g += uint32(row[i+1])
+					b += uint32(row[i+2])
+					a += uint32(row[i+3])
+					n++
+				}
+			}
+
+			i := y*dst.Stride + x*4
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+
+	return dst
+}
+
+// Encode writes img in the given format and returns it with its MIME type.
+func Encode(img image.Image, format string) ([]byte, string, error) {
+	var buf bytes.Buffer
+
+	switch format {
+	case FormatJPEG:
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, "", err
+		}
+	case FormatPNG:
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, "", err
+		}
+	default:
+		return nil, "", ErrUnsupportedFormat
+	}
+
+	return buf.Bytes(), contentTypes[format], nil
+}
+
+// Extension returns the file extension used for format.
+func Extension(format string) string {
+	if format == FormatJPEG {
+		return "jpg"
+	}
+
+	return format
+}
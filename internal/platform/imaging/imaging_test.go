@@ -0,0 +1,126 @@
+package imaging_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/platform/imaging"
+)
+
+func encodeJPEG(t *testing.T, w, h int) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h)), nil))
+	return buf.Bytes()
+}
+
+// withOrientation inserts a minimal big-endian EXIF segment right after the
+// SOI marker.
+func withOrientation(data []byte, orientation byte) []byte {
+	exif := []byte{
+		'E', 'x', 'i', 'f', 0, 0,
+		'M', 'M', 0, 42, 0, 0, 0, 8,
+		0, 1,
+		0x01, 0x12, 0, 3, 0, 0, 0, 1, 0, orientation, 0, 0,
+		0, 0, 0, 0,
+	}
+	segment := append([]byte{0xFF, 0xE1, 0, byte(len(exif) + 2)}, exif...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, segment...)
+	return append(out, data[2:]...)
+}
+
+func TestDecode(t *testing.T) {
+	var pngBuf bytes.Buffer
+	assert.NoError(t, png.Encode(&pngBuf, image.NewNRGBA(image.Rect(0, 0, 3, 2))))
+
+	var hugeBuf bytes.Buffer
+	assert.NoError(t, png.Encode(&hugeBuf, image.NewGray(image.Rect(0, 0, 8001, 1))))
+
+	tests := map[string]struct {
+		data           []byte
+		expectedFormat string
+		expectedSize   image.Point
+		expectedErr    error
+	}{
+		"when the image is a JPEG": {
+			data:           encodeJPEG(t, 40, 20),
+			expectedFormat: imaging.FormatJPEG,
+			expectedSize:   image.Pt(40, 20),
+		},
+		"when the JPEG is rotated through EXIF": {
+			data:           withOrientation(encodeJPEG(t, 40, 20), 6),
+			expectedFormat: imaging.FormatJPEG,
+			expectedSize:   image.Pt(20, 40),
+		},
+		"when the image is a PNG": {
+			data:           pngBuf.Bytes(),
+			expectedFormat: imaging.FormatPNG,
+			expectedSize:   image.Pt(3, 2),
+		},
+		"when the content is not an image": {
+			data:        []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"),
+			expectedErr: imaging.ErrUnsupportedFormat,
+		},
+		"when the image is too large": {
+			data:        hugeBuf.Bytes(),
+			expectedErr: imaging.ErrTooLarge,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			img, format, err := imaging.Decode(tc.data)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, tc.expectedFormat, format)
+				assert.Equal(t, tc.expectedSize, img.Bounds().Size())
+			}
+		})
+	}
+}
+
+func TestDecode_AppliesOrientation(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 16, 8))
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			src.Set(x, y, color.White)
+		}
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, src, &jpeg.Options{Quality: 100}))
+
+	// Orientation 6 turns the image a quarter clockwise, moving the white
+	// left half to the top.
+	img, _, err := imaging.Decode(withOrientation(buf.Bytes(), 6))
+	assert.NoError(t, err)
+
+	r, _, _, _ := img.At(4, 2).RGBA()
+	assert.Greater(t, r, uint32(0xC000))
+	r, _, _, _ = img.At(4, 13).RGBA()
+	assert.Less(t, r, uint32(0x4000))
+}
+
+func TestSquare(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 300, 200))
+
+	assert.Equal(t, image.Pt(64, 64), imaging.Square(img, 64).Bounds().Size())
+	assert.Equal(t, image.Pt(200, 200), imaging.Square(img, 512).Bounds().Size())
+}
+
+func TestEncode_StripsMetadata(t *testing.T) {
+	img, format, err := imaging.Decode(withOrientation(encodeJPEG(t, 10, 10), 3))
+	assert.NoError(t, err)
+
+	data, contentType, err := imaging.Encode(img, format)
+	assert.NoError(t, err)
+	assert.Equal(t, "image/jpeg", contentType)
+	assert.NotContains(t, string(data), "Exif")
+}
@@ -0,0 +1,25 @@
+package requestinfo
+
+import "context"
+
+// ContextKey is a plain string so the value is also visible through
+// gin.Context, which only looks up string keys.
+const ContextKey = "requestInfo"
+
+// Info describes the HTTP request that triggered a usecase.
+type Info struct {
+	IP        string
+	UserAgent string
+	RequestID string
+}
+
+func WithInfo(ctx context.Context, info Info) context.Context {
+	return context.WithValue(ctx, ContextKey, info)
+}
+
+// FromContext returns the request info stored in ctx, or the zero value for
+// work that did not start from an HTTP request.
+func FromContext(ctx context.Context) Info {
+	info, _ := ctx.Value(ContextKey).(Info)
+	return info
+}
@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 200
+)
+
+type (
+	ListUsecase interface {
+		Execute(context.Context, ListFilterOptions) (*ListOutput, error)
+	}
+
+	listUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	ListFilterOptions struct {
+		ActorID  string
+		TargetID string
+		Action   string
+		From     time.Time
+		To       time.Time
+		Limit    int
+		Offset   int
+	}
+
+	ListOutput struct {
+		Data   []AuditEventOutputData `json:"data"`
+		Total  int                    `json:"total"`
+		Limit  int                    `json:"limit"`
+		Offset int                    `json:"offset"`
+	}
+)
+
+func NewListUsecase(contextFactory appcontext.Factory) ListUsecase {
+	return &listUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *listUsecase) Execute(ctx context.Context, filters ListFilterOptions) (*ListOutput, error) {
+	app := u.contextFactory()
+
+	return listEvents(ctx, app, audit_repo.ListFilterOptions{
+		ActorID:  filters.ActorID,
+		TargetID: filters.TargetID,
+		Action:   domain.AuditAction(filters.Action),
+		From:     filters.From,
+		To:       filters.To,
+		Limit:    filters.Limit,
+		Offset:   filters.Offset,
+	})
+}
+
+func listEvents(ctx context.Context, app *appcontext.Context, filters audit_repo.ListFilterOptions) (*ListOutput, error) {
+	if filters.Limit <= 0 {
+		filters.Limit = defaultLimit
+	}
+	if filters.Limit > maxLimit {
+		filters.Limit = maxLimit
+	}
+	if filters.Offset < 0 {
+		filters.Offset = 0
+	}
+
+	total, err := app.Repositories.Audit.Count(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := app.Repositories.Audit.List(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListOutput{
+		Data:   toAuditEventsOutputData(events),
+		Total:  total,
+		Limit:  filters.Limit,
+		Offset: filters.Offset,
+	}, nil
+}
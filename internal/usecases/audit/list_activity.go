@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"context"
+	"errors"
+
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	// ListActivityUsecase lists the events where the user is the actor or
+	// the target. ActorID and TargetID filters are ignored.
+	ListActivityUsecase interface {
+		Execute(context.Context, string, ListFilterOptions) (*ListOutput, error)
+	}
+
+	listActivityUsecase struct {
+		contextFactory appcontext.Factory
+	}
+)
+
+func NewListActivityUsecase(contextFactory appcontext.Factory) ListActivityUsecase {
+	return &listActivityUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *listActivityUsecase) Execute(ctx context.Context, username string, filters ListFilterOptions) (*ListOutput, error) {
+	app := u.contextFactory()
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Username: username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	return listEvents(ctx, app, audit_repo.ListFilterOptions{
+		Subject: user.ID,
+		Action:  domain.AuditAction(filters.Action),
+		From:    filters.From,
+		To:      filters.To,
+		Limit:   filters.Limit,
+		Offset:  filters.Offset,
+	})
+}
@@ -0,0 +1,108 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/audit"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListActivityUsecase_Execute(t *testing.T) {
+	type fields struct {
+		user  *mock_user.MockRepository
+		audit *mock_audit.MockRepository
+	}
+
+	tests := map[string]struct {
+		filters     usecase.ListFilterOptions
+		prepare     func(f *fields)
+		expected    *usecase.ListOutput
+		expectedErr error
+	}{
+		"when the user has activity": {
+			filters: usecase.ListFilterOptions{ActorID: "someone-else", Limit: 500},
+			prepare: func(f *fields) {
+				f.user.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).
+					Return(&domain.User{ID: "user-1"}, nil)
+
+				filters := audit_repo.ListFilterOptions{Subject: "user-1", Limit: 200}
+				f.audit.EXPECT().Count(gomock.Any(), filters).Return(1, nil)
+				f.audit.EXPECT().List(gomock.Any(), filters).Return([]domain.AuditEvent{
+					{
+						ID:       7,
+						Action:   domain.AuditActionLogin,
+						Actor:    domain.UserActor("user-1"),
+						Target:   domain.UserTarget("user-1"),
+						Metadata: map[string]any{},
+					},
+				}, nil)
+			},
+			expected: &usecase.ListOutput{
+				Data: []usecase.AuditEventOutputData{
+					{
+						ID:         7,
+						Action:     "auth.login",
+						ActorType:  "user",
+						ActorID:    "user-1",
+						TargetType: "user",
+						TargetID:   "user-1",
+						Metadata:   map[string]any{},
+					},
+				},
+				Total: 1,
+				Limit: 200,
+			},
+		},
+		"when the user does not exist": {
+			prepare: func(f *fields) {
+				f.user.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(nil, nil)
+			},
+			expectedErr: errors.New("user not found"),
+		},
+		"when counting fails": {
+			prepare: func(f *fields) {
+				f.user.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&domain.User{ID: "user-1"}, nil)
+				f.audit.EXPECT().Count(gomock.Any(), gomock.Any()).Return(0, errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				user:  mock_user.NewMockRepository(ctrl),
+				audit: mock_audit.NewMockRepository(ctrl),
+			}
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User:  f.user,
+						Audit: f.audit,
+					},
+				}
+			}
+
+			uc := usecase.NewListActivityUsecase(contextFactory)
+			output, err := uc.Execute(context.Background(), "jdoe", tc.filters)
+
+			assert.Equal(t, tc.expected, output)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	AuditEventOutputData struct {
+		ID         int64          `json:"id"`
+		Action     string         `json:"action"`
+		ActorType  string         `json:"actor_type"`
+		ActorID    string         `json:"actor_id,omitempty"`
+		TargetType string         `json:"target_type,omitempty"`
+		TargetID   string         `json:"target_id,omitempty"`
+		IP         string         `json:"ip"`
+		UserAgent  string         `json:"user_agent"`
+		RequestID  string         `json:"request_id"`
+		Metadata   map[string]any `json:"metadata"`
+		Hash       string         `json:"hash"`
+		CreatedAt  time.Time      `json:"created_at"`
+	}
+)
+
+func toAuditEventOutputData(event *domain.AuditEvent) AuditEventOutputData {
+	return AuditEventOutputData{
+		ID:         event.ID,
+		Action:     string(event.Action),
+		ActorType:  string(event.Actor.Type),
+		ActorID:    event.Actor.ID,
+		TargetType: string(event.Target.Type),
+		TargetID:   event.Target.ID,
+		IP:         event.IP,
+		UserAgent:  event.UserAgent,
+		RequestID:  event.RequestID,
+		Metadata:   event.Metadata,
+		Hash:       event.Hash,
+		CreatedAt:  event.CreatedAt,
+	}
+}
+
+func toAuditEventsOutputData(events []domain.AuditEvent) []AuditEventOutputData {
+	data := make([]AuditEventOutputData, 0, len(events))
+	for i := range events {
+		data = append(data, toAuditEventOutputData(&events[i]))
+	}
+
+	return data
+}
@@ -0,0 +1,36 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	// RecordUsecase appends a standalone audit event, for callers outside
+	// the usecases that write their own events transactionally.
+	RecordUsecase interface {
+		Execute(context.Context, domain.AuditEvent) error
+	}
+
+	recordUsecase struct {
+		contextFactory appcontext.Factory
+	}
+)
+
+func NewRecordUsecase(contextFactory appcontext.Factory) RecordUsecase {
+	return &recordUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *recordUsecase) Execute(ctx context.Context, event domain.AuditEvent) error {
+	app := u.contextFactory()
+
+	return app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		return audit_repo.Record(ctx, repos.Audit, event)
+	})
+}
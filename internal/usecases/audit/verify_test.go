@@ -0,0 +1,141 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/audit"
+	"go.uber.org/mock/gomock"
+)
+
+func buildChain(t *testing.T, n int) []domain.AuditEvent {
+	t.Helper()
+
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := make([]domain.AuditEvent, 0, n)
+
+	var prevHash string
+	for i := 0; i < n; i++ {
+		event := domain.NewAuditEvent(
+			domain.AuditActionLogin,
+			domain.UserActor("user-1"),
+			domain.UserTarget("user-1"),
+			map[string]any{"attempt": float64(i)},
+		)
+		event.ID = int64(i + 1)
+		event.PrevHash = prevHash
+		event.CreatedAt = createdAt.Add(time.Duration(i) * time.Second)
+
+		hash, err := event.ComputeHash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		event.Hash = hash
+		prevHash = hash
+
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func TestVerifyUsecase_Execute(t *testing.T) {
+	type fields struct {
+		audit *mock_audit.MockRepository
+	}
+
+	brokenAt := func(id int64) *int64 { return &id }
+
+	tests := map[string]struct {
+		prepare     func(f *fields)
+		expected    *usecase.VerifyOutput
+		expectedErr error
+	}{
+		"when the chain is intact": {
+			prepare: func(f *fields) {
+				f.audit.EXPECT().ListAfter(gomock.Any(), int64(0), 500).Return(buildChain(t, 3), nil)
+			},
+			expected: &usecase.VerifyOutput{Valid: true, Checked: 3},
+		},
+		"when the log is empty": {
+			prepare: func(f *fields) {
+				f.audit.EXPECT().ListAfter(gomock.Any(), int64(0), 500).Return(nil, nil)
+			},
+			expected: &usecase.VerifyOutput{Valid: true},
+		},
+		"when a row was modified": {
+			prepare: func(f *fields) {
+				events := buildChain(t, 3)
+				events[1].Metadata["attempt"] = float64(42)
+				f.audit.EXPECT().ListAfter(gomock.Any(), int64(0), 500).Return(events, nil)
+			},
+			expected: &usecase.VerifyOutput{
+				Checked:  2,
+				BrokenAt: brokenAt(2),
+				Reason:   "hash does not match contents",
+			},
+		},
+		"when a row was removed": {
+			prepare: func(f *fields) {
+				events := buildChain(t, 3)
+				f.audit.EXPECT().ListAfter(gomock.Any(), int64(0), 500).
+					Return([]domain.AuditEvent{events[0], events[2]}, nil)
+			},
+			expected: &usecase.VerifyOutput{
+				Checked:  2,
+				BrokenAt: brokenAt(3),
+				Reason:   "previous hash does not match",
+			},
+		},
+		"when a row was redacted by an account erasure": {
+			prepare: func(f *fields) {
+				events := buildChain(t, 3)
+				redactedAt := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+				events[1].Actor.ID = "erased:1"
+				events[1].Metadata = nil
+				events[1].RedactedAt = &redactedAt
+				f.audit.EXPECT().ListAfter(gomock.Any(), int64(0), 500).Return(events, nil)
+			},
+			expected: &usecase.VerifyOutput{Valid: true, Checked: 3, Redacted: 1},
+		},
+		"when listing fails": {
+			prepare: func(f *fields) {
+				f.audit.EXPECT().ListAfter(gomock.Any(), int64(0), 500).Return(nil, errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				audit: mock_audit.NewMockRepository(ctrl),
+			}
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						Audit: f.audit,
+					},
+				}
+			}
+
+			uc := usecase.NewVerifyUsecase(contextFactory)
+			output, err := uc.Execute(context.Background())
+
+			assert.Equal(t, tc.expected, output)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
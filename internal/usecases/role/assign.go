@@ -0,0 +1,144 @@
+package role
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	auditRepo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outboxRepo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	roleRepo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role"
+	userRepo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	AssignUsecase interface {
+		Execute(context.Context, AssignInput) error
+	}
+
+	assignUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	// AssignInput identifies the user and role to link. Actor is the
+	// username of the caller and is recorded on the emitted event.
+	AssignInput struct {
+		UserID   string `json:"user_id"`
+		RoleName string `json:"role_name"`
+		Actor    string `json:"-"`
+	}
+)
+
+func NewAssignUsecase(contextFactory appcontext.Factory) AssignUsecase {
+	return &assignUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *assignUsecase) Execute(ctx context.Context, input AssignInput) error {
+	app := u.contextFactory()
+
+	user, role, err := findUserAndRole(ctx, app, input.UserID, input.RoleName)
+	if err != nil {
+		return err
+	}
+
+	for _, assigned := range user.Roles {
+		if assigned.ID == role.ID {
+			return errors.New("role already assigned")
+		}
+	}
+
+	actor, caller, err := auditRepo.Actor(ctx, app.Repositories.User, input.Actor)
+	if err != nil {
+		return err
+	}
+
+	if err := checkRank(caller, user, role); err != nil {
+		return err
+	}
+
+	return app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if _, err := repos.UserRole.Create(ctx, domain.UserRole{
+			UserID: user.ID,
+			RoleID: role.ID,
+		}); err != nil {
+			return err
+		}
+
+		if err := auditRepo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionRoleAssigned,
+			actor,
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"role_id":   role.ID,
+				"role_name": role.Name,
+			},
+		)); err != nil {
+			return err
+		}
+
+		return outboxRepo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserRoleAssigned,
+			eventActor(input.Actor),
+			domain.UserRoleEventData{
+				UserID:   user.ID,
+				RoleID:   role.ID,
+				RoleName: role.Name,
+			},
+		))
+	})
+}
+
+func findUserAndRole(ctx context.Context, app *appcontext.Context, userID, roleName string) (*domain.User, *domain.Role, error) {
+	if userID == "" {
+		return nil, nil, errors.New("user ID is required")
+	}
+	if roleName == "" {
+		return nil, nil, errors.New("role name is required")
+	}
+
+	user, err := app.Repositories.User.Get(ctx, userRepo.GetFilterOptions{ID: userID})
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, errors.New("user not found")
+	}
+
+	role, err := app.Repositories.Role.Get(ctx, roleRepo.GetFilterOptions{Name: roleName})
+	if err != nil {
+		return nil, nil, err
+	}
+	if role == nil {
+		return nil, nil, errors.New("role not found")
+	}
+
+	return user, role, nil
+}
+
+// checkRank lets a caller change only the roles of users it outranks, and
+// only for roles below its own, so admins can't grant themselves or others
+// superadmin. A nil caller is the system, which may change any role.
+func checkRank(caller, user *domain.User, role *domain.Role) error {
+	if caller == nil {
+		return nil
+	}
+
+	if !domain.Outranks(caller.Roles, role.Name.Rank()) ||
+		!domain.Outranks(caller.Roles, domain.HighestRank(user.Roles)) {
+		return domain.ErrInsufficientRole
+	}
+
+	return nil
+}
+
+func eventActor(username string) domain.EventActor {
+	if username == "" {
+		return domain.SystemActor()
+	}
+
+	return domain.UserActor(username)
+}
@@ -0,0 +1,93 @@
+package role
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	auditRepo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outboxRepo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	RevokeUsecase interface {
+		Execute(context.Context, RevokeInput) error
+	}
+
+	revokeUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	// RevokeInput identifies the user and role to unlink. Actor is the
+	// username of the caller and is recorded on the emitted event.
+	RevokeInput struct {
+		UserID   string `json:"user_id"`
+		RoleName string `json:"role_name"`
+		Actor    string `json:"-"`
+	}
+)
+
+func NewRevokeUsecase(contextFactory appcontext.Factory) RevokeUsecase {
+	return &revokeUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *revokeUsecase) Execute(ctx context.Context, input RevokeInput) error {
+	app := u.contextFactory()
+
+	user, role, err := findUserAndRole(ctx, app, input.UserID, input.RoleName)
+	if err != nil {
+		return err
+	}
+
+	assigned := false
+	for _, r := range user.Roles {
+		if r.ID == role.ID {
+			assigned = true
+			break
+		}
+	}
+	if !assigned {
+		return errors.New("role not assigned")
+	}
+
+	actor, caller, err := auditRepo.Actor(ctx, app.Repositories.User, input.Actor)
+	if err != nil {
+		return err
+	}
+
+	if err := checkRank(caller, user, role); err != nil {
+		return err
+	}
+
+	return app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if _, err := repos.UserRole.Delete(ctx, user.ID, role.ID); err != nil {
+			return err
+		}
+
+		if err := auditRepo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionRoleRevoked,
+			actor,
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"role_id":   role.ID,
+				"role_name": role.Name,
+			},
+		)); err != nil {
+			return err
+		}
+
+		return outboxRepo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserRoleRevoked,
+			eventActor(input.Actor),
+			domain.UserRoleEventData{
+				UserID:   user.ID,
+				RoleID:   role.ID,
+				RoleName: role.Name,
+			},
+		))
+	})
+}
@@ -0,0 +1,129 @@
+package role_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	roleRepo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role"
+	mock_role "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role/mocks"
+	userRepo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	mock_user_role "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/role/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/role"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRevokeUsecase_Execute(t *testing.T) {
+	type fields struct {
+		user       *mock_user.MockRepository
+		role       *mock_role.MockRepository
+		userRole   *mock_user_role.MockRepository
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+		transactor *mock_repositories.MockTransactor
+	}
+
+	adminRole := &domain.Role{ID: "role-1", Name: domain.RoleAdmin}
+
+	tests := map[string]struct {
+		input       usecase.RevokeInput
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when the role is revoked successfully": {
+			input: usecase.RevokeInput{UserID: "user-1", RoleName: "admin"},
+			prepare: func(f *fields) {
+				f.user.EXPECT().Get(gomock.Any(), userRepo.GetFilterOptions{ID: "user-1"}).
+					Return(&domain.User{ID: "user-1", Roles: []domain.Role{*adminRole}}, nil)
+				f.role.EXPECT().Get(gomock.Any(), roleRepo.GetFilterOptions{Name: "admin"}).
+					Return(adminRole, nil)
+				f.userRole.EXPECT().Delete(gomock.Any(), "user-1", "role-1").
+					Return(&domain.UserRole{UserID: "user-1", RoleID: "role-1"}, nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						assert.Equal(t, string(domain.EventUserRoleRevoked), message.Topic)
+						assert.Contains(t, string(message.Payload), `"actor":{"type":"system"}`)
+						return message.ID, nil
+					},
+				)
+			},
+		},
+		"when an admin revokes a role from a superadmin": {
+			input: usecase.RevokeInput{UserID: "user-1", RoleName: "admin", Actor: "other-admin"},
+			prepare: func(f *fields) {
+				f.user.EXPECT().Get(gomock.Any(), userRepo.GetFilterOptions{ID: "user-1"}).
+					Return(&domain.User{ID: "user-1", Roles: []domain.Role{
+						*adminRole,
+						{ID: "role-0", Name: domain.RoleSuperAdmin},
+					}}, nil)
+				f.role.EXPECT().Get(gomock.Any(), roleRepo.GetFilterOptions{Name: "admin"}).
+					Return(adminRole, nil)
+				f.user.EXPECT().Get(gomock.Any(), userRepo.GetFilterOptions{Username: "other-admin"}).
+					Return(&domain.User{ID: "user-2", Roles: []domain.Role{*adminRole}}, nil)
+			},
+			expectedErr: domain.ErrInsufficientRole,
+		},
+		"when the role is not assigned": {
+			input: usecase.RevokeInput{UserID: "user-1", RoleName: "admin"},
+			prepare: func(f *fields) {
+				f.user.EXPECT().Get(gomock.Any(), userRepo.GetFilterOptions{ID: "user-1"}).
+					Return(&domain.User{ID: "user-1"}, nil)
+				f.role.EXPECT().Get(gomock.Any(), roleRepo.GetFilterOptions{Name: "admin"}).
+					Return(adminRole, nil)
+			},
+			expectedErr: errors.New("role not assigned"),
+		},
+		"when the role name is missing": {
+			input:       usecase.RevokeInput{UserID: "user-1"},
+			prepare:     func(f *fields) {},
+			expectedErr: errors.New("role name is required"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				user:       mock_user.NewMockRepository(ctrl),
+				role:       mock_role.NewMockRepository(ctrl),
+				userRole:   mock_user_role.NewMockRepository(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{UserRole: f.userRole, Outbox: f.outbox, Audit: f.audit})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User: f.user,
+						Role: f.role,
+					},
+					Transactor: f.transactor,
+				}
+			}
+
+			uc := usecase.NewRevokeUsecase(contextFactory)
+			err := uc.Execute(context.Background(), tc.input)
+
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
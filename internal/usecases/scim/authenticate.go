@@ -0,0 +1,54 @@
+package scim
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+// ErrInvalidToken is returned for a missing, unknown or revoked token.
+var ErrInvalidToken = newError(http.StatusUnauthorized, "", "invalid or revoked SCIM token")
+
+type (
+	// AuthenticateUsecase resolves a SCIM bearer token to the stored token,
+	// whose ID and tenant scope the request.
+	AuthenticateUsecase interface {
+		Execute(context.Context, string) (*domain.SCIMToken, error)
+	}
+
+	authenticateUsecase struct {
+		contextFactory appcontext.Factory
+	}
+)
+
+func NewAuthenticateUsecase(contextFactory appcontext.Factory) AuthenticateUsecase {
+	return &authenticateUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *authenticateUsecase) Execute(ctx context.Context, bearer string) (*domain.SCIMToken, error) {
+	app := u.contextFactory()
+
+	if bearer == "" {
+		return nil, ErrInvalidToken
+	}
+
+	token, err := app.Repositories.SCIMToken.GetByTokenHash(ctx, hashToken(bearer))
+	if err != nil {
+		return nil, err
+	}
+
+	if token == nil || token.RevokedAt != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if err := app.Repositories.SCIMToken.Touch(ctx, token.ID); err != nil {
+		log.Printf("Failed to record SCIM token use: %v", err)
+	}
+
+	return token, nil
+}
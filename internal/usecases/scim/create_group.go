@@ -0,0 +1,102 @@
+package scim
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	// CreateGroupUsecase creates a role owned by the caller's tenant and
+	// assigns it to the listed members.
+	CreateGroupUsecase interface {
+		Execute(context.Context, CreateGroupInput) (*GroupResource, error)
+	}
+
+	createGroupUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	CreateGroupInput struct {
+		Request GroupRequest
+		TokenID string
+		Tenant  string
+	}
+)
+
+func NewCreateGroupUsecase(contextFactory appcontext.Factory) CreateGroupUsecase {
+	return &createGroupUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *createGroupUsecase) Execute(ctx context.Context, input CreateGroupInput) (*GroupResource, error) {
+	app := u.contextFactory()
+
+	attrs := input.Request.attributes()
+	if attrs.DisplayName == "" {
+		return nil, errInvalidValue("displayName is required")
+	}
+
+	taken, err := roleNameTaken(ctx, app, attrs.DisplayName)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, errUniqueness("displayName %s is already in use", attrs.DisplayName)
+	}
+
+	if err := checkMembers(ctx, app, input.Tenant, attrs.Members); err != nil {
+		return nil, err
+	}
+
+	role := domain.Role{
+		ID:   uuid.NewString(),
+		Name: domain.RoleName(attrs.DisplayName),
+	}
+
+	actor := domain.SCIMActor(input.TokenID)
+
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if _, err := repos.Role.Create(ctx, role); err != nil {
+			return err
+		}
+
+		if err := repos.Role.SetSCIMTenant(ctx, role.ID, input.Tenant); err != nil {
+			return err
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionRoleCreated,
+			actor,
+			domain.RoleTarget(role.ID),
+			map[string]any{
+				"name":   role.Name,
+				"tenant": input.Tenant,
+			},
+		)); err != nil {
+			return err
+		}
+
+		for _, id := range attrs.Members {
+			if _, err := repos.UserRole.Create(ctx, domain.UserRole{UserID: id, RoleID: role.ID}); err != nil {
+				return err
+			}
+
+			if err := recordMembership(ctx, repos, actor, &role, id, true); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return groupResource(ctx, app, role.ID, input.Tenant)
+}
@@ -0,0 +1,106 @@
+package scim
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/utils"
+)
+
+type (
+	// CreateTokenUsecase issues a bearer token for one identity provider
+	// connection.
+	CreateTokenUsecase interface {
+		Execute(context.Context, CreateTokenInput) (*CreateTokenOutput, error)
+	}
+
+	createTokenUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	// CreateTokenInput names the connection, for example after the
+	// identity provider, and the tenant whose users it provisions. Actor is
+	// the username of the admin.
+	CreateTokenInput struct {
+		Name   string `json:"name"`
+		Tenant string `json:"tenant"`
+		Actor  string `json:"-"`
+	}
+
+	// CreateTokenOutput is the only response that includes the token.
+	CreateTokenOutput struct {
+		Data  TokenOutputData `json:"data"`
+		Token string          `json:"token"`
+	}
+)
+
+func NewCreateTokenUsecase(contextFactory appcontext.Factory) CreateTokenUsecase {
+	return &createTokenUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *createTokenUsecase) Execute(ctx context.Context, input CreateTokenInput) (*CreateTokenOutput, error) {
+	app := u.contextFactory()
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	tenant := strings.TrimSpace(input.Tenant)
+	if tenant == "" {
+		return nil, errors.New("tenant is required")
+	}
+
+	actor, _, err := audit_repo.Actor(ctx, app.Repositories.User, input.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	plain, err := utils.GetEncodedString()
+	if err != nil {
+		return nil, err
+	}
+
+	token := domain.SCIMToken{
+		ID:        uuid.NewString(),
+		Name:      name,
+		Tenant:    tenant,
+		TokenHash: hashToken(plain),
+		CreatedBy: actor.ID,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if _, err := repos.SCIMToken.Create(ctx, token); err != nil {
+			return err
+		}
+
+		return audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionSCIMTokenCreated,
+			actor,
+			domain.AuditTarget{},
+			map[string]any{
+				"token_id": token.ID,
+				"name":     token.Name,
+				"tenant":   token.Tenant,
+			},
+		))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateTokenOutput{
+		Data:  toTokenOutputData(&token),
+		Token: plain,
+	}, nil
+}
@@ -0,0 +1,172 @@
+package scim
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	role_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+type (
+	// CreateUserUsecase provisions a user with the default role. The email
+	// is trusted as verified, since the identity provider owns it. Without
+	// a password the user signs in through single sign-on or sets one with
+	// a password reset.
+	CreateUserUsecase interface {
+		Execute(context.Context, CreateUserInput) (*UserResource, error)
+	}
+
+	createUserUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	CreateUserInput struct {
+		Request UserRequest
+		TokenID string
+		Tenant  string
+	}
+)
+
+func NewCreateUserUsecase(contextFactory appcontext.Factory) CreateUserUsecase {
+	return &createUserUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *createUserUsecase) Execute(ctx context.Context, input CreateUserInput) (*UserResource, error) {
+	app := u.contextFactory()
+
+	attrs := input.Request.attributes(userAttributes{Active: true})
+	if err := attrs.validate(); err != nil {
+		return nil, err
+	}
+
+	existing, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Email:          attrs.Email,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errUniqueness("userName %s is already in use", attrs.Email)
+	}
+
+	var password string
+	if input.Request.Password != "" {
+		if err := auth.ValidatePassword(input.Request.Password, attrs.GivenName, attrs.FamilyName, attrs.Email); err != nil {
+			return nil, errInvalidValue("%s", err.Error())
+		}
+
+		hashed, err := auth.HashedPassword(input.Request.Password)
+		if err != nil {
+			return nil, err
+		}
+		password = string(hashed)
+	}
+
+	// The column is unique, so every user needs a token even though this
+	// one is never sent.
+	verificationToken, err := auth.NewOneTimeToken()
+	if err != nil {
+		return nil, err
+	}
+
+	user := domain.User{
+		ID:                       uuid.NewString(),
+		FirstName:                attrs.GivenName,
+		LastName:                 attrs.FamilyName,
+		Username:                 auth.GenerateUsername(attrs.GivenName, attrs.FamilyName),
+		Email:                    attrs.Email,
+		Password:                 password,
+		IsActive:                 true,
+		VerifiedEmail:            true,
+		VerifiedEmailToken:       verificationToken.Hash,
+		VerifiedEmailTokenExpiry: time.Now().UTC(),
+		AuthMethod:               string(domain.AuthMethodPassword),
+	}
+
+	defaultRole, err := app.Repositories.Role.Get(ctx, role_repo.GetFilterOptions{
+		Name: string(domain.RoleUser),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	actor := domain.SCIMActor(input.TokenID)
+
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if _, err := repos.User.Create(ctx, user); err != nil {
+			return err
+		}
+
+		if err := repos.User.SetSCIMTenant(ctx, user.ID, input.Tenant); err != nil {
+			return err
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionUserRegistered,
+			actor,
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"source": "scim",
+			},
+		)); err != nil {
+			return err
+		}
+
+		if err := outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserRegistered,
+			actor,
+			domain.NewUserEventData(&user),
+		)); err != nil {
+			return err
+		}
+
+		if defaultRole != nil {
+			if _, err := repos.UserRole.Create(ctx, domain.UserRole{
+				UserID: user.ID,
+				RoleID: defaultRole.ID,
+			}); err != nil {
+				return err
+			}
+
+			if err := outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+				domain.EventUserRoleAssigned,
+				actor,
+				domain.UserRoleEventData{
+					UserID:   user.ID,
+					RoleID:   defaultRole.ID,
+					RoleName: defaultRole.Name,
+				},
+			)); err != nil {
+				return err
+			}
+		}
+
+		if attrs.Active {
+			return nil
+		}
+
+		return setActive(ctx, repos, actor, &user, false)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := getUser(ctx, app, user.ID, input.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := toUserResource(baseURL(app), created)
+	return &resource, nil
+}
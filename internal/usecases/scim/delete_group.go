@@ -0,0 +1,74 @@
+package scim
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	// DeleteGroupUsecase deletes a role the caller's tenant created, which
+	// revokes it from every holder. Holders outside the tenant, who got the
+	// role from an administrator, are recorded as revoked too.
+	DeleteGroupUsecase interface {
+		Execute(context.Context, DeleteInput) error
+	}
+
+	deleteGroupUsecase struct {
+		contextFactory appcontext.Factory
+	}
+)
+
+func NewDeleteGroupUsecase(contextFactory appcontext.Factory) DeleteGroupUsecase {
+	return &deleteGroupUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *deleteGroupUsecase) Execute(ctx context.Context, input DeleteInput) error {
+	app := u.contextFactory()
+
+	role, members, err := getGroup(ctx, app, input.ID, input.Tenant)
+	if err != nil {
+		return err
+	}
+
+	if err := checkVersion(input.IfMatch, toGroupResource(baseURL(app), role, members).Meta.Version); err != nil {
+		return err
+	}
+
+	actor := domain.SCIMActor(input.TokenID)
+
+	return app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		holders, err := repos.UserRole.ListHolders(ctx, role.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := repos.Role.Delete(ctx, role.ID); err != nil {
+			return err
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionRoleDeleted,
+			actor,
+			domain.RoleTarget(role.ID),
+			map[string]any{
+				"name": role.Name,
+			},
+		)); err != nil {
+			return err
+		}
+
+		for _, holder := range holders {
+			if err := recordMembership(ctx, repos, actor, role, holder.UserID, false); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
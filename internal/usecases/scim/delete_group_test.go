@@ -0,0 +1,125 @@
+package scim_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	role_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role"
+	mock_role "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role/mocks"
+	user_role "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/role"
+	mock_user_role "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/role/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteGroupUsecase(t *testing.T) {
+	type fields struct {
+		role       *mock_role.MockRepository
+		userRole   *mock_user_role.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+	}
+
+	editors := &domain.Role{ID: "role-1", Name: "editors"}
+
+	tests := map[string]struct {
+		input          usecase.DeleteInput
+		prepare        func(f *fields)
+		expectedStatus int
+	}{
+		"when the group is deleted every holder is revoked": {
+			input: usecase.DeleteInput{ID: "role-1", TokenID: "token-1", Tenant: "acme"},
+			prepare: func(f *fields) {
+				f.role.EXPECT().Get(gomock.Any(), role_repo.GetFilterOptions{ID: "role-1", SCIMTenant: "acme"}).Return(editors, nil)
+				f.userRole.EXPECT().ListMembers(gomock.Any(), "role-1", "acme").Return([]user_role.Member{{UserID: "user-1"}}, nil)
+				f.userRole.EXPECT().ListHolders(gomock.Any(), "role-1").Return([]user_role.Member{{UserID: "user-1"}, {UserID: "user-9"}}, nil)
+				f.role.EXPECT().Delete(gomock.Any(), "role-1").Return(nil)
+
+				targets := []string{"role-1", "user-1", "user-9"}
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, targets[0], event.Target.ID)
+						targets = targets[1:]
+						return 1, nil
+					},
+				).Times(3)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						return message.ID, nil
+					},
+				).Times(2)
+			},
+		},
+		"when the group belongs to another tenant": {
+			input: usecase.DeleteInput{ID: "role-1", Tenant: "globex"},
+			prepare: func(f *fields) {
+				f.role.EXPECT().Get(gomock.Any(), role_repo.GetFilterOptions{ID: "role-1", SCIMTenant: "globex"}).Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				role:       mock_role.NewMockRepository(ctrl),
+				userRole:   mock_user_role.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{
+						Role:     f.role,
+						UserRole: f.userRole,
+						Outbox:   f.outbox,
+						Audit:    f.audit,
+					})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						Role:     f.role,
+						UserRole: f.userRole,
+					},
+					Transactor: f.transactor,
+					ConfigService: &config.ConfigurationService{
+						ServerConfig: config.ServerConfig{Host: "https://auth.example.com"},
+					},
+				}
+			}
+
+			uc := usecase.NewDeleteGroupUsecase(contextFactory)
+			err := uc.Execute(context.Background(), tc.input)
+
+			if tc.expectedStatus != 0 {
+				var scimErr *usecase.Error
+				if assert.ErrorAs(t, err, &scimErr) {
+					assert.Equal(t, tc.expectedStatus, scimErr.Status)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	// DeleteUserUsecase soft-deletes a user, like the admin delete.
+	DeleteUserUsecase interface {
+		Execute(context.Context, DeleteInput) error
+	}
+
+	deleteUserUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	DeleteInput struct {
+		ID      string
+		IfMatch string
+		TokenID string
+		Tenant  string
+	}
+)
+
+func NewDeleteUserUsecase(contextFactory appcontext.Factory) DeleteUserUsecase {
+	return &deleteUserUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *deleteUserUsecase) Execute(ctx context.Context, input DeleteInput) error {
+	app := u.contextFactory()
+
+	user, err := getUser(ctx, app, input.ID, input.Tenant)
+	if err != nil {
+		return err
+	}
+
+	if err := checkVersion(input.IfMatch, toUserResource(baseURL(app), user).Meta.Version); err != nil {
+		return err
+	}
+
+	if isPrivileged(user) {
+		return errForbidden("administrators cannot be deleted through SCIM")
+	}
+
+	actor := domain.SCIMActor(input.TokenID)
+
+	return app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if err := repos.User.SoftDelete(ctx, user.ID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errNotFound("User", user.ID)
+			}
+			return err
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionUserDeleted,
+			actor,
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"username": user.Username,
+				"email":    user.Email,
+			},
+		)); err != nil {
+			return err
+		}
+
+		return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserDeleted,
+			actor,
+			domain.NewUserEventData(user),
+		))
+	})
+}
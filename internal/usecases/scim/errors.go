@@ -0,0 +1,86 @@
+package scim
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Error is a failure reported to the identity provider in the SCIM error
+// format. Any other error returned by a usecase is an internal error.
+type Error struct {
+	Status   int
+	ScimType string
+	Detail   string
+}
+
+func (e *Error) Error() string {
+	return e.Detail
+}
+
+func newError(status int, scimType, format string, args ...any) *Error {
+	return &Error{
+		Status:   status,
+		ScimType: scimType,
+		Detail:   fmt.Sprintf(format, args...),
+	}
+}
+
+func errInvalidValue(format string, args ...any) *Error {
+	return newError(http.StatusBadRequest, "invalidValue", format, args...)
+}
+
+func errNotFound(resource, id string) *Error {
+	return newError(http.StatusNotFound, "", "%s %s not found", resource, id)
+}
+
+func errForbidden(format string, args ...any) *Error {
+	return newError(http.StatusForbidden, "", format, args...)
+}
+
+func errUniqueness(format string, args ...any) *Error {
+	return newError(http.StatusConflict, "uniqueness", format, args...)
+}
+
+func errMutability(format string, args ...any) *Error {
+	return newError(http.StatusBadRequest, "mutability", format, args...)
+}
+
+// checkVersion enforces an If-Match header. An empty header or "*" always
+// matches.
+func checkVersion(ifMatch, version string) error {
+	if ifMatch == "" || ifMatch == "*" || ifMatch == version {
+		return nil
+	}
+
+	return newError(http.StatusPreconditionFailed, "", "resource has been modified")
+}
+
+// ErrorResponse is the body of a SCIM error response.
+type ErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+// NewErrorResponse builds the body for err. Errors other than *Error are
+// reported as internal errors without their detail.
+func NewErrorResponse(err error) (int, ErrorResponse) {
+	scimErr, ok := err.(*Error)
+	if !ok {
+		scimErr = newError(http.StatusInternalServerError, "", "internal server error")
+	}
+
+	return scimErr.Status, ErrorResponse{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(scimErr.Status),
+		ScimType: scimErr.ScimType,
+		Detail:   scimErr.Detail,
+	}
+}
+
+// NewInvalidSyntaxError reports a request that could not be parsed.
+func NewInvalidSyntaxError(err error) error {
+	return newError(http.StatusBadRequest, "invalidSyntax", "%s", err.Error())
+}
@@ -0,0 +1,47 @@
+package scim
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// equalityFilter is the only filter form supported: attribute eq "value".
+type equalityFilter struct {
+	Attribute string
+	Value     string
+}
+
+// parseFilter reads an optional filter and returns nil when there is none.
+// allowed lists the attributes, in lower case, that may be filtered on.
+func parseFilter(filter string, allowed ...string) (*equalityFilter, error) {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return nil, nil
+	}
+
+	invalid := newError(http.StatusBadRequest, "invalidFilter", "only %s eq \"value\" filters are supported", strings.Join(allowed, ", "))
+
+	parts := strings.SplitN(filter, " ", 3)
+	if len(parts) != 3 || !strings.EqualFold(parts[1], "eq") {
+		return nil, invalid
+	}
+
+	attribute := strings.ToLower(parts[0])
+	found := false
+	for _, name := range allowed {
+		if strings.ToLower(name) == attribute {
+			found = true
+		}
+	}
+	if !found {
+		return nil, invalid
+	}
+
+	value, err := strconv.Unquote(strings.TrimSpace(parts[2]))
+	if err != nil {
+		return nil, invalid
+	}
+
+	return &equalityFilter{Attribute: attribute, Value: value}, nil
+}
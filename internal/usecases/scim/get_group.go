@@ -0,0 +1,27 @@
+package scim
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	GetGroupUsecase interface {
+		Execute(context.Context, GetInput) (*GroupResource, error)
+	}
+
+	getGroupUsecase struct {
+		contextFactory appcontext.Factory
+	}
+)
+
+func NewGetGroupUsecase(contextFactory appcontext.Factory) GetGroupUsecase {
+	return &getGroupUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *getGroupUsecase) Execute(ctx context.Context, input GetInput) (*GroupResource, error) {
+	return groupResource(ctx, u.contextFactory(), input.ID, input.Tenant)
+}
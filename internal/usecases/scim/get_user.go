@@ -0,0 +1,35 @@
+package scim
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	GetUserUsecase interface {
+		Execute(context.Context, GetInput) (*UserResource, error)
+	}
+
+	getUserUsecase struct {
+		contextFactory appcontext.Factory
+	}
+)
+
+func NewGetUserUsecase(contextFactory appcontext.Factory) GetUserUsecase {
+	return &getUserUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *getUserUsecase) Execute(ctx context.Context, input GetInput) (*UserResource, error) {
+	app := u.contextFactory()
+
+	user, err := getUser(ctx, app, input.ID, input.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := toUserResource(baseURL(app), user)
+	return &resource, nil
+}
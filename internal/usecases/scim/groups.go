@@ -0,0 +1,362 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	role_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	user_role "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/role"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	// GroupRequest is the body of a group create or replace.
+	GroupRequest struct {
+		Schemas     []string      `json:"schemas"`
+		DisplayName string        `json:"displayName"`
+		Members     []MultiValued `json:"members"`
+	}
+
+	// groupAttributes are the group fields the identity provider manages.
+	// Members holds user IDs.
+	groupAttributes struct {
+		DisplayName string
+		Members     []string
+	}
+)
+
+func (r GroupRequest) attributes() groupAttributes {
+	attrs := groupAttributes{
+		DisplayName: strings.TrimSpace(r.DisplayName),
+		Members:     make([]string, 0, len(r.Members)),
+	}
+
+	for _, member := range r.Members {
+		attrs.Members = appendMember(attrs.Members, strings.TrimSpace(member.Value))
+	}
+
+	return attrs
+}
+
+func groupAttributesOf(role *domain.Role, members []user_role.Member) groupAttributes {
+	attrs := groupAttributes{
+		DisplayName: string(role.Name),
+		Members:     make([]string, 0, len(members)),
+	}
+
+	for _, member := range members {
+		attrs.Members = append(attrs.Members, member.UserID)
+	}
+
+	return attrs
+}
+
+func appendMember(members []string, id string) []string {
+	if id == "" {
+		return members
+	}
+
+	for _, member := range members {
+		if member == id {
+			return members
+		}
+	}
+
+	return append(members, id)
+}
+
+func removeMember(members []string, id string) []string {
+	kept := members[:0]
+	for _, member := range members {
+		if member != id {
+			kept = append(kept, member)
+		}
+	}
+
+	return kept
+}
+
+// getGroup returns the role with the given ID and its members provisioned
+// by tenant, or a SCIM not found error. A tenant only sees the roles it
+// created, so built-in roles, roles made by administrators and those of
+// other tenants are not found.
+func getGroup(ctx context.Context, app *appcontext.Context, id string, tenant string) (*domain.Role, []user_role.Member, error) {
+	role, err := app.Repositories.Role.Get(ctx, role_repo.GetFilterOptions{ID: id, SCIMTenant: tenant})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, err
+	}
+
+	if role == nil {
+		return nil, nil, errNotFound("Group", id)
+	}
+
+	members, err := app.Repositories.UserRole.ListMembers(ctx, role.ID, tenant)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return role, members, nil
+}
+
+// roleNameTaken reports whether any role, whoever created it, is named
+// name. Role names are unique across tenants.
+func roleNameTaken(ctx context.Context, app *appcontext.Context, name string) (bool, error) {
+	existing, err := app.Repositories.Role.Get(ctx, role_repo.GetFilterOptions{Name: name})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+
+	return existing != nil, nil
+}
+
+func groupResource(ctx context.Context, app *appcontext.Context, id string, tenant string) (*GroupResource, error) {
+	role, members, err := getGroup(ctx, app, id, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := toGroupResource(baseURL(app), role, members)
+	return &resource, nil
+}
+
+// applyPatch runs PATCH operations against attrs. Only displayName and
+// members are supported.
+func (a *groupAttributes) applyPatch(request PatchRequest) error {
+	for _, operation := range request.Operations {
+		op := strings.ToLower(operation.Op)
+		path := strings.TrimSpace(operation.Path)
+
+		switch op {
+		case "add", "replace":
+			if path == "" {
+				var values map[string]json.RawMessage
+				if err := json.Unmarshal(operation.Value, &values); err != nil {
+					return errInvalidValue("patch value without a path must be an object")
+				}
+				for key, value := range values {
+					if err := a.set(op, strings.ToLower(key), value); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			if err := a.set(op, strings.ToLower(path), operation.Value); err != nil {
+				return err
+			}
+		case "remove":
+			if err := a.remove(path, operation.Value); err != nil {
+				return err
+			}
+		default:
+			return errInvalidValue("unsupported patch operation %q", operation.Op)
+		}
+	}
+
+	return nil
+}
+
+func (a *groupAttributes) set(op, path string, value json.RawMessage) error {
+	switch path {
+	case "displayname":
+		return decodeString(value, &a.DisplayName)
+	case "members":
+		var members []MultiValued
+		if err := json.Unmarshal(value, &members); err != nil {
+			return errInvalidValue("members must be a list")
+		}
+
+		if op == "replace" {
+			a.Members = []string{}
+		}
+		for _, member := range members {
+			a.Members = appendMember(a.Members, strings.TrimSpace(member.Value))
+		}
+	default:
+		return newError(http.StatusBadRequest, "invalidPath", "unsupported path %q", path)
+	}
+
+	return nil
+}
+
+// remove handles members, members with a list of values, and
+// members[value eq "id"].
+func (a *groupAttributes) remove(path string, value json.RawMessage) error {
+	lower := strings.ToLower(path)
+
+	switch {
+	case lower == "":
+		return newError(http.StatusBadRequest, "noTarget", "remove operations require a path")
+	case lower == "displayname":
+		return errMutability("displayName cannot be removed")
+	case lower == "members":
+		var members []MultiValued
+		if len(value) == 0 || string(value) == "null" {
+			a.Members = []string{}
+			return nil
+		}
+		if err := json.Unmarshal(value, &members); err != nil {
+			return errInvalidValue("members must be a list")
+		}
+		for _, member := range members {
+			a.Members = removeMember(a.Members, strings.TrimSpace(member.Value))
+		}
+	case strings.HasPrefix(lower, "members[") && strings.HasSuffix(lower, "]"):
+		filter, err := parseFilter(path[len("members["):len(path)-1], "value")
+		if err != nil {
+			return err
+		}
+		if filter == nil {
+			return newError(http.StatusBadRequest, "invalidPath", "unsupported path %q", path)
+		}
+		a.Members = removeMember(a.Members, filter.Value)
+	default:
+		return newError(http.StatusBadRequest, "invalidPath", "unsupported path %q", path)
+	}
+
+	return nil
+}
+
+// saveGroup writes the differences between the role and attrs. Every
+// membership change is recorded as an assignment or revocation, like the
+// admin role endpoints. The role is one tenant created and members are
+// those of tenant, so other tenants' memberships are left alone.
+func saveGroup(ctx context.Context, app *appcontext.Context, tokenID string, tenant string, role *domain.Role, members []user_role.Member, attrs groupAttributes) error {
+	if attrs.DisplayName == "" {
+		return errInvalidValue("displayName is required")
+	}
+
+	name := domain.RoleName(attrs.DisplayName)
+	renamed := name != role.Name
+
+	if renamed {
+		taken, err := roleNameTaken(ctx, app, attrs.DisplayName)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return errUniqueness("displayName %s is already in use", attrs.DisplayName)
+		}
+	}
+
+	current := make(map[string]bool, len(members))
+	for _, member := range members {
+		current[member.UserID] = true
+	}
+
+	var added, removed []string
+	for _, id := range attrs.Members {
+		if !current[id] {
+			added = append(added, id)
+		}
+		delete(current, id)
+	}
+	for _, member := range members {
+		if current[member.UserID] {
+			removed = append(removed, member.UserID)
+		}
+	}
+
+	if err := checkMembers(ctx, app, tenant, added); err != nil {
+		return err
+	}
+
+	actor := domain.SCIMActor(tokenID)
+
+	return app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if renamed {
+			if _, err := repos.Role.Update(ctx, role.ID, &domain.Role{Name: name}); err != nil {
+				return err
+			}
+
+			if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+				domain.AuditActionRoleUpdated,
+				actor,
+				domain.RoleTarget(role.ID),
+				map[string]any{
+					"old_name": role.Name,
+					"new_name": name,
+				},
+			)); err != nil {
+				return err
+			}
+
+			role.Name = name
+		}
+
+		for _, id := range added {
+			if _, err := repos.UserRole.Create(ctx, domain.UserRole{UserID: id, RoleID: role.ID}); err != nil {
+				return err
+			}
+
+			if err := recordMembership(ctx, repos, actor, role, id, true); err != nil {
+				return err
+			}
+		}
+
+		for _, id := range removed {
+			if _, err := repos.UserRole.Delete(ctx, id, role.ID); err != nil {
+				return err
+			}
+
+			if err := recordMembership(ctx, repos, actor, role, id, false); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// checkMembers fails when one of ids is not an existing user of tenant.
+func checkMembers(ctx context.Context, app *appcontext.Context, tenant string, ids []string) error {
+	for _, id := range ids {
+		user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{ID: id, SCIMTenant: tenant})
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return errInvalidValue("member %s does not exist", id)
+		}
+	}
+
+	return nil
+}
+
+func recordMembership(ctx context.Context, repos *repositories.Repositories, actor domain.EventActor, role *domain.Role, userID string, assigned bool) error {
+	action, eventType := domain.AuditActionRoleRevoked, domain.EventUserRoleRevoked
+	if assigned {
+		action, eventType = domain.AuditActionRoleAssigned, domain.EventUserRoleAssigned
+	}
+
+	if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+		action,
+		actor,
+		domain.UserTarget(userID),
+		map[string]any{
+			"role_id":   role.ID,
+			"role_name": role.Name,
+		},
+	)); err != nil {
+		return err
+	}
+
+	return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+		eventType,
+		actor,
+		domain.UserRoleEventData{
+			UserID:   userID,
+			RoleID:   role.ID,
+			RoleName: role.Name,
+		},
+	))
+}
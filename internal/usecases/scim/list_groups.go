@@ -0,0 +1,66 @@
+package scim
+
+import (
+	"context"
+
+	role_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	// ListGroupsUsecase pages through the roles the caller's tenant
+	// created, optionally filtered with displayName eq "value". There are
+	// few roles, so paging is done in memory.
+	ListGroupsUsecase interface {
+		Execute(context.Context, PageInput) (*ListResponse[GroupResource], error)
+	}
+
+	listGroupsUsecase struct {
+		contextFactory appcontext.Factory
+	}
+)
+
+func NewListGroupsUsecase(contextFactory appcontext.Factory) ListGroupsUsecase {
+	return &listGroupsUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *listGroupsUsecase) Execute(ctx context.Context, input PageInput) (*ListResponse[GroupResource], error) {
+	app := u.contextFactory()
+
+	filter, err := parseFilter(input.Filter, "displayName")
+	if err != nil {
+		return nil, err
+	}
+
+	options := role_repo.ListFilterOptions{SCIMTenant: input.Tenant}
+	if filter != nil {
+		options.Name = filter.Value
+	}
+
+	roles, err := app.Repositories.Role.List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, limit, startIndex := input.page()
+
+	var page []domain.Role
+	if offset < len(roles) {
+		page = roles[offset:min(offset+limit, len(roles))]
+	}
+
+	resources := make([]GroupResource, 0, len(page))
+	for i := range page {
+		members, err := app.Repositories.UserRole.ListMembers(ctx, page[i].ID, input.Tenant)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, toGroupResource(baseURL(app), &page[i], members))
+	}
+
+	return newListResponse(resources, len(roles), startIndex), nil
+}
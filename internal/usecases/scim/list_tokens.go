@@ -0,0 +1,45 @@
+package scim
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	ListTokensUsecase interface {
+		Execute(context.Context) (*ListTokensOutput, error)
+	}
+
+	listTokensUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	ListTokensOutput struct {
+		Data []TokenOutputData `json:"data"`
+	}
+)
+
+func NewListTokensUsecase(contextFactory appcontext.Factory) ListTokensUsecase {
+	return &listTokensUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *listTokensUsecase) Execute(ctx context.Context) (*ListTokensOutput, error) {
+	app := u.contextFactory()
+
+	tokens, err := app.Repositories.SCIMToken.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListTokensOutput{
+		Data: make([]TokenOutputData, 0, len(tokens)),
+	}
+	for i := range tokens {
+		output.Data = append(output.Data, toTokenOutputData(&tokens[i]))
+	}
+
+	return output, nil
+}
@@ -0,0 +1,78 @@
+package scim
+
+import (
+	"context"
+
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	// ListUsersUsecase pages through users, optionally filtered with
+	// userName eq "value", which is how identity providers look up an
+	// account before creating it.
+	ListUsersUsecase interface {
+		Execute(context.Context, PageInput) (*ListResponse[UserResource], error)
+	}
+
+	listUsersUsecase struct {
+		contextFactory appcontext.Factory
+	}
+)
+
+func NewListUsersUsecase(contextFactory appcontext.Factory) ListUsersUsecase {
+	return &listUsersUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *listUsersUsecase) Execute(ctx context.Context, input PageInput) (*ListResponse[UserResource], error) {
+	app := u.contextFactory()
+
+	filter, err := parseFilter(input.Filter, "userName")
+	if err != nil {
+		return nil, err
+	}
+
+	offset, limit, startIndex := input.page()
+
+	var (
+		users []*domain.User
+		total int
+	)
+
+	if filter != nil {
+		user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+			Email:      filter.Value,
+			SCIMTenant: input.Tenant,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if user != nil {
+			total = 1
+			if offset == 0 && limit > 0 {
+				users = []*domain.User{user}
+			}
+		}
+	} else {
+		if total, err = app.Repositories.User.Count(ctx, input.Tenant); err != nil {
+			return nil, err
+		}
+
+		if limit > 0 && offset < total {
+			if users, err = app.Repositories.User.ListPage(ctx, input.Tenant, offset, limit); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	resources := make([]UserResource, 0, len(users))
+	for _, user := range users {
+		resources = append(resources, toUserResource(baseURL(app), user))
+	}
+
+	return newListResponse(resources, total, startIndex), nil
+}
@@ -0,0 +1,57 @@
+package scim
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	// PatchGroupUsecase applies PATCH operations to displayName and
+	// members, which is how identity providers push membership changes.
+	PatchGroupUsecase interface {
+		Execute(context.Context, PatchGroupInput) (*GroupResource, error)
+	}
+
+	patchGroupUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	PatchGroupInput struct {
+		ID      string
+		Request PatchRequest
+		IfMatch string
+		TokenID string
+		Tenant  string
+	}
+)
+
+func NewPatchGroupUsecase(contextFactory appcontext.Factory) PatchGroupUsecase {
+	return &patchGroupUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *patchGroupUsecase) Execute(ctx context.Context, input PatchGroupInput) (*GroupResource, error) {
+	app := u.contextFactory()
+
+	role, members, err := getGroup(ctx, app, input.ID, input.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkVersion(input.IfMatch, toGroupResource(baseURL(app), role, members).Meta.Version); err != nil {
+		return nil, err
+	}
+
+	attrs := groupAttributesOf(role, members)
+	if err := attrs.applyPatch(input.Request); err != nil {
+		return nil, err
+	}
+
+	if err := saveGroup(ctx, app, input.TokenID, input.Tenant, role, members, attrs); err != nil {
+		return nil, err
+	}
+
+	return groupResource(ctx, app, role.ID, input.Tenant)
+}
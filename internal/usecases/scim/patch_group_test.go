@@ -0,0 +1,180 @@
+package scim_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	role_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role"
+	mock_role "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	user_role "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/role"
+	mock_user_role "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/role/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPatchGroupUsecase(t *testing.T) {
+	type fields struct {
+		user       *mock_user.MockRepository
+		role       *mock_role.MockRepository
+		userRole   *mock_user_role.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+	}
+
+	editors := &domain.Role{ID: "role-1", Name: "editors"}
+	members := []user_role.Member{{UserID: "user-1", Email: "one@example.com"}}
+
+	patch := func(operations ...usecase.PatchOperation) usecase.PatchRequest {
+		return usecase.PatchRequest{Schemas: []string{usecase.SchemaPatchOp}, Operations: operations}
+	}
+
+	tests := map[string]struct {
+		input           usecase.PatchGroupInput
+		prepare         func(f *fields)
+		expectedStatus  int
+		expectedMembers int
+	}{
+		"when members are added and removed": {
+			input: usecase.PatchGroupInput{ID: "role-1", TokenID: "token-1", Tenant: "acme", Request: patch(
+				usecase.PatchOperation{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"user-2"}]`)},
+				usecase.PatchOperation{Op: "remove", Path: `members[value eq "user-1"]`},
+			)},
+			prepare: func(f *fields) {
+				f.role.EXPECT().Get(gomock.Any(), role_repo.GetFilterOptions{ID: "role-1", SCIMTenant: "acme"}).Return(editors, nil)
+				f.userRole.EXPECT().ListMembers(gomock.Any(), "role-1", "acme").Return(members, nil)
+				f.user.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-2", SCIMTenant: "acme"}).Return(&domain.User{ID: "user-2"}, nil)
+				f.userRole.EXPECT().Create(gomock.Any(), domain.UserRole{UserID: "user-2", RoleID: "role-1"}).Return(&domain.UserRole{}, nil)
+				f.userRole.EXPECT().Delete(gomock.Any(), "user-1", "role-1").Return(&domain.UserRole{}, nil)
+
+				var actions []domain.AuditAction
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						actions = append(actions, event.Action)
+						assert.Equal(t, domain.SCIMActor("token-1"), event.Actor)
+						return 1, nil
+					},
+				).Times(2)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						return message.ID, nil
+					},
+				).Times(2)
+
+				f.role.EXPECT().Get(gomock.Any(), role_repo.GetFilterOptions{ID: "role-1", SCIMTenant: "acme"}).DoAndReturn(
+					func(ctx context.Context, filters role_repo.GetFilterOptions) (*domain.Role, error) {
+						assert.Equal(t, []domain.AuditAction{domain.AuditActionRoleAssigned, domain.AuditActionRoleRevoked}, actions)
+						return editors, nil
+					},
+				)
+				f.userRole.EXPECT().ListMembers(gomock.Any(), "role-1", "acme").Return([]user_role.Member{{UserID: "user-2"}}, nil)
+			},
+			expectedMembers: 1,
+		},
+		"when an added member does not exist": {
+			input: usecase.PatchGroupInput{ID: "role-1", Tenant: "acme", Request: patch(
+				usecase.PatchOperation{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"user-404"}]`)},
+			)},
+			prepare: func(f *fields) {
+				f.role.EXPECT().Get(gomock.Any(), role_repo.GetFilterOptions{ID: "role-1", SCIMTenant: "acme"}).Return(editors, nil)
+				f.userRole.EXPECT().ListMembers(gomock.Any(), "role-1", "acme").Return(members, nil)
+				f.user.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-404", SCIMTenant: "acme"}).Return(nil, nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		"when a built-in role is renamed": {
+			input: usecase.PatchGroupInput{ID: "role-0", Tenant: "acme", Request: patch(
+				usecase.PatchOperation{Op: "replace", Path: "displayName", Value: json.RawMessage(`"owners"`)},
+			)},
+			prepare: func(f *fields) {
+				f.role.EXPECT().Get(gomock.Any(), role_repo.GetFilterOptions{ID: "role-0", SCIMTenant: "acme"}).Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		"when a member is added to a role of another tenant": {
+			input: usecase.PatchGroupInput{ID: "role-1", Tenant: "globex", Request: patch(
+				usecase.PatchOperation{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"user-2"}]`)},
+			)},
+			prepare: func(f *fields) {
+				f.role.EXPECT().Get(gomock.Any(), role_repo.GetFilterOptions{ID: "role-1", SCIMTenant: "globex"}).Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		"when the group does not exist": {
+			input: usecase.PatchGroupInput{ID: "role-404", Tenant: "acme", Request: patch()},
+			prepare: func(f *fields) {
+				f.role.EXPECT().Get(gomock.Any(), role_repo.GetFilterOptions{ID: "role-404", SCIMTenant: "acme"}).Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				user:       mock_user.NewMockRepository(ctrl),
+				role:       mock_role.NewMockRepository(ctrl),
+				userRole:   mock_user_role.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{
+						Role:     f.role,
+						UserRole: f.userRole,
+						Outbox:   f.outbox,
+						Audit:    f.audit,
+					})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User:     f.user,
+						Role:     f.role,
+						UserRole: f.userRole,
+					},
+					Transactor: f.transactor,
+					ConfigService: &config.ConfigurationService{
+						ServerConfig: config.ServerConfig{Host: "https://auth.example.com"},
+					},
+				}
+			}
+
+			uc := usecase.NewPatchGroupUsecase(contextFactory)
+			output, err := uc.Execute(context.Background(), tc.input)
+
+			if tc.expectedStatus != 0 {
+				var scimErr *usecase.Error
+				if assert.ErrorAs(t, err, &scimErr) {
+					assert.Equal(t, tc.expectedStatus, scimErr.Status)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, output.Members, tc.expectedMembers)
+		})
+	}
+}
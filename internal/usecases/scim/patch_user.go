@@ -0,0 +1,64 @@
+package scim
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	// PatchUserUsecase applies PATCH operations to userName, name, emails
+	// and active. Setting active to false deactivates the account and
+	// revokes its tokens.
+	PatchUserUsecase interface {
+		Execute(context.Context, PatchUserInput) (*UserResource, error)
+	}
+
+	patchUserUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	PatchUserInput struct {
+		ID      string
+		Request PatchRequest
+		IfMatch string
+		TokenID string
+		Tenant  string
+	}
+)
+
+func NewPatchUserUsecase(contextFactory appcontext.Factory) PatchUserUsecase {
+	return &patchUserUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *patchUserUsecase) Execute(ctx context.Context, input PatchUserInput) (*UserResource, error) {
+	app := u.contextFactory()
+
+	user, err := getUser(ctx, app, input.ID, input.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkVersion(input.IfMatch, toUserResource(baseURL(app), user).Meta.Version); err != nil {
+		return nil, err
+	}
+
+	attrs := attributesOf(user)
+	if err := attrs.applyPatch(input.Request); err != nil {
+		return nil, err
+	}
+
+	if err := updateUser(ctx, app, input.TokenID, user, attrs); err != nil {
+		return nil, err
+	}
+
+	updated, err := getUser(ctx, app, user.ID, input.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := toUserResource(baseURL(app), updated)
+	return &resource, nil
+}
@@ -0,0 +1,185 @@
+package scim_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/scim"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPatchUserUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+	}
+
+	user := func() *domain.User {
+		return &domain.User{
+			ID:        "user-123",
+			FirstName: "John",
+			LastName:  "Doe",
+			Email:     "john@example.com",
+			IsActive:  true,
+		}
+	}
+
+	patch := func(op, path, value string) usecase.PatchRequest {
+		return usecase.PatchRequest{
+			Schemas: []string{usecase.SchemaPatchOp},
+			Operations: []usecase.PatchOperation{
+				{Op: op, Path: path, Value: json.RawMessage(value)},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		input          usecase.PatchUserInput
+		prepare        func(f *fields)
+		expectedStatus int
+		expectedActive bool
+	}{
+		"when the identity provider deactivates the user": {
+			input: usecase.PatchUserInput{ID: "user-123", Tenant: "acme", Request: patch("Replace", "active", `"False"`), TokenID: "token-1"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123", SCIMTenant: "acme"}).Return(user(), nil)
+				f.repository.EXPECT().Suspend(gomock.Any(), "user-123", "Deactivated by SCIM provisioning", nil).Return(nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, domain.AuditActionUserSuspended, event.Action)
+						assert.Equal(t, domain.SCIMActor("token-1"), event.Actor)
+						return 1, nil
+					},
+				)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						assert.Equal(t, string(domain.EventUserDeactivated), message.Topic)
+						return message.ID, nil
+					},
+				)
+				suspended := user()
+				suspended.IsActive = false
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123", SCIMTenant: "acme"}).Return(suspended, nil)
+			},
+			expectedActive: false,
+		},
+		"when the patch changes nothing stored": {
+			input: usecase.PatchUserInput{ID: "user-123", Tenant: "acme", Request: patch("add", "title", `"Engineer"`), IfMatch: "*"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123", SCIMTenant: "acme"}).Return(user(), nil).Times(2)
+			},
+			expectedActive: true,
+		},
+		"when the email belongs to another user": {
+			input: usecase.PatchUserInput{ID: "user-123", Tenant: "acme", Request: patch("replace", "userName", `"jane@example.com"`)},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123", SCIMTenant: "acme"}).Return(user(), nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Email: "jane@example.com", IncludeDeleted: true}).
+					Return(&domain.User{ID: "user-456"}, nil)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		"when userName is removed": {
+			input: usecase.PatchUserInput{ID: "user-123", Tenant: "acme", Request: patch("remove", "userName", ``)},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123", SCIMTenant: "acme"}).Return(user(), nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		"when the identity provider deactivates an admin": {
+			input: usecase.PatchUserInput{ID: "user-123", Tenant: "acme", Request: patch("replace", "active", `false`)},
+			prepare: func(f *fields) {
+				admin := user()
+				admin.Roles = []domain.Role{{Name: domain.RoleAdmin}}
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123", SCIMTenant: "acme"}).Return(admin, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		"when the identity provider changes the email of a superadmin": {
+			input: usecase.PatchUserInput{ID: "user-123", Tenant: "acme", Request: patch("replace", "userName", `"attacker@example.com"`)},
+			prepare: func(f *fields) {
+				superadmin := user()
+				superadmin.Roles = []domain.Role{{Name: domain.RoleSuperAdmin}}
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123", SCIMTenant: "acme"}).Return(superadmin, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		"when the version does not match": {
+			input: usecase.PatchUserInput{ID: "user-123", Tenant: "acme", Request: patch("replace", "active", `false`), IfMatch: `W/"stale"`},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123", SCIMTenant: "acme"}).Return(user(), nil)
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		"when the user does not exist": {
+			input: usecase.PatchUserInput{ID: "user-404", Tenant: "acme", Request: patch("replace", "active", `false`)},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-404", SCIMTenant: "acme"}).Return(nil, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{User: f.repository, Outbox: f.outbox, Audit: f.audit})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User: f.repository,
+					},
+					Transactor: f.transactor,
+					ConfigService: &config.ConfigurationService{
+						ServerConfig: config.ServerConfig{Host: "https://auth.example.com"},
+					},
+				}
+			}
+
+			uc := usecase.NewPatchUserUsecase(contextFactory)
+			output, err := uc.Execute(context.Background(), tc.input)
+
+			if tc.expectedStatus != 0 {
+				var scimErr *usecase.Error
+				if assert.ErrorAs(t, err, &scimErr) {
+					assert.Equal(t, tc.expectedStatus, scimErr.Status)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedActive, *output.Active)
+			assert.Equal(t, "https://auth.example.com/scim/v2/Users/user-123", output.Meta.Location)
+			assert.NotEmpty(t, output.Meta.Version)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package scim
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	// ReplaceGroupUsecase applies a PUT, which sets the full member list.
+	ReplaceGroupUsecase interface {
+		Execute(context.Context, ReplaceGroupInput) (*GroupResource, error)
+	}
+
+	replaceGroupUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	ReplaceGroupInput struct {
+		ID      string
+		Request GroupRequest
+		IfMatch string
+		TokenID string
+		Tenant  string
+	}
+)
+
+func NewReplaceGroupUsecase(contextFactory appcontext.Factory) ReplaceGroupUsecase {
+	return &replaceGroupUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *replaceGroupUsecase) Execute(ctx context.Context, input ReplaceGroupInput) (*GroupResource, error) {
+	app := u.contextFactory()
+
+	role, members, err := getGroup(ctx, app, input.ID, input.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkVersion(input.IfMatch, toGroupResource(baseURL(app), role, members).Meta.Version); err != nil {
+		return nil, err
+	}
+
+	if err := saveGroup(ctx, app, input.TokenID, input.Tenant, role, members, input.Request.attributes()); err != nil {
+		return nil, err
+	}
+
+	return groupResource(ctx, app, role.ID, input.Tenant)
+}
@@ -0,0 +1,58 @@
+package scim
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	// ReplaceUserUsecase applies a PUT. Attributes the request leaves out
+	// keep their values, and a password is only accepted on create.
+	ReplaceUserUsecase interface {
+		Execute(context.Context, ReplaceUserInput) (*UserResource, error)
+	}
+
+	replaceUserUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	ReplaceUserInput struct {
+		ID      string
+		Request UserRequest
+		IfMatch string
+		TokenID string
+		Tenant  string
+	}
+)
+
+func NewReplaceUserUsecase(contextFactory appcontext.Factory) ReplaceUserUsecase {
+	return &replaceUserUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *replaceUserUsecase) Execute(ctx context.Context, input ReplaceUserInput) (*UserResource, error) {
+	app := u.contextFactory()
+
+	user, err := getUser(ctx, app, input.ID, input.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkVersion(input.IfMatch, toUserResource(baseURL(app), user).Meta.Version); err != nil {
+		return nil, err
+	}
+
+	if err := updateUser(ctx, app, input.TokenID, user, input.Request.attributes(attributesOf(user))); err != nil {
+		return nil, err
+	}
+
+	updated, err := getUser(ctx, app, user.ID, input.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := toUserResource(baseURL(app), updated)
+	return &resource, nil
+}
@@ -0,0 +1,194 @@
+package scim
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	user_role "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/role"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+const (
+	SchemaUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError        = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	defaultPageSize = 100
+	maxPageSize     = 200
+)
+
+type (
+	// UserResource is a user as seen by the identity provider. userName is
+	// the email address, which is what users sign in with.
+	UserResource struct {
+		Schemas  []string      `json:"schemas"`
+		ID       string        `json:"id,omitempty"`
+		UserName string        `json:"userName"`
+		Name     UserName      `json:"name"`
+		Emails   []MultiValued `json:"emails,omitempty"`
+		Active   *bool         `json:"active,omitempty"`
+		Groups   []MultiValued `json:"groups,omitempty"`
+		Meta     *Meta         `json:"meta,omitempty"`
+	}
+
+	UserName struct {
+		GivenName  string `json:"givenName"`
+		FamilyName string `json:"familyName"`
+	}
+
+	// GroupResource is a role. Members are users.
+	GroupResource struct {
+		Schemas     []string      `json:"schemas"`
+		ID          string        `json:"id,omitempty"`
+		DisplayName string        `json:"displayName"`
+		Members     []MultiValued `json:"members"`
+		Meta        *Meta         `json:"meta,omitempty"`
+	}
+
+	MultiValued struct {
+		Value   string `json:"value"`
+		Display string `json:"display,omitempty"`
+		Type    string `json:"type,omitempty"`
+		Primary bool   `json:"primary,omitempty"`
+		Ref     string `json:"$ref,omitempty"`
+	}
+
+	// Meta.Version is a weak ETag over the rest of the resource.
+	Meta struct {
+		ResourceType string     `json:"resourceType"`
+		Created      *time.Time `json:"created,omitempty"`
+		LastModified *time.Time `json:"lastModified,omitempty"`
+		Location     string     `json:"location"`
+		Version      string     `json:"version"`
+	}
+
+	ListResponse[T any] struct {
+		Schemas      []string `json:"schemas"`
+		TotalResults int      `json:"totalResults"`
+		StartIndex   int      `json:"startIndex"`
+		ItemsPerPage int      `json:"itemsPerPage"`
+		Resources    []T      `json:"Resources"`
+	}
+
+	// PageInput is the startIndex and count query parameters. StartIndex
+	// is 1-based. Tenant is the calling token's tenant.
+	PageInput struct {
+		Filter     string
+		StartIndex int
+		Count      *int
+		Tenant     string
+	}
+
+	// GetInput identifies a resource of the calling token's tenant.
+	GetInput struct {
+		ID     string
+		Tenant string
+	}
+)
+
+func toUserResource(baseURL string, user *domain.User) UserResource {
+	active := user.IsActive
+	resource := UserResource{
+		Schemas:  []string{SchemaUser},
+		ID:       user.ID,
+		UserName: user.Email,
+		Name: UserName{
+			GivenName:  user.FirstName,
+			FamilyName: user.LastName,
+		},
+		Emails: []MultiValued{{Value: user.Email, Type: "work", Primary: true}},
+		Active: &active,
+	}
+
+	for _, role := range user.Roles {
+		resource.Groups = append(resource.Groups, MultiValued{
+			Value:   role.ID,
+			Display: string(role.Name),
+			Ref:     baseURL + "/Groups/" + role.ID,
+		})
+	}
+
+	created, modified := user.CreatedAt, user.UpdatedAt
+	resource.Meta = &Meta{
+		ResourceType: "User",
+		Created:      &created,
+		LastModified: &modified,
+		Location:     baseURL + "/Users/" + user.ID,
+		Version:      resourceVersion(resource),
+	}
+
+	return resource
+}
+
+func toGroupResource(baseURL string, role *domain.Role, members []user_role.Member) GroupResource {
+	resource := GroupResource{
+		Schemas:     []string{SchemaGroup},
+		ID:          role.ID,
+		DisplayName: string(role.Name),
+		Members:     make([]MultiValued, 0, len(members)),
+	}
+
+	for _, member := range members {
+		resource.Members = append(resource.Members, MultiValued{
+			Value:   member.UserID,
+			Display: member.Email,
+			Ref:     baseURL + "/Users/" + member.UserID,
+		})
+	}
+
+	resource.Meta = &Meta{
+		ResourceType: "Group",
+		Location:     baseURL + "/Groups/" + role.ID,
+		Version:      resourceVersion(resource),
+	}
+
+	return resource
+}
+
+// resourceVersion hashes a resource that does not have its Meta set yet.
+func resourceVersion(resource any) string {
+	body, _ := json.Marshal(resource)
+	sum := sha256.Sum256(body)
+
+	return `W/"` + hex.EncodeToString(sum[:8]) + `"`
+}
+
+// page clamps the requested page to what the server returns. The offset is
+// 0-based.
+func (p PageInput) page() (offset, limit, startIndex int) {
+	startIndex = p.StartIndex
+	if startIndex < 1 {
+		startIndex = 1
+	}
+
+	limit = defaultPageSize
+	if p.Count != nil {
+		limit = *p.Count
+	}
+	if limit < 0 {
+		limit = 0
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	return startIndex - 1, limit, startIndex
+}
+
+func newListResponse[T any](resources []T, total, startIndex int) *ListResponse[T] {
+	if resources == nil {
+		resources = []T{}
+	}
+
+	return &ListResponse[T]{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
@@ -0,0 +1,60 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	RevokeTokenUsecase interface {
+		Execute(context.Context, RevokeTokenInput) error
+	}
+
+	revokeTokenUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	RevokeTokenInput struct {
+		ID    string
+		Actor string
+	}
+)
+
+func NewRevokeTokenUsecase(contextFactory appcontext.Factory) RevokeTokenUsecase {
+	return &revokeTokenUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *revokeTokenUsecase) Execute(ctx context.Context, input RevokeTokenInput) error {
+	app := u.contextFactory()
+
+	actor, _, err := audit_repo.Actor(ctx, app.Repositories.User, input.Actor)
+	if err != nil {
+		return err
+	}
+
+	return app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if err := repos.SCIMToken.Revoke(ctx, input.ID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.New("token not found")
+			}
+			return err
+		}
+
+		return audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionSCIMTokenRevoked,
+			actor,
+			domain.AuditTarget{},
+			map[string]any{
+				"token_id": input.ID,
+			},
+		))
+	})
+}
@@ -0,0 +1,34 @@
+package scim
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type TokenOutputData struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Tenant     string     `json:"tenant"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+func toTokenOutputData(token *domain.SCIMToken) TokenOutputData {
+	return TokenOutputData{
+		ID:         token.ID,
+		Name:       token.Name,
+		Tenant:     token.Tenant,
+		CreatedAt:  token.CreatedAt,
+		LastUsedAt: token.LastUsedAt,
+		RevokedAt:  token.RevokedAt,
+	}
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,403 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+// deactivationReason is stored on users deactivated by the identity
+// provider, which does not say why.
+const deactivationReason = "Deactivated by SCIM provisioning"
+
+type (
+	// UserRequest is the body of a user create or replace.
+	UserRequest struct {
+		Schemas  []string      `json:"schemas"`
+		UserName string        `json:"userName"`
+		Name     UserName      `json:"name"`
+		Emails   []MultiValued `json:"emails"`
+		Active   *bool         `json:"active"`
+		Password string        `json:"password"`
+	}
+
+	PatchRequest struct {
+		Schemas    []string         `json:"schemas"`
+		Operations []PatchOperation `json:"Operations"`
+	}
+
+	PatchOperation struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	}
+
+	// userAttributes are the user fields the identity provider manages.
+	userAttributes struct {
+		Email      string
+		GivenName  string
+		FamilyName string
+		Active     bool
+	}
+)
+
+func baseURL(app *appcontext.Context) string {
+	return app.ConfigService.ServerConfig.Host + "/scim/v2"
+}
+
+func attributesOf(user *domain.User) userAttributes {
+	return userAttributes{
+		Email:      user.Email,
+		GivenName:  user.FirstName,
+		FamilyName: user.LastName,
+		Active:     user.IsActive,
+	}
+}
+
+// attributes reads a create or replace body. Attributes it leaves out keep
+// the values in current.
+func (r UserRequest) attributes(current userAttributes) userAttributes {
+	attrs := userAttributes{
+		Email:      strings.TrimSpace(r.UserName),
+		GivenName:  strings.TrimSpace(r.Name.GivenName),
+		FamilyName: strings.TrimSpace(r.Name.FamilyName),
+		Active:     current.Active,
+	}
+
+	if attrs.Email == "" {
+		attrs.Email = primaryValue(r.Emails)
+	}
+	if r.Active != nil {
+		attrs.Active = *r.Active
+	}
+
+	return attrs
+}
+
+func (a userAttributes) validate() error {
+	if err := auth.ValidateEmail(a.Email); err != nil {
+		return errInvalidValue("userName must be a valid email address")
+	}
+	if a.GivenName == "" {
+		return errInvalidValue("name.givenName is required")
+	}
+
+	return nil
+}
+
+func primaryValue(values []MultiValued) string {
+	for _, value := range values {
+		if value.Primary {
+			return strings.TrimSpace(value.Value)
+		}
+	}
+	if len(values) > 0 {
+		return strings.TrimSpace(values[0].Value)
+	}
+
+	return ""
+}
+
+// getUser returns the user with the given ID provisioned by tenant, or a
+// SCIM not found error. Users of other tenants are reported as missing.
+func getUser(ctx context.Context, app *appcontext.Context, id string, tenant string) (*domain.User, error) {
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{ID: id, SCIMTenant: tenant})
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errNotFound("User", id)
+	}
+
+	return user, nil
+}
+
+// isPrivileged reports whether user holds admin or superadmin. Their email
+// and active status are managed by admins only, so that an identity
+// provider token cannot take over or lock out an administrator.
+func isPrivileged(user *domain.User) bool {
+	return domain.HighestRank(user.Roles) > domain.RoleUser.Rank()
+}
+
+// applyPatch runs PATCH operations against attrs. Attributes this service
+// does not store are accepted and ignored, as identity providers send many
+// of them.
+func (a *userAttributes) applyPatch(request PatchRequest) error {
+	for _, operation := range request.Operations {
+		op := strings.ToLower(operation.Op)
+		path := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(operation.Path), SchemaUser+":"))
+
+		switch {
+		case op != "add" && op != "replace" && op != "remove":
+			return errInvalidValue("unsupported patch operation %q", operation.Op)
+		case op == "remove":
+			if err := a.remove(path); err != nil {
+				return err
+			}
+		case path == "":
+			var values map[string]json.RawMessage
+			if err := json.Unmarshal(operation.Value, &values); err != nil {
+				return errInvalidValue("patch value without a path must be an object")
+			}
+			for key, value := range values {
+				if err := a.set(strings.ToLower(key), value); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := a.set(path, operation.Value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *userAttributes) set(path string, value json.RawMessage) error {
+	switch {
+	case path == "active":
+		active, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		a.Active = active
+	case path == "username":
+		return decodeString(value, &a.Email)
+	case path == "name.givenname":
+		return decodeString(value, &a.GivenName)
+	case path == "name.familyname":
+		return decodeString(value, &a.FamilyName)
+	case path == "name":
+		var name map[string]string
+		if err := json.Unmarshal(value, &name); err != nil {
+			return errInvalidValue("name must be an object")
+		}
+		for key, part := range name {
+			switch strings.ToLower(key) {
+			case "givenname":
+				a.GivenName = strings.TrimSpace(part)
+			case "familyname":
+				a.FamilyName = strings.TrimSpace(part)
+			}
+		}
+	case path == "emails":
+		var emails []MultiValued
+		if err := json.Unmarshal(value, &emails); err != nil {
+			return errInvalidValue("emails must be a list")
+		}
+		if email := primaryValue(emails); email != "" {
+			a.Email = email
+		}
+	case strings.HasPrefix(path, "emails[") && strings.HasSuffix(path, "].value"):
+		return decodeString(value, &a.Email)
+	}
+
+	return nil
+}
+
+func (a *userAttributes) remove(path string) error {
+	switch path {
+	case "name.familyname":
+		a.FamilyName = ""
+	case "":
+		return newError(http.StatusBadRequest, "noTarget", "remove operations require a path")
+	case "active", "username", "name", "name.givenname", "emails":
+		return errMutability("%s cannot be removed", path)
+	}
+
+	return nil
+}
+
+func decodeString(value json.RawMessage, target *string) error {
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return errInvalidValue("expected a string value")
+	}
+
+	*target = strings.TrimSpace(s)
+	return nil
+}
+
+// parseBool accepts JSON booleans and the "True"/"False" strings some
+// identity providers send.
+func parseBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		switch strings.ToLower(s) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+	}
+
+	return false, errInvalidValue("active must be a boolean")
+}
+
+// updateUser writes the differences between user and attrs. Turning active
+// off suspends the account, which also revokes its tokens.
+func updateUser(ctx context.Context, app *appcontext.Context, tokenID string, user *domain.User, attrs userAttributes) error {
+	if err := attrs.validate(); err != nil {
+		return err
+	}
+
+	emailChanged := attrs.Email != user.Email
+	nameChanged := attrs.GivenName != user.FirstName || attrs.FamilyName != user.LastName
+	activeChanged := attrs.Active != user.IsActive
+
+	if (emailChanged || activeChanged) && isPrivileged(user) {
+		return errForbidden("userName and active of administrators cannot be managed through SCIM")
+	}
+
+	if emailChanged {
+		existing, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+			Email:          attrs.Email,
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return err
+		}
+		if existing != nil && existing.ID != user.ID {
+			return errUniqueness("userName %s is already in use", attrs.Email)
+		}
+	}
+
+	actor := domain.SCIMActor(tokenID)
+
+	return app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if nameChanged {
+			if _, err := repos.User.Update(ctx, user.ID, user_repo.UpdateFields{
+				FirstName: &attrs.GivenName,
+				LastName:  &attrs.FamilyName,
+			}); err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return errNotFound("User", user.ID)
+				}
+				return err
+			}
+
+			user.FirstName, user.LastName = attrs.GivenName, attrs.FamilyName
+
+			if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+				domain.AuditActionUserUpdated,
+				actor,
+				domain.UserTarget(user.ID),
+				map[string]any{
+					"fields": []string{"first_name", "last_name"},
+				},
+			)); err != nil {
+				return err
+			}
+
+			if err := outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+				domain.EventUserUpdated,
+				actor,
+				domain.NewUserEventData(user),
+			)); err != nil {
+				return err
+			}
+		}
+
+		if emailChanged {
+			if err := repos.User.ChangeEmail(ctx, user.ID, attrs.Email); err != nil {
+				return err
+			}
+
+			oldEmail := user.Email
+			user.Email, user.VerifiedEmail = attrs.Email, true
+
+			if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+				domain.AuditActionEmailChanged,
+				actor,
+				domain.UserTarget(user.ID),
+				map[string]any{
+					"old_email": oldEmail,
+					"new_email": attrs.Email,
+				},
+			)); err != nil {
+				return err
+			}
+
+			if err := outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+				domain.EventUserEmailChanged,
+				actor,
+				domain.NewUserEventData(user),
+			)); err != nil {
+				return err
+			}
+		}
+
+		if !activeChanged {
+			return nil
+		}
+
+		return setActive(ctx, repos, actor, user, attrs.Active)
+	})
+}
+
+func setActive(ctx context.Context, repos *repositories.Repositories, actor domain.EventActor, user *domain.User, active bool) error {
+	action, eventType := domain.AuditActionUserReactivated, domain.EventUserReactivated
+	metadata := map[string]any{}
+
+	if active {
+		if err := repos.User.Reactivate(ctx, user.ID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errNotFound("User", user.ID)
+			}
+			return err
+		}
+
+		user.SuspendedAt, user.SuspendedUntil, user.SuspensionReason = nil, nil, nil
+	} else {
+		if err := repos.User.Suspend(ctx, user.ID, deactivationReason, nil); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errNotFound("User", user.ID)
+			}
+			return err
+		}
+
+		now := time.Now().UTC()
+		reason := deactivationReason
+		user.SuspendedAt, user.SuspensionReason = &now, &reason
+		user.TokenVersion++
+
+		action, eventType = domain.AuditActionUserSuspended, domain.EventUserDeactivated
+		metadata["reason"] = reason
+	}
+
+	user.IsActive = active
+
+	if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+		action,
+		actor,
+		domain.UserTarget(user.ID),
+		metadata,
+	)); err != nil {
+		return err
+	}
+
+	return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+		eventType,
+		actor,
+		domain.NewUserEventData(user),
+	))
+}
@@ -2,54 +2,171 @@ package usecases
 
 import (
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/audit"
 	"github.com/tapiaw38/auth-api-be/internal/usecases/role"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/scim"
 	"github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"github.com/tapiaw38/auth-api-be/internal/usecases/webhook"
 )
 
 type Usecases struct {
-	User User
-	Role Role
+	User    User
+	Role    Role
+	Webhook Webhook
+	Audit   Audit
+	SCIM    SCIM
 }
 
 type User struct {
-	RegisterUsecase             user.RegisterUsecase
-	LoginUsecase                user.LoginUsecase
-	GetUsecase                  user.GetUsecase
-	UpdateUsecase               user.UpdateUsecase
-	DeleteUsecase               user.DeleteUsecase
-	ListUsecase                 user.ListUsecase
-	GetTokenVersionUsecase      user.GetTokenVersionUsecase
-	VerifyEmailUsecase          user.VerifyEmailUsecase
-	ResetPasswordUsecase        user.ResetPasswordUsecase
-	RequestResetPasswordUsecase user.RequestResetPasswordUsecase
-	ChangePasswordUsecase       user.ChangePasswordUsecase
-	SetPasswordUsecase          user.SetPasswordUsecase
+	RegisterUsecase                 user.RegisterUsecase
+	LoginUsecase                    user.LoginUsecase
+	GetUsecase                      user.GetUsecase
+	UpdateUsecase                   user.UpdateUsecase
+	DeleteUsecase                   user.DeleteUsecase
+	ListUsecase                     user.ListUsecase
+	SearchUsecase                   user.SearchUsecase
+	GetTokenVersionUsecase          user.GetTokenVersionUsecase
+	VerifyEmailUsecase              user.VerifyEmailUsecase
+	ResendVerificationUsecase       user.ResendVerificationUsecase
+	ReauthenticateUsecase           user.ReauthenticateUsecase
+	ReportUnrecognizedLoginUsecase  user.ReportUnrecognizedLoginUsecase
+	ResetPasswordUsecase            user.ResetPasswordUsecase
+	RequestResetPasswordUsecase     user.RequestResetPasswordUsecase
+	ChangePasswordUsecase           user.ChangePasswordUsecase
+	SetPasswordUsecase              user.SetPasswordUsecase
+	RequestEmailChangeUsecase       user.RequestEmailChangeUsecase
+	ConfirmEmailChangeUsecase       user.ConfirmEmailChangeUsecase
+	UploadAvatarUsecase             user.UploadAvatarUsecase
+	StartPhoneVerificationUsecase   user.StartPhoneVerificationUsecase
+	ConfirmPhoneVerificationUsecase user.ConfirmPhoneVerificationUsecase
+	RequestExportUsecase            user.RequestExportUsecase
+	DownloadExportUsecase           user.DownloadExportUsecase
+	ScheduleDeletionUsecase         user.ScheduleDeletionUsecase
+	CancelDeletionUsecase           user.CancelDeletionUsecase
+	SuspendUsecase                  user.SuspendUsecase
+	ReactivateUsecase               user.ReactivateUsecase
+	ImportUsersUsecase              user.ImportUsersUsecase
+	GetImportJobUsecase             user.GetImportJobUsecase
+	ExportUsersUsecase              user.ExportUsersUsecase
 }
 
 type Role struct {
 	EnsureUsecase role.EnsureUseCase
 	ListUsecase   role.ListUsecase
+	AssignUsecase role.AssignUsecase
+	RevokeUsecase role.RevokeUsecase
+}
+
+type Webhook struct {
+	CreateUsecase         webhook.CreateUsecase
+	GetUsecase            webhook.GetUsecase
+	ListUsecase           webhook.ListUsecase
+	UpdateUsecase         webhook.UpdateUsecase
+	DeleteUsecase         webhook.DeleteUsecase
+	ListDeliveriesUsecase webhook.ListDeliveriesUsecase
+	GetDeliveryUsecase    webhook.GetDeliveryUsecase
+	RedeliverUsecase      webhook.RedeliverUsecase
+}
+
+type Audit struct {
+	RecordUsecase       audit.RecordUsecase
+	ListUsecase         audit.ListUsecase
+	ListActivityUsecase audit.ListActivityUsecase
+	VerifyUsecase       audit.VerifyUsecase
+}
+
+type SCIM struct {
+	CreateTokenUsecase  scim.CreateTokenUsecase
+	ListTokensUsecase   scim.ListTokensUsecase
+	RevokeTokenUsecase  scim.RevokeTokenUsecase
+	AuthenticateUsecase scim.AuthenticateUsecase
+	ListUsersUsecase    scim.ListUsersUsecase
+	GetUserUsecase      scim.GetUserUsecase
+	CreateUserUsecase   scim.CreateUserUsecase
+	ReplaceUserUsecase  scim.ReplaceUserUsecase
+	PatchUserUsecase    scim.PatchUserUsecase
+	DeleteUserUsecase   scim.DeleteUserUsecase
+	ListGroupsUsecase   scim.ListGroupsUsecase
+	GetGroupUsecase     scim.GetGroupUsecase
+	CreateGroupUsecase  scim.CreateGroupUsecase
+	ReplaceGroupUsecase scim.ReplaceGroupUsecase
+	PatchGroupUsecase   scim.PatchGroupUsecase
+	DeleteGroupUsecase  scim.DeleteGroupUsecase
 }
 
 func CreateUsecases(contextFactory appcontext.Factory) *Usecases {
 	return &Usecases{
 		User: User{
-			RegisterUsecase:             user.NewCreateUsecase(contextFactory),
-			LoginUsecase:                user.NewLoginUsecase(contextFactory),
-			GetUsecase:                  user.NewGetUsecase(contextFactory),
-			UpdateUsecase:               user.NewUpdateUsecase(contextFactory),
-			DeleteUsecase:               user.NewDeleteUsecase(contextFactory),
-			ListUsecase:                 user.NewListUsecase(contextFactory),
-			GetTokenVersionUsecase:      user.NewGetTokenVersionUsecase(contextFactory),
-			VerifyEmailUsecase:          user.NewVerifyEmailUsecase(contextFactory),
-			ResetPasswordUsecase:        user.NewResetPasswordUsecase(contextFactory),
-			RequestResetPasswordUsecase: user.NewRequestResetPasswordUsecase(contextFactory),
-			ChangePasswordUsecase:       user.NewChangePasswordUsecase(contextFactory),
-			SetPasswordUsecase:          user.NewSetPasswordUsecase(contextFactory),
+			RegisterUsecase:                 user.NewCreateUsecase(contextFactory),
+			LoginUsecase:                    user.NewLoginUsecase(contextFactory),
+			GetUsecase:                      user.NewGetUsecase(contextFactory),
+			UpdateUsecase:                   user.NewUpdateUsecase(contextFactory),
+			DeleteUsecase:                   user.NewDeleteUsecase(contextFactory),
+			ListUsecase:                     user.NewListUsecase(contextFactory),
+			SearchUsecase:                   user.NewSearchUsecase(contextFactory),
+			GetTokenVersionUsecase:          user.NewGetTokenVersionUsecase(contextFactory),
+			VerifyEmailUsecase:              user.NewVerifyEmailUsecase(contextFactory),
+			ResendVerificationUsecase:       user.NewResendVerificationUsecase(contextFactory),
+			ReauthenticateUsecase:           user.NewReauthenticateUsecase(contextFactory),
+			ReportUnrecognizedLoginUsecase:  user.NewReportUnrecognizedLoginUsecase(contextFactory),
+			ResetPasswordUsecase:            user.NewResetPasswordUsecase(contextFactory),
+			RequestResetPasswordUsecase:     user.NewRequestResetPasswordUsecase(contextFactory),
+			ChangePasswordUsecase:           user.NewChangePasswordUsecase(contextFactory),
+			SetPasswordUsecase:              user.NewSetPasswordUsecase(contextFactory),
+			RequestEmailChangeUsecase:       user.NewRequestEmailChangeUsecase(contextFactory),
+			ConfirmEmailChangeUsecase:       user.NewConfirmEmailChangeUsecase(contextFactory),
+			UploadAvatarUsecase:             user.NewUploadAvatarUsecase(contextFactory),
+			StartPhoneVerificationUsecase:   user.NewStartPhoneVerificationUsecase(contextFactory),
+			ConfirmPhoneVerificationUsecase: user.NewConfirmPhoneVerificationUsecase(contextFactory),
+			RequestExportUsecase:            user.NewRequestExportUsecase(contextFactory),
+			DownloadExportUsecase:           user.NewDownloadExportUsecase(contextFactory),
+			ScheduleDeletionUsecase:         user.NewScheduleDeletionUsecase(contextFactory),
+			CancelDeletionUsecase:           user.NewCancelDeletionUsecase(contextFactory),
+			SuspendUsecase:                  user.NewSuspendUsecase(contextFactory),
+			ReactivateUsecase:               user.NewReactivateUsecase(contextFactory),
+			ImportUsersUsecase:              user.NewImportUsersUsecase(contextFactory),
+			GetImportJobUsecase:             user.NewGetImportJobUsecase(contextFactory),
+			ExportUsersUsecase:              user.NewExportUsersUsecase(contextFactory),
 		},
 		Role: Role{
 			EnsureUsecase: role.NewEnsureUseCase(contextFactory),
 			ListUsecase:   role.NewListUsecase(contextFactory),
+			AssignUsecase: role.NewAssignUsecase(contextFactory),
+			RevokeUsecase: role.NewRevokeUsecase(contextFactory),
+		},
+		Webhook: Webhook{
+			CreateUsecase:         webhook.NewCreateUsecase(contextFactory),
+			GetUsecase:            webhook.NewGetUsecase(contextFactory),
+			ListUsecase:           webhook.NewListUsecase(contextFactory),
+			UpdateUsecase:         webhook.NewUpdateUsecase(contextFactory),
+			DeleteUsecase:         webhook.NewDeleteUsecase(contextFactory),
+			ListDeliveriesUsecase: webhook.NewListDeliveriesUsecase(contextFactory),
+			GetDeliveryUsecase:    webhook.NewGetDeliveryUsecase(contextFactory),
+			RedeliverUsecase:      webhook.NewRedeliverUsecase(contextFactory),
+		},
+		Audit: Audit{
+			RecordUsecase:       audit.NewRecordUsecase(contextFactory),
+			ListUsecase:         audit.NewListUsecase(contextFactory),
+			ListActivityUsecase: audit.NewListActivityUsecase(contextFactory),
+			VerifyUsecase:       audit.NewVerifyUsecase(contextFactory),
+		},
+		SCIM: SCIM{
+			CreateTokenUsecase:  scim.NewCreateTokenUsecase(contextFactory),
+			ListTokensUsecase:   scim.NewListTokensUsecase(contextFactory),
+			RevokeTokenUsecase:  scim.NewRevokeTokenUsecase(contextFactory),
+			AuthenticateUsecase: scim.NewAuthenticateUsecase(contextFactory),
+			ListUsersUsecase:    scim.NewListUsersUsecase(contextFactory),
+			GetUserUsecase:      scim.NewGetUserUsecase(contextFactory),
+			CreateUserUsecase:   scim.NewCreateUserUsecase(contextFactory),
+			ReplaceUserUsecase:  scim.NewReplaceUserUsecase(contextFactory),
+			PatchUserUsecase:    scim.NewPatchUserUsecase(contextFactory),
+			DeleteUserUsecase:   scim.NewDeleteUserUsecase(contextFactory),
+			ListGroupsUsecase:   scim.NewListGroupsUsecase(contextFactory),
+			GetGroupUsecase:     scim.NewGetGroupUsecase(contextFactory),
+			CreateGroupUsecase:  scim.NewCreateGroupUsecase(contextFactory),
+			ReplaceGroupUsecase: scim.NewReplaceGroupUsecase(contextFactory),
+			PatchGroupUsecase:   scim.NewPatchGroupUsecase(contextFactory),
+			DeleteGroupUsecase:  scim.NewDeleteGroupUsecase(contextFactory),
 		},
 	}
 }
@@ -0,0 +1,18 @@
+package user
+
+import (
+	"context"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+// recordAudit appends event in its own transaction, for flows such as login
+// that do not otherwise write to the database.
+func recordAudit(ctx context.Context, app *appcontext.Context, event domain.AuditEvent) error {
+	return app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		return audit_repo.Record(ctx, repos.Audit, event)
+	})
+}
@@ -0,0 +1,71 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	CancelDeletionUsecase interface {
+		Execute(context.Context, string) error
+	}
+
+	cancelDeletionUsecase struct {
+		contextFactory appcontext.Factory
+	}
+)
+
+func NewCancelDeletionUsecase(contextFactory appcontext.Factory) CancelDeletionUsecase {
+	return &cancelDeletionUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *cancelDeletionUsecase) Execute(ctx context.Context, username string) error {
+	app := u.contextFactory()
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Username: username,
+	})
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return errors.New("user not found")
+	}
+
+	return app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		// The row lock waits for an erasure already in progress, which
+		// deletes the row, so a late cancel fails instead of racing it.
+		deletion, err := repos.Deletion.GetForUpdate(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		if deletion == nil {
+			return errors.New("no deletion is scheduled")
+		}
+
+		if err := repos.Deletion.Cancel(ctx, user.ID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.New("no deletion is scheduled")
+			}
+			return err
+		}
+
+		return audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionDeletionCancelled,
+			domain.UserActor(user.ID),
+			domain.UserTarget(user.ID),
+			nil,
+		))
+	})
+}
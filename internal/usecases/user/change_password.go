@@ -4,7 +4,11 @@ import (
 	"context"
 	"errors"
 
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
 	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
 	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
 )
@@ -52,7 +56,11 @@ func (u *changePasswordUsecase) Execute(ctx context.Context, input ChangePasswor
 		return errors.New("new password must be different from current password")
 	}
 
-	if err := auth.ValidatePasswordStrength(input.NewPassword); err != nil {
+	if err := auth.ValidatePassword(input.NewPassword, user.FirstName, user.LastName, user.Email); err != nil {
+		return err
+	}
+
+	if err := checkPasswordReuse(ctx, app, user, input.NewPassword); err != nil {
 		return err
 	}
 
@@ -61,5 +69,28 @@ func (u *changePasswordUsecase) Execute(ctx context.Context, input ChangePasswor
 		return err
 	}
 
-	return app.Repositories.User.ChangePassword(ctx, user.ID, string(hashedPassword))
+	return app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if err := repos.User.ChangePassword(ctx, user.ID, string(hashedPassword)); err != nil {
+			return err
+		}
+
+		if err := recordPasswordHistory(ctx, app, repos, user.ID, string(hashedPassword)); err != nil {
+			return err
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionPasswordChanged,
+			domain.UserActor(user.ID),
+			domain.UserTarget(user.ID),
+			nil,
+		)); err != nil {
+			return err
+		}
+
+		return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserPasswordChanged,
+			domain.UserActor(user.Username),
+			domain.NewUserEventData(user),
+		))
+	})
 }
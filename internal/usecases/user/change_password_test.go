@@ -7,11 +7,16 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
 	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
 	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	mock_user_password_history "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/password_history/mocks"
 	"github.com/tapiaw38/auth-api-be/internal/domain"
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
 	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
 	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
 	"go.uber.org/mock/gomock"
 )
@@ -19,9 +24,14 @@ import (
 func TestChangePasswordUsecase(t *testing.T) {
 	type fields struct {
 		repository *mock_user.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+		history    *mock_user_password_history.MockRepository
 	}
 
 	hashedPassword, _ := auth.HashedPassword("oldpassword")
+	previousPassword, _ := auth.HashedPassword("NewPassword123!")
 
 	tests := map[string]struct {
 		input       usecase.ChangePasswordInput
@@ -41,10 +51,33 @@ func TestChangePasswordUsecase(t *testing.T) {
 					Username: "testuser",
 					Password: string(hashedPassword),
 				}, nil)
+				f.history.EXPECT().ListRecent(gomock.Any(), "user-123", 5).Return(nil, nil)
 				f.repository.EXPECT().ChangePassword(gomock.Any(), "user-123", gomock.Any()).Return(nil)
+				f.history.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				f.history.EXPECT().Prune(gomock.Any(), "user-123", 5).Return(nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).Return("event-1", nil)
 			},
 			expectedErr: nil,
 		},
+		"new password matches a previous password": {
+			input: usecase.ChangePasswordInput{
+				OldPassword: "oldpassword",
+				NewPassword: "NewPassword123!",
+			},
+			username: "testuser",
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "testuser"}).Return(&domain.User{
+					ID:       "user-123",
+					Username: "testuser",
+					Password: string(hashedPassword),
+				}, nil)
+				f.history.EXPECT().ListRecent(gomock.Any(), "user-123", 5).Return([]domain.PasswordHistoryEntry{
+					{ID: "history-1", UserID: "user-123", PasswordHash: string(previousPassword)},
+				}, nil)
+			},
+			expectedErr: &auth.PasswordPolicyError{Code: auth.PasswordReused, Message: "password must not match any of your last 5 passwords"},
+		},
 		"user not found - error from repository": {
 			input:    usecase.ChangePasswordInput{},
 			username: "non-existent-user",
@@ -104,7 +137,7 @@ func TestChangePasswordUsecase(t *testing.T) {
 					Password: string(hashedPassword),
 				}, nil)
 			},
-			expectedErr: errors.New("password must be at least 8 characters long"),
+			expectedErr: &auth.PasswordPolicyError{Code: auth.PasswordTooShort, Message: "password must be at least 8 characters long"},
 		},
 		"new password missing uppercase": {
 			input: usecase.ChangePasswordInput{
@@ -119,7 +152,7 @@ func TestChangePasswordUsecase(t *testing.T) {
 					Password: string(hashedPassword),
 				}, nil)
 			},
-			expectedErr: errors.New("password must contain at least one uppercase letter"),
+			expectedErr: &auth.PasswordPolicyError{Code: auth.PasswordMissingUppercase, Message: "password must contain at least one uppercase letter"},
 		},
 		"new password missing lowercase": {
 			input: usecase.ChangePasswordInput{
@@ -134,7 +167,7 @@ func TestChangePasswordUsecase(t *testing.T) {
 					Password: string(hashedPassword),
 				}, nil)
 			},
-			expectedErr: errors.New("password must contain at least one lowercase letter"),
+			expectedErr: &auth.PasswordPolicyError{Code: auth.PasswordMissingLowercase, Message: "password must contain at least one lowercase letter"},
 		},
 		"new password missing number": {
 			input: usecase.ChangePasswordInput{
@@ -149,7 +182,7 @@ func TestChangePasswordUsecase(t *testing.T) {
 					Password: string(hashedPassword),
 				}, nil)
 			},
-			expectedErr: errors.New("password must contain at least one number"),
+			expectedErr: &auth.PasswordPolicyError{Code: auth.PasswordMissingNumber, Message: "password must contain at least one number"},
 		},
 		"new password missing special character": {
 			input: usecase.ChangePasswordInput{
@@ -164,7 +197,7 @@ func TestChangePasswordUsecase(t *testing.T) {
 					Password: string(hashedPassword),
 				}, nil)
 			},
-			expectedErr: errors.New("password must contain at least one special character (!@#$%^&*()_+-=[]{}|;:,.<>?)"),
+			expectedErr: &auth.PasswordPolicyError{Code: auth.PasswordMissingSpecial, Message: "password must contain at least one special character (!@#$%^&*()_+-=[]{}|;:,.<>?)"},
 		},
 	}
 
@@ -175,8 +208,17 @@ func TestChangePasswordUsecase(t *testing.T) {
 
 			f := fields{
 				repository: mock_user.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+				history:    mock_user_password_history.NewMockRepository(ctrl),
 			}
 
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{User: f.repository, Outbox: f.outbox, Audit: f.audit, PasswordHistory: f.history})
+				}).AnyTimes()
+
 			if tc.prepare != nil {
 				tc.prepare(&f)
 			}
@@ -184,7 +226,12 @@ func TestChangePasswordUsecase(t *testing.T) {
 			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
 				return &appcontext.Context{
 					Repositories: &repositories.Repositories{
-						User: f.repository,
+						User:            f.repository,
+						PasswordHistory: f.history,
+					},
+					Transactor: f.transactor,
+					ConfigService: &config.ConfigurationService{
+						PasswordPolicy: config.PasswordPolicyConfig{HistorySize: 5},
 					},
 				}
 			}
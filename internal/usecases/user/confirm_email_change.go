@@ -0,0 +1,119 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	user_email_change "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/email_change"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+type (
+	ConfirmEmailChangeUsecase interface {
+		Execute(context.Context, string) (string, error)
+	}
+
+	confirmEmailChangeUsecase struct {
+		contextFactory appcontext.Factory
+	}
+)
+
+func NewConfirmEmailChangeUsecase(contextFactory appcontext.Factory) ConfirmEmailChangeUsecase {
+	return &confirmEmailChangeUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+// Execute swaps the email of the account for the pending address and bumps
+// the token version, which signs out every existing session.
+func (u *confirmEmailChangeUsecase) Execute(ctx context.Context, token string) (string, error) {
+	app := u.contextFactory()
+
+	change, err := app.Repositories.EmailChange.Get(ctx, user_email_change.GetFilterOptions{
+		TokenHash: auth.HashOneTimeToken(token),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if change == nil || !auth.OneTimeTokenMatches(token, change.TokenHash) || change.ConfirmedAt != nil || time.Now().After(change.ExpiresAt) {
+		return "", errors.New("token expired or invalid")
+	}
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		ID: change.UserID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if user == nil {
+		return "", errors.New("user not found")
+	}
+
+	existingUser, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Email: change.NewEmail,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if existingUser != nil && existingUser.ID != user.ID {
+		return "", errors.New("email already in use")
+	}
+
+	oldEmail := user.Email
+
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if err := repos.EmailChange.Confirm(ctx, change.ID); err != nil {
+			return err
+		}
+
+		if err := repos.User.ChangeEmail(ctx, user.ID, change.NewEmail); err != nil {
+			return err
+		}
+
+		if err := repos.User.IncrementTokenVersion(ctx, user.ID); err != nil {
+			return err
+		}
+
+		user.Email = change.NewEmail
+		user.VerifiedEmail = true
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionEmailChanged,
+			domain.UserActor(user.ID),
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"old_email": oldEmail,
+				"new_email": change.NewEmail,
+			},
+		)); err != nil {
+			return err
+		}
+
+		return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserEmailChanged,
+			domain.UserActor(user.Username),
+			domain.NewUserEventData(user),
+		))
+	})
+	if err != nil {
+		return "", err
+	}
+
+	redirectURL := fmt.Sprintf(
+		"%s/",
+		app.ConfigService.GCPConfig.OAuth2Config.FrontendURL,
+	)
+
+	return redirectURL, nil
+}
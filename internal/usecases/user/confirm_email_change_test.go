@@ -0,0 +1,157 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	user_email_change "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/email_change"
+	mock_user_email_change "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/email_change/mocks"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestConfirmEmailChangeUsecase(t *testing.T) {
+	type fields struct {
+		repository  *mock_user.MockRepository
+		emailChange *mock_user_email_change.MockRepository
+		transactor  *mock_repositories.MockTransactor
+		outbox      *mock_outbox.MockRepository
+		audit       *mock_audit.MockRepository
+	}
+
+	pending := func() *domain.EmailChange {
+		return &domain.EmailChange{
+			ID:        "change-1",
+			UserID:    "user-123",
+			NewEmail:  "new@example.com",
+			TokenHash: auth.HashOneTimeToken("token-1"),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+	}
+
+	tests := map[string]struct {
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when the email is changed": {
+			prepare: func(f *fields) {
+				f.emailChange.EXPECT().Get(gomock.Any(), user_email_change.GetFilterOptions{TokenHash: auth.HashOneTimeToken("token-1")}).Return(pending(), nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123"}).
+					Return(&domain.User{ID: "user-123", Username: "jdoe", Email: "old@example.com"}, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Email: "new@example.com"}).Return(nil, nil)
+				f.emailChange.EXPECT().Confirm(gomock.Any(), "change-1").Return(nil)
+				f.repository.EXPECT().ChangeEmail(gomock.Any(), "user-123", "new@example.com").Return(nil)
+				f.repository.EXPECT().IncrementTokenVersion(gomock.Any(), "user-123").Return(nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, domain.AuditActionEmailChanged, event.Action)
+						assert.Equal(t, "old@example.com", event.Metadata["old_email"])
+						return 1, nil
+					},
+				)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						assert.Equal(t, string(domain.EventUserEmailChanged), message.Topic)
+						return message.ID, nil
+					},
+				)
+			},
+		},
+		"when the token does not exist": {
+			prepare: func(f *fields) {
+				f.emailChange.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectedErr: errors.New("token expired or invalid"),
+		},
+		"when the token has expired": {
+			prepare: func(f *fields) {
+				change := pending()
+				change.ExpiresAt = time.Now().Add(-time.Minute)
+				f.emailChange.EXPECT().Get(gomock.Any(), gomock.Any()).Return(change, nil)
+			},
+			expectedErr: errors.New("token expired or invalid"),
+		},
+		"when the token was already used": {
+			prepare: func(f *fields) {
+				change := pending()
+				confirmedAt := time.Now()
+				change.ConfirmedAt = &confirmedAt
+				f.emailChange.EXPECT().Get(gomock.Any(), gomock.Any()).Return(change, nil)
+			},
+			expectedErr: errors.New("token expired or invalid"),
+		},
+		"when the email was taken in the meantime": {
+			prepare: func(f *fields) {
+				f.emailChange.EXPECT().Get(gomock.Any(), gomock.Any()).Return(pending(), nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123"}).
+					Return(&domain.User{ID: "user-123"}, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Email: "new@example.com"}).
+					Return(&domain.User{ID: "user-456"}, nil)
+			},
+			expectedErr: errors.New("email already in use"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository:  mock_user.NewMockRepository(ctrl),
+				emailChange: mock_user_email_change.NewMockRepository(ctrl),
+				transactor:  mock_repositories.NewMockTransactor(ctrl),
+				outbox:      mock_outbox.NewMockRepository(ctrl),
+				audit:       mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{
+						User:        f.repository,
+						EmailChange: f.emailChange,
+						Outbox:      f.outbox,
+						Audit:       f.audit,
+					})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User:        f.repository,
+						EmailChange: f.emailChange,
+					},
+					Transactor: f.transactor,
+					ConfigService: &config.ConfigurationService{
+						GCPConfig: config.GCPConfig{
+							OAuth2Config: config.OAuth2Config{FrontendURL: "http://localhost:3000"},
+						},
+					},
+				}
+			}
+
+			uc := usecase.NewConfirmEmailChangeUsecase(contextFactory)
+			redirectURL, err := uc.Execute(context.Background(), "token-1")
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, "http://localhost:3000/", redirectURL)
+			}
+		})
+	}
+}
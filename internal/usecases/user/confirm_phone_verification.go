@@ -0,0 +1,142 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+var (
+	errPhoneCodeInvalid = errors.New("invalid verification code")
+	errPhoneCodeExpired = errors.New("verification code expired or invalid")
+	errPhoneCodeLocked  = errors.New("too many attempts, request a new code")
+)
+
+type (
+	ConfirmPhoneVerificationUsecase interface {
+		Execute(context.Context, ConfirmPhoneVerificationInput) (*ConfirmPhoneVerificationOutput, error)
+	}
+
+	confirmPhoneVerificationUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	ConfirmPhoneVerificationInput struct {
+		Code     string `json:"code"`
+		Username string `json:"-"`
+	}
+
+	ConfirmPhoneVerificationOutput struct {
+		Data UserOutputData `json:"data"`
+	}
+)
+
+func NewConfirmPhoneVerificationUsecase(contextFactory appcontext.Factory) ConfirmPhoneVerificationUsecase {
+	return &confirmPhoneVerificationUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+// Execute checks the code against the latest one sent. Each wrong guess is
+// counted, and the code stops working after phoneCodeMaxAttempts.
+func (u *confirmPhoneVerificationUsecase) Execute(ctx context.Context, input ConfirmPhoneVerificationInput) (*ConfirmPhoneVerificationOutput, error) {
+	app := u.contextFactory()
+
+	if !isPhoneCode(input.Code) {
+		return nil, errPhoneCodeInvalid
+	}
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Username: input.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	verification, err := app.Repositories.PhoneVerification.GetLatest(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if verification == nil || verification.VerifiedAt != nil || time.Now().After(verification.ExpiresAt) ||
+		user.PhoneNumber == nil || *user.PhoneNumber != verification.PhoneNumber {
+		return nil, errPhoneCodeExpired
+	}
+
+	if verification.Attempts >= phoneCodeMaxAttempts {
+		return nil, errPhoneCodeLocked
+	}
+
+	if !phoneCodeMatches(app.ConfigService.ServerConfig.JWTSecret, verification, input.Code) {
+		attempts, err := app.Repositories.PhoneVerification.IncrementAttempts(ctx, verification.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if attempts >= phoneCodeMaxAttempts {
+			return nil, errPhoneCodeLocked
+		}
+
+		return nil, errPhoneCodeInvalid
+	}
+
+	var updatedUser *domain.User
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if err := repos.PhoneVerification.MarkVerified(ctx, verification.ID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errPhoneCodeExpired
+			}
+			return err
+		}
+
+		if err := repos.User.VerifyPhone(ctx, user.ID, verification.PhoneNumber); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errPhoneCodeExpired
+			}
+			return err
+		}
+
+		updatedUser, err = repos.User.Get(ctx, user_repo.GetFilterOptions{
+			ID: user.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionPhoneVerified,
+			domain.UserActor(user.ID),
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"phone_number": maskPhoneNumber(verification.PhoneNumber),
+			},
+		)); err != nil {
+			return err
+		}
+
+		return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserPhoneVerified,
+			domain.UserActor(updatedUser.Username),
+			domain.NewUserEventData(updatedUser),
+		))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConfirmPhoneVerificationOutput{
+		Data: toUserOutputData(updatedUser),
+	}, nil
+}
@@ -0,0 +1,194 @@
+package user_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	mock_user_phone_verification "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/phone_verification/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestConfirmPhoneVerificationUsecase(t *testing.T) {
+	type fields struct {
+		repository   *mock_user.MockRepository
+		verification *mock_user_phone_verification.MockRepository
+		transactor   *mock_repositories.MockTransactor
+		outbox       *mock_outbox.MockRepository
+		audit        *mock_audit.MockRepository
+	}
+
+	phone := "+14155550123"
+	userWithPhone := &domain.User{ID: "user-123", Username: "jdoe", PhoneNumber: &phone}
+
+	pending := func(attempts int) *domain.PhoneVerification {
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte("verification-1:123456"))
+
+		return &domain.PhoneVerification{
+			ID:          "verification-1",
+			UserID:      "user-123",
+			PhoneNumber: phone,
+			CodeHash:    hex.EncodeToString(mac.Sum(nil)),
+			Attempts:    attempts,
+			ExpiresAt:   time.Now().Add(5 * time.Minute),
+		}
+	}
+
+	tests := map[string]struct {
+		code        string
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when the code is correct": {
+			code: "123456",
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(userWithPhone, nil)
+				f.verification.EXPECT().GetLatest(gomock.Any(), "user-123").Return(pending(0), nil)
+				f.verification.EXPECT().MarkVerified(gomock.Any(), "verification-1").Return(nil)
+				f.repository.EXPECT().VerifyPhone(gomock.Any(), "user-123", phone).Return(nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123"}).
+					Return(&domain.User{ID: "user-123", Username: "jdoe", PhoneNumber: &phone, VerifiedPhone: true}, nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, domain.AuditActionPhoneVerified, event.Action)
+						return 1, nil
+					},
+				)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						assert.Equal(t, string(domain.EventUserPhoneVerified), message.Topic)
+						return message.ID, nil
+					},
+				)
+			},
+		},
+		"when the code is wrong": {
+			code: "654321",
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(userWithPhone, nil)
+				f.verification.EXPECT().GetLatest(gomock.Any(), "user-123").Return(pending(1), nil)
+				f.verification.EXPECT().IncrementAttempts(gomock.Any(), "verification-1").Return(2, nil)
+			},
+			expectedErr: errors.New("invalid verification code"),
+		},
+		"when the last attempt is wrong": {
+			code: "654321",
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(userWithPhone, nil)
+				f.verification.EXPECT().GetLatest(gomock.Any(), "user-123").Return(pending(4), nil)
+				f.verification.EXPECT().IncrementAttempts(gomock.Any(), "verification-1").Return(5, nil)
+			},
+			expectedErr: errors.New("too many attempts, request a new code"),
+		},
+		"when the attempts are exhausted even with the right code": {
+			code: "123456",
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(userWithPhone, nil)
+				f.verification.EXPECT().GetLatest(gomock.Any(), "user-123").Return(pending(5), nil)
+			},
+			expectedErr: errors.New("too many attempts, request a new code"),
+		},
+		"when the code has expired": {
+			code: "123456",
+			prepare: func(f *fields) {
+				verification := pending(0)
+				verification.ExpiresAt = time.Now().Add(-time.Minute)
+				f.repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(userWithPhone, nil)
+				f.verification.EXPECT().GetLatest(gomock.Any(), "user-123").Return(verification, nil)
+			},
+			expectedErr: errors.New("verification code expired or invalid"),
+		},
+		"when the phone number changed after the code was sent": {
+			code: "123456",
+			prepare: func(f *fields) {
+				other := "+14155550999"
+				f.repository.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(&domain.User{ID: "user-123", PhoneNumber: &other}, nil)
+				f.verification.EXPECT().GetLatest(gomock.Any(), "user-123").Return(pending(0), nil)
+			},
+			expectedErr: errors.New("verification code expired or invalid"),
+		},
+		"when the code was used concurrently": {
+			code: "123456",
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(userWithPhone, nil)
+				f.verification.EXPECT().GetLatest(gomock.Any(), "user-123").Return(pending(0), nil)
+				f.verification.EXPECT().MarkVerified(gomock.Any(), "verification-1").Return(sql.ErrNoRows)
+			},
+			expectedErr: errors.New("verification code expired or invalid"),
+		},
+		"when the code is malformed": {
+			code:        "12ab",
+			prepare:     func(f *fields) {},
+			expectedErr: errors.New("invalid verification code"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository:   mock_user.NewMockRepository(ctrl),
+				verification: mock_user_phone_verification.NewMockRepository(ctrl),
+				transactor:   mock_repositories.NewMockTransactor(ctrl),
+				outbox:       mock_outbox.NewMockRepository(ctrl),
+				audit:        mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{
+						User:              f.repository,
+						PhoneVerification: f.verification,
+						Outbox:            f.outbox,
+						Audit:             f.audit,
+					})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User:              f.repository,
+						PhoneVerification: f.verification,
+					},
+					Transactor: f.transactor,
+					ConfigService: &config.ConfigurationService{
+						ServerConfig: config.ServerConfig{JWTSecret: "secret"},
+					},
+				}
+			}
+
+			uc := usecase.NewConfirmPhoneVerificationUsecase(contextFactory)
+			output, err := uc.Execute(context.Background(), usecase.ConfirmPhoneVerificationInput{
+				Code:     tc.code,
+				Username: "jdoe",
+			})
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.True(t, output.Data.VerifiedPhone)
+			}
+		})
+	}
+}
@@ -2,11 +2,20 @@ package user
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
 )
 
 type (
+	// DeleteUsecase soft-deletes an account. The row is kept but hidden
+	// from lookups, and its tokens stop working.
 	DeleteUsecase interface {
 		Execute(context.Context, string) (string, error)
 	}
@@ -25,7 +34,43 @@ func NewDeleteUsecase(contextFactory appcontext.Factory) DeleteUsecase {
 func (u *deleteUsecase) Execute(ctx context.Context, id string) (string, error) {
 	app := u.contextFactory()
 
-	err := app.Repositories.User.Delete(ctx, id)
+	err := app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		user, err := repos.User.Get(ctx, user_repo.GetFilterOptions{
+			ID: id,
+		})
+		if err != nil {
+			return err
+		}
+
+		if user == nil {
+			return errors.New("user not found")
+		}
+
+		if err := repos.User.SoftDelete(ctx, id); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.New("user not found")
+			}
+			return err
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionUserDeleted,
+			domain.SystemActor(),
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"username": user.Username,
+				"email":    user.Email,
+			},
+		)); err != nil {
+			return err
+		}
+
+		return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserDeleted,
+			domain.SystemActor(),
+			domain.NewUserEventData(user),
+		))
+	})
 	if err != nil {
 		return "", err
 	}
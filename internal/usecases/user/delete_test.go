@@ -7,7 +7,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
 	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
 	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
 	"go.uber.org/mock/gomock"
@@ -16,48 +21,65 @@ import (
 func TestDeleteUsecase(t *testing.T) {
 	type fields struct {
 		repository *mock_user.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
 	}
 
 	tests := map[string]struct {
-		userID       string
-		prepare      func(f *fields)
-		expectedID   string
-		expectedErr  error
+		userID      string
+		prepare     func(f *fields)
+		expectedID  string
+		expectedErr error
 	}{
 		"successful delete": {
 			userID: "user-123",
 			prepare: func(f *fields) {
-				f.repository.EXPECT().Delete(gomock.Any(), "user-123").Return(nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123"}).Return(&domain.User{ID: "user-123"}, nil)
+				f.repository.EXPECT().SoftDelete(gomock.Any(), "user-123").Return(nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						assert.Equal(t, domain.OutboxKindEvent, message.Kind)
+						assert.Equal(t, string(domain.EventUserDeleted), message.Topic)
+						return message.ID, nil
+					},
+				)
 			},
 			expectedID: "user-123",
 		},
 		"error - user not found": {
 			userID: "non-existent-user",
 			prepare: func(f *fields) {
-				f.repository.EXPECT().Delete(gomock.Any(), "non-existent-user").Return(errors.New("user not found"))
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "non-existent-user"}).Return(nil, nil)
 			},
 			expectedErr: errors.New("user not found"),
 		},
 		"error - database error": {
 			userID: "user-456",
 			prepare: func(f *fields) {
-				f.repository.EXPECT().Delete(gomock.Any(), "user-456").Return(errors.New("database error"))
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-456"}).Return(&domain.User{ID: "user-456"}, nil)
+				f.repository.EXPECT().SoftDelete(gomock.Any(), "user-456").Return(errors.New("database error"))
 			},
 			expectedErr: errors.New("database error"),
 		},
 		"error - user has dependencies": {
 			userID: "user-789",
 			prepare: func(f *fields) {
-				f.repository.EXPECT().Delete(gomock.Any(), "user-789").Return(errors.New("cannot delete user with existing dependencies"))
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-789"}).Return(&domain.User{ID: "user-789"}, nil)
+				f.repository.EXPECT().SoftDelete(gomock.Any(), "user-789").Return(errors.New("cannot delete user with existing dependencies"))
 			},
 			expectedErr: errors.New("cannot delete user with existing dependencies"),
 		},
-		"successful delete - empty id should still call repository": {
-			userID: "",
+		"error - event cannot be recorded": {
+			userID: "user-123",
 			prepare: func(f *fields) {
-				f.repository.EXPECT().Delete(gomock.Any(), "").Return(errors.New("invalid user id"))
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123"}).Return(&domain.User{ID: "user-123"}, nil)
+				f.repository.EXPECT().SoftDelete(gomock.Any(), "user-123").Return(nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).Return("", errors.New("outbox error"))
 			},
-			expectedErr: errors.New("invalid user id"),
+			expectedErr: errors.New("outbox error"),
 		},
 	}
 
@@ -68,8 +90,16 @@ func TestDeleteUsecase(t *testing.T) {
 
 			f := fields{
 				repository: mock_user.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
 			}
 
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{User: f.repository, Outbox: f.outbox, Audit: f.audit})
+				}).AnyTimes()
+
 			if tc.prepare != nil {
 				tc.prepare(&f)
 			}
@@ -79,6 +109,7 @@ func TestDeleteUsecase(t *testing.T) {
 					Repositories: &repositories.Repositories{
 						User: f.repository,
 					},
+					Transactor: f.transactor,
 				}
 			}
 
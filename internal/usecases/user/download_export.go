@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+type (
+	// DownloadExportUsecase returns the archive behind a download link. The
+	// link is the only credential, so it works without a session.
+	DownloadExportUsecase interface {
+		Execute(context.Context, string) (*DownloadExportOutput, error)
+	}
+
+	downloadExportUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	DownloadExportOutput struct {
+		FileName string
+		Payload  []byte
+	}
+)
+
+func NewDownloadExportUsecase(contextFactory appcontext.Factory) DownloadExportUsecase {
+	return &downloadExportUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *downloadExportUsecase) Execute(ctx context.Context, token string) (*DownloadExportOutput, error) {
+	app := u.contextFactory()
+
+	if token == "" {
+		return nil, errors.New("export link is invalid or has expired")
+	}
+
+	export, err := app.Repositories.DataExport.GetByTokenHash(ctx, auth.HashOneTimeToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	if export == nil || !auth.OneTimeTokenMatches(token, export.TokenHash) || time.Now().After(export.ExpiresAt) {
+		return nil, errors.New("export link is invalid or has expired")
+	}
+
+	return &DownloadExportOutput{
+		FileName: "export-" + export.CreatedAt.UTC().Format("20060102T150405Z") + ".json",
+		Payload:  export.Payload,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	// EraseUsecase carries out a scheduled deletion whose grace period has
+	// ended. The account row is deleted, and every audit row that mentions
+	// the user is redacted in favour of a random pseudonym, so the log keeps
+	// its shape without pointing back to the person. Deletions that were
+	// cancelled or are not yet due are skipped.
+	EraseUsecase interface {
+		Execute(ctx context.Context, userID string) (bool, error)
+	}
+
+	eraseUsecase struct {
+		contextFactory appcontext.Factory
+	}
+)
+
+func NewEraseUsecase(contextFactory appcontext.Factory) EraseUsecase {
+	return &eraseUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *eraseUsecase) Execute(ctx context.Context, userID string) (bool, error) {
+	app := u.contextFactory()
+
+	var erased *domain.User
+	err := app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		deletion, err := repos.Deletion.GetForUpdate(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		if deletion == nil || time.Now().Before(deletion.ScheduledFor) {
+			return nil
+		}
+
+		user, err := repos.User.Get(ctx, user_repo.GetFilterOptions{
+			ID:             userID,
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		if user == nil {
+			return repos.Deletion.Cancel(ctx, userID)
+		}
+
+		pseudonym := "erased:" + uuid.NewString()
+
+		redacted, err := repos.Audit.Redact(ctx, audit_repo.RedactInput{
+			UserID:    user.ID,
+			Username:  user.Username,
+			Email:     user.Email,
+			Pseudonym: pseudonym,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := repos.User.Delete(ctx, user.ID); err != nil {
+			return err
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionUserDeleted,
+			domain.SystemActor(),
+			domain.UserTarget(pseudonym),
+			map[string]any{
+				"reason":          "scheduled_erasure",
+				"redacted_events": redacted,
+			},
+		)); err != nil {
+			return err
+		}
+
+		// The outbox outlives the user, so the event carries nothing but
+		// the ID subscribers need to drop their own copy.
+		if err := outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserDeleted,
+			domain.SystemActor(),
+			domain.UserEventData{UserID: user.ID},
+		)); err != nil {
+			return err
+		}
+
+		erased = user
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if erased == nil {
+		return false, nil
+	}
+
+	store := app.Integrations.BlobStore
+	if store != nil && erased.Picture != nil {
+		deleteAvatarObjects(ctx, store, previousAvatarKeys(store, erased.ID, *erased.Picture))
+	}
+
+	return true, nil
+}
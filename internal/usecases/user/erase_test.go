@@ -0,0 +1,156 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user_deletion "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/deletion/mocks"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEraseUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		deletion   *mock_user_deletion.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+	}
+
+	currentUser := &domain.User{
+		ID:       "user-123",
+		Username: "jdoe",
+		Email:    "john@example.com",
+	}
+
+	due := &domain.AccountDeletion{
+		UserID:       "user-123",
+		RequestedAt:  time.Now().Add(-31 * 24 * time.Hour),
+		ScheduledFor: time.Now().Add(-time.Hour),
+	}
+
+	tests := map[string]struct {
+		prepare     func(f *fields)
+		expected    bool
+		expectedErr error
+	}{
+		"when the grace period has ended": {
+			prepare: func(f *fields) {
+				var pseudonym string
+
+				f.deletion.EXPECT().GetForUpdate(gomock.Any(), "user-123").Return(due, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123", IncludeDeleted: true}).Return(currentUser, nil)
+				f.audit.EXPECT().Redact(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, input audit_repo.RedactInput) (int64, error) {
+						assert.Equal(t, "user-123", input.UserID)
+						assert.Equal(t, "jdoe", input.Username)
+						assert.Equal(t, "john@example.com", input.Email)
+						assert.True(t, strings.HasPrefix(input.Pseudonym, "erased:"))
+						pseudonym = input.Pseudonym
+						return 7, nil
+					},
+				)
+				f.repository.EXPECT().Delete(gomock.Any(), "user-123").Return(nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, domain.AuditActionUserDeleted, event.Action)
+						assert.Equal(t, domain.ActorTypeSystem, event.Actor.Type)
+						assert.Equal(t, pseudonym, event.Target.ID)
+						assert.Equal(t, int64(7), event.Metadata["redacted_events"])
+						return 8, nil
+					},
+				)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						assert.Equal(t, string(domain.EventUserDeleted), message.Topic)
+						assert.Contains(t, string(message.Payload), `"user_id":"user-123"`)
+						assert.NotContains(t, string(message.Payload), "jdoe")
+						assert.NotContains(t, string(message.Payload), "john@example.com")
+						return message.ID, nil
+					},
+				)
+			},
+			expected: true,
+		},
+		"when the deletion was cancelled": {
+			prepare: func(f *fields) {
+				f.deletion.EXPECT().GetForUpdate(gomock.Any(), "user-123").Return(nil, nil)
+			},
+		},
+		"when the deletion is not due yet": {
+			prepare: func(f *fields) {
+				f.deletion.EXPECT().GetForUpdate(gomock.Any(), "user-123").Return(&domain.AccountDeletion{
+					UserID:       "user-123",
+					ScheduledFor: time.Now().Add(time.Hour),
+				}, nil)
+			},
+		},
+		"when redacting the audit log fails": {
+			prepare: func(f *fields) {
+				f.deletion.EXPECT().GetForUpdate(gomock.Any(), "user-123").Return(due, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123", IncludeDeleted: true}).Return(currentUser, nil)
+				f.audit.EXPECT().Redact(gomock.Any(), gomock.Any()).Return(int64(0), errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				deletion:   mock_user_deletion.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{
+						User:     f.repository,
+						Deletion: f.deletion,
+						Outbox:   f.outbox,
+						Audit:    f.audit,
+					})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User:     f.repository,
+						Deletion: f.deletion,
+					},
+					Transactor:   f.transactor,
+					Integrations: &integrations.Integrations{},
+				}
+			}
+
+			uc := usecase.NewEraseUsecase(contextFactory)
+			erased, err := uc.Execute(context.Background(), "user-123")
+
+			assert.Equal(t, tc.expected, erased)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package user_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportUsersUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		transactor *mock_repositories.MockTransactor
+		audit      *mock_audit.MockRepository
+	}
+
+	admin := &domain.User{ID: "admin-1", Username: "admin"}
+	users := []*domain.User{
+		{
+			ID:        "user-1",
+			FirstName: "Jane",
+			LastName:  "Doe",
+			Email:     "jane@example.com",
+			Password:  "$2a$08$hash",
+			Roles:     []domain.Role{{Name: domain.RoleAdmin}, {Name: domain.RoleUser}},
+		},
+		{ID: "user-2", FirstName: "John", LastName: "Roe", Email: "john@example.com"},
+	}
+
+	expectAudit := func(f *fields, rows int, includePasswordHash bool) {
+		f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+				assert.Equal(t, domain.AuditActionUsersExported, event.Action)
+				assert.Equal(t, "admin-1", event.Actor.ID)
+				assert.Equal(t, rows, event.Metadata["rows"])
+				assert.Equal(t, includePasswordHash, event.Metadata["include_password_hash"])
+				return 1, nil
+			},
+		)
+	}
+
+	tests := map[string]struct {
+		input          usecase.ExportUsersInput
+		prepare        func(f *fields)
+		expectedOutput string
+		expectedErr    error
+	}{
+		"when users are exported as csv without password hashes": {
+			input: usecase.ExportUsersInput{Format: "csv", Actor: "admin"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "admin"}).Return(admin, nil)
+				f.repository.EXPECT().ListAfter(gomock.Any(), "", 500).Return(users, nil)
+				expectAudit(f, 2, false)
+			},
+			expectedOutput: "first_name,last_name,email,roles,password_hash\n" +
+				"Jane,Doe,jane@example.com,admin;user,\n" +
+				"John,Roe,john@example.com,,\n",
+		},
+		"when users are exported as ndjson with password hashes": {
+			input: usecase.ExportUsersInput{Format: "ndjson", IncludePasswordHash: true, Actor: "admin"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "admin"}).Return(admin, nil)
+				f.repository.EXPECT().ListAfter(gomock.Any(), "", 500).Return(users[:1], nil)
+				expectAudit(f, 1, true)
+			},
+			expectedOutput: `{"first_name":"Jane","last_name":"Doe","email":"jane@example.com","roles":["admin","user"],"password_hash":"$2a$08$hash"}` + "\n",
+		},
+		"when the format is not supported": {
+			input:       usecase.ExportUsersInput{Format: "xml", Actor: "admin"},
+			prepare:     func(f *fields) {},
+			expectedErr: errors.New("format must be csv or ndjson"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{User: f.repository, Audit: f.audit})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User: f.repository,
+					},
+					Transactor: f.transactor,
+				}
+			}
+
+			var output bytes.Buffer
+			uc := usecase.NewExportUsersUsecase(contextFactory)
+			err := uc.Execute(context.Background(), tc.input, &output)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedOutput, output.String())
+		})
+	}
+}
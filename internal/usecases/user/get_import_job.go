@@ -0,0 +1,41 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	GetImportJobUsecase interface {
+		Execute(context.Context, string) (*ImportJobOutput, error)
+	}
+
+	getImportJobUsecase struct {
+		contextFactory appcontext.Factory
+	}
+)
+
+func NewGetImportJobUsecase(contextFactory appcontext.Factory) GetImportJobUsecase {
+	return &getImportJobUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *getImportJobUsecase) Execute(ctx context.Context, id string) (*ImportJobOutput, error) {
+	app := u.contextFactory()
+
+	job, err := app.Repositories.ImportJob.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if job == nil {
+		return nil, errors.New("import job not found")
+	}
+
+	return &ImportJobOutput{
+		Data: toImportJobOutputData(job),
+	}, nil
+}
@@ -66,6 +66,7 @@ func TestGetUsecase_Execute(t *testing.T) {
 			expected: &usecase.GetOutput{
 				Data: usecase.UserOutputData{
 					ID:            "user-123",
+					Username:      "johndoe",
 					FirstName:     "John",
 					LastName:      "Doe",
 					Email:         "john@example.com",
@@ -78,6 +79,7 @@ func TestGetUsecase_Execute(t *testing.T) {
 					Roles: []usecase.RoleOutputData{
 						{ID: "role-1", Name: "user"},
 					},
+					CreatedAt: validDateTime,
 				},
 			},
 		},
@@ -115,6 +117,7 @@ func TestGetUsecase_Execute(t *testing.T) {
 			expected: &usecase.GetOutput{
 				Data: usecase.UserOutputData{
 					ID:            "user-456",
+					Username:      "johndoe",
 					FirstName:     "Jane",
 					LastName:      "Smith",
 					Email:         "jane@example.com",
@@ -127,6 +130,7 @@ func TestGetUsecase_Execute(t *testing.T) {
 					Roles: []usecase.RoleOutputData{
 						{ID: "role-1", Name: "admin"},
 					},
+					CreatedAt: validDateTime,
 				},
 			},
 		},
@@ -0,0 +1,208 @@
+package user
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+// importRoleSeparator joins role names inside a single CSV cell.
+const importRoleSeparator = ";"
+
+var importCSVHeader = []string{"first_name", "last_name", "email", "roles", "password_hash"}
+
+// ImportRecord is one user in an import or export file. In CSV the columns
+// are named by a header row and roles are joined with ";". In NDJSON each
+// line is one object and roles are an array.
+type ImportRecord struct {
+	FirstName    string   `json:"first_name"`
+	LastName     string   `json:"last_name"`
+	Email        string   `json:"email"`
+	Roles        []string `json:"roles,omitempty"`
+	PasswordHash string   `json:"password_hash,omitempty"`
+}
+
+func parseImportFormat(format string) (domain.UserImportFormat, error) {
+	switch domain.UserImportFormat(strings.ToLower(format)) {
+	case domain.UserImportFormatCSV:
+		return domain.UserImportFormatCSV, nil
+	case domain.UserImportFormatNDJSON:
+		return domain.UserImportFormatNDJSON, nil
+	}
+
+	return "", errors.New("format must be csv or ndjson")
+}
+
+// decodeImportRecords calls fn for every record in payload with its 1-based
+// row number. A record that cannot be decoded is passed as a row error
+// instead of stopping the file, but a CSV without a usable header is
+// rejected as a whole.
+func decodeImportRecords(format domain.UserImportFormat, payload []byte, fn func(row int, record ImportRecord, err error) error) error {
+	switch format {
+	case domain.UserImportFormatCSV:
+		return decodeImportCSV(payload, fn)
+	case domain.UserImportFormatNDJSON:
+		return decodeImportNDJSON(payload, fn)
+	}
+
+	return errors.New("format must be csv or ndjson")
+}
+
+func decodeImportCSV(payload []byte, fn func(int, ImportRecord, error) error) error {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(payload, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return errors.New("file is empty")
+		}
+		return fmt.Errorf("invalid csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, required := range []string{"first_name", "last_name", "email"} {
+		if _, ok := columns[required]; !ok {
+			return fmt.Errorf("csv header is missing the %s column", required)
+		}
+	}
+
+	cell := func(fields []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(fields) {
+			return ""
+		}
+		return strings.TrimSpace(fields[i])
+	}
+
+	for row := 1; ; row++ {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return err
+			}
+			if err := fn(row, ImportRecord{}, errors.New("malformed csv row")); err != nil {
+				return err
+			}
+			continue
+		}
+
+		record := ImportRecord{
+			FirstName:    cell(fields, "first_name"),
+			LastName:     cell(fields, "last_name"),
+			Email:        cell(fields, "email"),
+			PasswordHash: cell(fields, "password_hash"),
+		}
+
+		for _, role := range strings.Split(cell(fields, "roles"), importRoleSeparator) {
+			if role = strings.TrimSpace(role); role != "" {
+				record.Roles = append(record.Roles, role)
+			}
+		}
+
+		if err := fn(row, record, nil); err != nil {
+			return err
+		}
+	}
+}
+
+func decodeImportNDJSON(payload []byte, fn func(int, ImportRecord, error) error) error {
+	scanner := bufio.NewScanner(bytes.NewReader(payload))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	row := 0
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		row++
+
+		var record ImportRecord
+		var recordErr error
+		if err := json.Unmarshal(line, &record); err != nil {
+			recordErr = errors.New("malformed json line")
+		}
+
+		if err := fn(row, record, recordErr); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// importRecordWriter writes records in either format. CSV output begins
+// with the same header the importer expects.
+type importRecordWriter struct {
+	format  domain.UserImportFormat
+	csv     *csv.Writer
+	json    *json.Encoder
+	started bool
+}
+
+func newImportRecordWriter(format domain.UserImportFormat, w io.Writer) *importRecordWriter {
+	writer := &importRecordWriter{format: format}
+	if format == domain.UserImportFormatCSV {
+		writer.csv = csv.NewWriter(w)
+	} else {
+		writer.json = json.NewEncoder(w)
+	}
+
+	return writer
+}
+
+func (w *importRecordWriter) Write(record ImportRecord) error {
+	if w.format == domain.UserImportFormatNDJSON {
+		return w.json.Encode(record)
+	}
+
+	if !w.started {
+		w.started = true
+		if err := w.csv.Write(importCSVHeader); err != nil {
+			return err
+		}
+	}
+
+	return w.csv.Write([]string{
+		record.FirstName,
+		record.LastName,
+		record.Email,
+		strings.Join(record.Roles, importRoleSeparator),
+		record.PasswordHash,
+	})
+}
+
+// Flush writes buffered CSV rows, including the header for an empty export.
+func (w *importRecordWriter) Flush() error {
+	if w.format == domain.UserImportFormatNDJSON {
+		return nil
+	}
+
+	if !w.started {
+		w.started = true
+		if err := w.csv.Write(importCSVHeader); err != nil {
+			return err
+		}
+	}
+
+	w.csv.Flush()
+	return w.csv.Error()
+}
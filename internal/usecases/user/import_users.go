@@ -0,0 +1,175 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+const maxImportRows = 10000
+
+type (
+	// ImportUsersUsecase stores an uploaded file as an import job and
+	// queues it for the import worker. Only the shape of the file is
+	// checked here; rows are validated when the job runs.
+	ImportUsersUsecase interface {
+		Execute(context.Context, ImportUsersInput) (*ImportJobOutput, error)
+	}
+
+	importUsersUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	// ImportUsersInput describes an upload. Actor is the username of the
+	// admin starting the import.
+	ImportUsersInput struct {
+		Format      string
+		DryRun      bool
+		SendInvites bool
+		Payload     []byte
+		Actor       string
+	}
+
+	// ImportUsersMessage is the queue message that starts a job.
+	ImportUsersMessage struct {
+		JobID string `json:"job_id"`
+	}
+
+	ImportJobOutput struct {
+		Data ImportJobOutputData `json:"data"`
+	}
+
+	ImportJobOutputData struct {
+		ID          string                      `json:"id"`
+		Format      string                      `json:"format"`
+		DryRun      bool                        `json:"dry_run"`
+		SendInvites bool                        `json:"send_invites"`
+		Status      string                      `json:"status"`
+		TotalRows   int                         `json:"total_rows"`
+		CreatedRows int                         `json:"created_rows"`
+		SkippedRows int                         `json:"skipped_rows"`
+		FailedRows  int                         `json:"failed_rows"`
+		Errors      []domain.UserImportRowError `json:"errors"`
+		Error       *string                     `json:"error,omitempty"`
+		CreatedAt   time.Time                   `json:"created_at"`
+		StartedAt   *time.Time                  `json:"started_at"`
+		FinishedAt  *time.Time                  `json:"finished_at"`
+	}
+)
+
+func NewImportUsersUsecase(contextFactory appcontext.Factory) ImportUsersUsecase {
+	return &importUsersUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *importUsersUsecase) Execute(ctx context.Context, input ImportUsersInput) (*ImportJobOutput, error) {
+	app := u.contextFactory()
+
+	format, err := parseImportFormat(input.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := 0
+	err = decodeImportRecords(format, input.Payload, func(int, ImportRecord, error) error {
+		rows++
+		if rows > maxImportRows {
+			return fmt.Errorf("file must have at most %d rows", maxImportRows)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rows == 0 {
+		return nil, errors.New("file has no rows")
+	}
+
+	actor, _, err := audit_repo.Actor(ctx, app.Repositories.User, input.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	job := domain.UserImportJob{
+		ID:          uuid.NewString(),
+		Format:      format,
+		DryRun:      input.DryRun,
+		SendInvites: input.SendInvites && !input.DryRun,
+		Status:      domain.UserImportJobPending,
+		Payload:     input.Payload,
+		TotalRows:   rows,
+		Errors:      []domain.UserImportRowError{},
+		CreatedBy:   actor.ID,
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	message, err := outbox_repo.NewMessage(string(queue.TopicImportUsers), ImportUsersMessage{JobID: job.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if _, err := repos.ImportJob.Create(ctx, job); err != nil {
+			return err
+		}
+
+		if _, err := repos.Outbox.Create(ctx, message); err != nil {
+			return err
+		}
+
+		return audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionUsersImportStarted,
+			actor,
+			domain.AuditTarget{},
+			map[string]any{
+				"job_id":       job.ID,
+				"format":       string(job.Format),
+				"rows":         rows,
+				"dry_run":      job.DryRun,
+				"send_invites": job.SendInvites,
+			},
+		))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportJobOutput{
+		Data: toImportJobOutputData(&job),
+	}, nil
+}
+
+func toImportJobOutputData(job *domain.UserImportJob) ImportJobOutputData {
+	rowErrors := job.Errors
+	if rowErrors == nil {
+		rowErrors = []domain.UserImportRowError{}
+	}
+
+	return ImportJobOutputData{
+		ID:          job.ID,
+		Format:      string(job.Format),
+		DryRun:      job.DryRun,
+		SendInvites: job.SendInvites,
+		Status:      string(job.Status),
+		TotalRows:   job.TotalRows,
+		CreatedRows: job.CreatedRows,
+		SkippedRows: job.SkippedRows,
+		FailedRows:  job.FailedRows,
+		Errors:      rowErrors,
+		Error:       job.Error,
+		CreatedAt:   job.CreatedAt,
+		StartedAt:   job.StartedAt,
+		FinishedAt:  job.FinishedAt,
+	}
+}
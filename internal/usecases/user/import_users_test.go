@@ -0,0 +1,129 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_import_job "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/import_job/mocks"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImportUsersUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		importJob  *mock_import_job.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+	}
+
+	admin := &domain.User{ID: "admin-1", Username: "admin"}
+	payload := []byte("first_name,last_name,email\nJane,Doe,jane@example.com\nJohn,Roe,john@example.com\n")
+
+	tests := map[string]struct {
+		input       usecase.ImportUsersInput
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when the job is queued": {
+			input: usecase.ImportUsersInput{Format: "CSV", DryRun: true, SendInvites: true, Payload: payload, Actor: "admin"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "admin"}).Return(admin, nil)
+				f.importJob.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, job domain.UserImportJob) (string, error) {
+						assert.Equal(t, domain.UserImportFormatCSV, job.Format)
+						assert.True(t, job.DryRun)
+						assert.False(t, job.SendInvites)
+						assert.Equal(t, "admin-1", job.CreatedBy)
+						return job.ID, nil
+					},
+				)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						assert.Equal(t, "import_users", message.Topic)
+						assert.Contains(t, string(message.Payload), `"job_id"`)
+						return message.ID, nil
+					},
+				)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, domain.AuditActionUsersImportStarted, event.Action)
+						assert.Equal(t, 2, event.Metadata["rows"])
+						return 1, nil
+					},
+				)
+			},
+		},
+		"when the format is not supported": {
+			input:       usecase.ImportUsersInput{Format: "xlsx", Payload: payload, Actor: "admin"},
+			prepare:     func(f *fields) {},
+			expectedErr: errors.New("format must be csv or ndjson"),
+		},
+		"when the csv header lacks a required column": {
+			input:       usecase.ImportUsersInput{Format: "csv", Payload: []byte("first_name,email\nJane,jane@example.com\n"), Actor: "admin"},
+			prepare:     func(f *fields) {},
+			expectedErr: errors.New("csv header is missing the last_name column"),
+		},
+		"when the file has too many rows": {
+			input: usecase.ImportUsersInput{
+				Format:  "ndjson",
+				Payload: []byte(strings.Repeat(`{"email":"jane@example.com"}`+"\n", 10001)),
+				Actor:   "admin",
+			},
+			prepare:     func(f *fields) {},
+			expectedErr: errors.New("file must have at most 10000 rows"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				importJob:  mock_import_job.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{ImportJob: f.importJob, Outbox: f.outbox, Audit: f.audit})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User: f.repository,
+					},
+					Transactor: f.transactor,
+				}
+			}
+
+			uc := usecase.NewImportUsersUsecase(contextFactory)
+			output, err := uc.Execute(context.Background(), tc.input)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, "pending", output.Data.Status)
+				assert.Equal(t, 2, output.Data.TotalRows)
+			}
+		})
+	}
+}
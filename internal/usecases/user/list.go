@@ -2,25 +2,72 @@ package user
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
 )
 
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+)
+
+// ErrInvalidListFilter is wrapped by the errors for a sort, order, auth
+// method or cursor the listing doesn't accept.
+var ErrInvalidListFilter = errors.New("invalid list filter")
+
 type (
 	ListUsecase interface {
-		Execute(context.Context, ListFilterOptions) ([]UserOutputData, error)
+		Execute(context.Context, ListFilterOptions) (*ListOutput, error)
 	}
 
 	listUsecase struct {
 		contextFactory appcontext.Factory
 	}
 
+	// ListFilterOptions pages through users either by Offset or, for
+	// stable paging while users are added, by the Cursor a previous page
+	// returned. SortBy is name, email or created_at; Order is asc or desc
+	// and defaults to newest first for created_at and asc otherwise.
+	ListFilterOptions struct {
+		Search         string
+		Role           string
+		IsActive       *bool
+		VerifiedEmail  *bool
+		AuthMethod     string
+		CreatedFrom    time.Time
+		CreatedTo      time.Time
+		SortBy         string
+		Order          string
+		Cursor         string
+		Limit          int
+		Offset         int
+		IncludeDeleted bool
+	}
+
+	// ListOutput is the envelope shared with the audit listing, plus
+	// NextCursor, which is set when there are more users.
 	ListOutput struct {
-		Data []UserOutputData `json:"data"`
+		Data       []UserOutputData `json:"data"`
+		Total      int              `json:"total"`
+		Limit      int              `json:"limit"`
+		Offset     int              `json:"offset"`
+		NextCursor string           `json:"next_cursor,omitempty"`
 	}
 
-	ListFilterOptions user.ListFilterOptions
+	// listCursor is what Cursor encodes. The sort is kept so a cursor
+	// can't be replayed against a different order.
+	listCursor struct {
+		SortBy   user.ListSortField `json:"sort"`
+		SortDesc bool               `json:"desc,omitempty"`
+		user.ListCursor
+	}
 )
 
 func NewListUsecase(contextFactory appcontext.Factory) ListUsecase {
@@ -29,18 +76,139 @@ func NewListUsecase(contextFactory appcontext.Factory) ListUsecase {
 	}
 }
 
-func (u *listUsecase) Execute(ctx context.Context, filters ListFilterOptions) ([]UserOutputData, error) {
+func (u *listUsecase) Execute(ctx context.Context, filters ListFilterOptions) (*ListOutput, error) {
 	app := u.contextFactory()
 
-	users, err := app.Repositories.User.List(ctx, user.ListFilterOptions(filters))
+	repoFilters, err := toRepoListFilter(filters)
 	if err != nil {
 		return nil, err
 	}
 
-	outputUsers := make([]UserOutputData, 0, len(users))
+	total, err := app.Repositories.User.CountList(ctx, repoFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	// One extra user tells whether there is a next page.
+	limit := repoFilters.Limit
+	repoFilters.Limit++
+
+	users, err := app.Repositories.User.List(ctx, repoFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListOutput{
+		Data:   make([]UserOutputData, 0, min(len(users), limit)),
+		Total:  total,
+		Limit:  limit,
+		Offset: repoFilters.Offset,
+	}
+
+	if len(users) > limit {
+		users = users[:limit]
+		output.NextCursor, err = encodeListCursor(listCursor{
+			SortBy:     repoFilters.SortBy,
+			SortDesc:   repoFilters.SortDesc,
+			ListCursor: user.NewListCursor(users[len(users)-1], repoFilters.SortBy),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	for _, user := range users {
-		outputUsers = append(outputUsers, toUserOutputData(user))
+		output.Data = append(output.Data, toUserOutputData(user))
+	}
+
+	return output, nil
+}
+
+func toRepoListFilter(filters ListFilterOptions) (user.ListFilterOptions, error) {
+	sortBy := user.ListSortField(filters.SortBy)
+	if sortBy == "" {
+		sortBy = user.ListSortCreatedAt
+	}
+	if !sortBy.Valid() {
+		return user.ListFilterOptions{}, fmt.Errorf("%w: sort must be name, email or created_at", ErrInvalidListFilter)
+	}
+
+	var sortDesc bool
+	switch filters.Order {
+	case "":
+		sortDesc = sortBy == user.ListSortCreatedAt
+	case "asc":
+	case "desc":
+		sortDesc = true
+	default:
+		return user.ListFilterOptions{}, fmt.Errorf("%w: order must be asc or desc", ErrInvalidListFilter)
+	}
+
+	switch domain.AuthMethod(filters.AuthMethod) {
+	case "", domain.AuthMethodPassword, domain.AuthMethodGoogle, domain.AuthMethodHybrid:
+	default:
+		return user.ListFilterOptions{}, fmt.Errorf("%w: unknown auth method %q", ErrInvalidListFilter, filters.AuthMethod)
+	}
+
+	limit := filters.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	repoFilters := user.ListFilterOptions{
+		Search:         filters.Search,
+		IsActive:       filters.IsActive,
+		VerifiedEmail:  filters.VerifiedEmail,
+		RoleName:       filters.Role,
+		AuthMethod:     filters.AuthMethod,
+		CreatedFrom:    filters.CreatedFrom,
+		CreatedTo:      filters.CreatedTo,
+		SortBy:         sortBy,
+		SortDesc:       sortDesc,
+		Limit:          limit,
+		Offset:         max(filters.Offset, 0),
+		IncludeDeleted: filters.IncludeDeleted,
+	}
+
+	if filters.Cursor != "" {
+		cursor, err := decodeListCursor(filters.Cursor)
+		if err != nil || cursor.SortBy != sortBy || cursor.SortDesc != sortDesc {
+			return user.ListFilterOptions{}, fmt.Errorf("%w: cursor is invalid or was made for another sort", ErrInvalidListFilter)
+		}
+		repoFilters.After = &cursor.ListCursor
+		repoFilters.Offset = 0
+	}
+
+	return repoFilters, nil
+}
+
+func encodeListCursor(cursor listCursor) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeListCursor(encoded string) (listCursor, error) {
+	var cursor listCursor
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return cursor, err
+	}
+
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return cursor, err
+	}
+
+	if cursor.ID == "" {
+		return cursor, errors.New("cursor has no id")
 	}
 
-	return outputUsers, nil
+	return cursor, nil
 }
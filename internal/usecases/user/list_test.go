@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
@@ -23,12 +24,25 @@ func TestListUsecase(t *testing.T) {
 	trueValue := true
 	falseValue := false
 
+	// repoFilters is what the usecase passes for the default sort, newest
+	// first, asking for one user more than the page size.
+	repoFilters := func(filters user_repo.ListFilterOptions) user_repo.ListFilterOptions {
+		if filters.SortBy == "" {
+			filters.SortBy = user_repo.ListSortCreatedAt
+			filters.SortDesc = true
+		}
+		if filters.Limit == 0 {
+			filters.Limit = 50
+		}
+		return filters
+	}
+
 	tests := map[string]struct {
 		filters      usecase.ListFilterOptions
 		prepare      func(f *fields)
 		expectedLen  int
 		expectedErr  error
-		validateData func(t *testing.T, result []usecase.UserOutputData)
+		validateData func(t *testing.T, result *usecase.ListOutput)
 	}{
 		"successful list - all users": {
 			filters: usecase.ListFilterOptions{
@@ -52,15 +66,19 @@ func TestListUsecase(t *testing.T) {
 						IsActive:  true,
 					},
 				}
+				f.repository.EXPECT().CountList(gomock.Any(), gomock.Any()).Return(2, nil)
 				f.repository.EXPECT().List(gomock.Any(), gomock.Any()).Return(users, nil)
 			},
 			expectedLen: 2,
-			validateData: func(t *testing.T, result []usecase.UserOutputData) {
-				assert.Equal(t, "user-1", result[0].ID)
-				assert.Equal(t, "John", result[0].FirstName)
-				assert.Equal(t, "john@example.com", result[0].Email)
-				assert.Equal(t, "user-2", result[1].ID)
-				assert.Equal(t, "Jane", result[1].FirstName)
+			validateData: func(t *testing.T, result *usecase.ListOutput) {
+				assert.Equal(t, "user-1", result.Data[0].ID)
+				assert.Equal(t, "John", result.Data[0].FirstName)
+				assert.Equal(t, "john@example.com", result.Data[0].Email)
+				assert.Equal(t, "user-2", result.Data[1].ID)
+				assert.Equal(t, "Jane", result.Data[1].FirstName)
+				assert.Equal(t, 2, result.Total)
+				assert.Equal(t, 10, result.Limit)
+				assert.Empty(t, result.NextCursor)
 			},
 		},
 		"successful list - filter by active users": {
@@ -79,16 +97,19 @@ func TestListUsecase(t *testing.T) {
 						IsActive:  true,
 					},
 				}
-				f.repository.EXPECT().List(gomock.Any(), user_repo.ListFilterOptions{
+				f.repository.EXPECT().CountList(gomock.Any(), repoFilters(user_repo.ListFilterOptions{
 					IsActive: &trueValue,
 					Limit:    10,
-					Offset:   0,
-				}).Return(users, nil)
+				})).Return(1, nil)
+				f.repository.EXPECT().List(gomock.Any(), repoFilters(user_repo.ListFilterOptions{
+					IsActive: &trueValue,
+					Limit:    11,
+				})).Return(users, nil)
 			},
 			expectedLen: 1,
-			validateData: func(t *testing.T, result []usecase.UserOutputData) {
-				assert.Equal(t, "user-1", result[0].ID)
-				assert.True(t, result[0].IsActive)
+			validateData: func(t *testing.T, result *usecase.ListOutput) {
+				assert.Equal(t, "user-1", result.Data[0].ID)
+				assert.True(t, result.Data[0].IsActive)
 			},
 		},
 		"successful list - filter by inactive users": {
@@ -107,16 +128,16 @@ func TestListUsecase(t *testing.T) {
 						IsActive:  false,
 					},
 				}
-				f.repository.EXPECT().List(gomock.Any(), user_repo.ListFilterOptions{
+				f.repository.EXPECT().CountList(gomock.Any(), gomock.Any()).Return(1, nil)
+				f.repository.EXPECT().List(gomock.Any(), repoFilters(user_repo.ListFilterOptions{
 					IsActive: &falseValue,
-					Limit:    10,
-					Offset:   0,
-				}).Return(users, nil)
+					Limit:    11,
+				})).Return(users, nil)
 			},
 			expectedLen: 1,
-			validateData: func(t *testing.T, result []usecase.UserOutputData) {
-				assert.Equal(t, "user-3", result[0].ID)
-				assert.False(t, result[0].IsActive)
+			validateData: func(t *testing.T, result *usecase.ListOutput) {
+				assert.Equal(t, "user-3", result.Data[0].ID)
+				assert.False(t, result.Data[0].IsActive)
 			},
 		},
 		"successful list - filter by verified email": {
@@ -135,21 +156,21 @@ func TestListUsecase(t *testing.T) {
 						VerifiedEmail: true,
 					},
 				}
-				f.repository.EXPECT().List(gomock.Any(), user_repo.ListFilterOptions{
+				f.repository.EXPECT().CountList(gomock.Any(), gomock.Any()).Return(1, nil)
+				f.repository.EXPECT().List(gomock.Any(), repoFilters(user_repo.ListFilterOptions{
 					VerifiedEmail: &trueValue,
-					Limit:         10,
-					Offset:        0,
-				}).Return(users, nil)
+					Limit:         11,
+				})).Return(users, nil)
 			},
 			expectedLen: 1,
-			validateData: func(t *testing.T, result []usecase.UserOutputData) {
-				assert.Equal(t, "user-4", result[0].ID)
-				assert.True(t, result[0].VerifiedEmail)
+			validateData: func(t *testing.T, result *usecase.ListOutput) {
+				assert.Equal(t, "user-4", result.Data[0].ID)
+				assert.True(t, result.Data[0].VerifiedEmail)
 			},
 		},
 		"successful list - filter by role": {
 			filters: usecase.ListFilterOptions{
-				RoleID: "role-123",
+				Role:   "admin",
 				Limit:  10,
 				Offset: 0,
 			},
@@ -165,55 +186,86 @@ func TestListUsecase(t *testing.T) {
 						},
 					},
 				}
-				f.repository.EXPECT().List(gomock.Any(), user_repo.ListFilterOptions{
-					RoleID: "role-123",
-					Limit:  10,
-					Offset: 0,
-				}).Return(users, nil)
+				f.repository.EXPECT().CountList(gomock.Any(), gomock.Any()).Return(1, nil)
+				f.repository.EXPECT().List(gomock.Any(), repoFilters(user_repo.ListFilterOptions{
+					RoleName: "admin",
+					Limit:    11,
+				})).Return(users, nil)
 			},
 			expectedLen: 1,
-			validateData: func(t *testing.T, result []usecase.UserOutputData) {
-				assert.Equal(t, "user-5", result[0].ID)
-				assert.Len(t, result[0].Roles, 1)
-				assert.Equal(t, "role-123", result[0].Roles[0].ID)
+			validateData: func(t *testing.T, result *usecase.ListOutput) {
+				assert.Equal(t, "user-5", result.Data[0].ID)
+				assert.Len(t, result.Data[0].Roles, 1)
+				assert.Equal(t, "role-123", result.Data[0].Roles[0].ID)
 			},
 		},
+		"successful list - search, auth method and created range": {
+			filters: usecase.ListFilterOptions{
+				Search:      "jane",
+				AuthMethod:  "google",
+				CreatedFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				CreatedTo:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				SortBy:      "name",
+			},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().CountList(gomock.Any(), gomock.Any()).Return(0, nil)
+				f.repository.EXPECT().List(gomock.Any(), user_repo.ListFilterOptions{
+					Search:      "jane",
+					AuthMethod:  "google",
+					CreatedFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					CreatedTo:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					SortBy:      user_repo.ListSortName,
+					Limit:       51,
+				}).Return(nil, nil)
+			},
+			expectedLen: 0,
+		},
 		"successful list - empty result": {
 			filters: usecase.ListFilterOptions{
 				Limit:  10,
 				Offset: 0,
 			},
 			prepare: func(f *fields) {
+				f.repository.EXPECT().CountList(gomock.Any(), gomock.Any()).Return(0, nil)
 				f.repository.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*domain.User{}, nil)
 			},
 			expectedLen: 0,
 		},
 		"successful list - with pagination": {
 			filters: usecase.ListFilterOptions{
-				Limit:  5,
+				Limit:  2,
 				Offset: 10,
 			},
 			prepare: func(f *fields) {
 				users := []*domain.User{
-					{
-						ID:        "user-11",
-						FirstName: "Page2",
-						LastName:  "User1",
-						Email:     "page2user1@example.com",
-					},
-					{
-						ID:        "user-12",
-						FirstName: "Page2",
-						LastName:  "User2",
-						Email:     "page2user2@example.com",
-					},
+					{ID: "user-11", Email: "page2user1@example.com"},
+					{ID: "user-12", Email: "page2user2@example.com", CreatedAt: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+					{ID: "user-13", Email: "page2user3@example.com"},
 				}
-				f.repository.EXPECT().List(gomock.Any(), user_repo.ListFilterOptions{
-					Limit:  5,
+				f.repository.EXPECT().CountList(gomock.Any(), gomock.Any()).Return(20, nil)
+				f.repository.EXPECT().List(gomock.Any(), repoFilters(user_repo.ListFilterOptions{
+					Limit:  3,
 					Offset: 10,
-				}).Return(users, nil)
+				})).Return(users, nil)
 			},
 			expectedLen: 2,
+			validateData: func(t *testing.T, result *usecase.ListOutput) {
+				assert.Equal(t, 20, result.Total)
+				assert.Equal(t, 10, result.Offset)
+				assert.NotEmpty(t, result.NextCursor)
+			},
+		},
+		"error - invalid sort": {
+			filters: usecase.ListFilterOptions{
+				SortBy: "password",
+			},
+			expectedErr: errors.New("invalid list filter: sort must be name, email or created_at"),
+		},
+		"error - invalid cursor": {
+			filters: usecase.ListFilterOptions{
+				Cursor: "not-a-cursor",
+			},
+			expectedErr: errors.New("invalid list filter: cursor is invalid or was made for another sort"),
 		},
 		"error - repository returns error": {
 			filters: usecase.ListFilterOptions{
@@ -221,6 +273,7 @@ func TestListUsecase(t *testing.T) {
 				Offset: 0,
 			},
 			prepare: func(f *fields) {
+				f.repository.EXPECT().CountList(gomock.Any(), gomock.Any()).Return(0, nil)
 				f.repository.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			expectedErr: errors.New("database error"),
@@ -257,7 +310,7 @@ func TestListUsecase(t *testing.T) {
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, actualErr)
-				assert.Len(t, result, tc.expectedLen)
+				assert.Len(t, result.Data, tc.expectedLen)
 				if tc.validateData != nil {
 					tc.validateData(t, result)
 				}
@@ -265,3 +318,42 @@ func TestListUsecase(t *testing.T) {
 		})
 	}
 }
+
+func TestListUsecase_CursorContinuesPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock_user.NewMockRepository(ctrl)
+	contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+		return &appcontext.Context{
+			Repositories: &repositories.Repositories{User: repository},
+		}
+	}
+	uc := usecase.NewListUsecase(contextFactory)
+
+	repository.EXPECT().CountList(gomock.Any(), gomock.Any()).Return(3, nil).Times(2)
+	repository.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*domain.User{
+		{ID: "user-1", FirstName: "Ann", LastName: "Lee"},
+		{ID: "user-2", FirstName: "Bob", LastName: "Ray"},
+	}, nil)
+
+	first, err := uc.Execute(context.Background(), usecase.ListFilterOptions{SortBy: "name", Limit: 1, Offset: 5})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, first.NextCursor)
+
+	repository.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, filters user_repo.ListFilterOptions) ([]*domain.User, error) {
+			assert.Equal(t, &user_repo.ListCursor{ID: "user-1", FirstName: "Ann", LastName: "Lee"}, filters.After)
+			assert.Zero(t, filters.Offset)
+			return []*domain.User{{ID: "user-2"}}, nil
+		},
+	)
+
+	second, err := uc.Execute(context.Background(), usecase.ListFilterOptions{SortBy: "name", Limit: 1, Offset: 5, Cursor: first.NextCursor})
+	assert.NoError(t, err)
+	assert.Equal(t, "user-2", second.Data[0].ID)
+	assert.Empty(t, second.NextCursor)
+
+	_, err = uc.Execute(context.Background(), usecase.ListFilterOptions{SortBy: "email", Cursor: first.NextCursor})
+	assert.ErrorIs(t, err, usecase.ErrInvalidListFilter)
+}
@@ -3,9 +3,13 @@ package user
 import (
 	"context"
 	"errors"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
 	role_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role"
 	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
 	"github.com/tapiaw38/auth-api-be/internal/domain"
@@ -14,6 +18,10 @@ import (
 	"github.com/tapiaw38/auth-api-be/internal/platform/utils"
 )
 
+// sessionTokenTTL is how long a token issued at login or
+// reauthentication stays valid.
+const sessionTokenTTL = time.Hour * 24 * 7
+
 type (
 	LoginUsecase interface {
 		Execute(context.Context, LoginInput) (*LoginOutput, error)
@@ -23,9 +31,12 @@ type (
 		contextFactory appcontext.Factory
 	}
 
+	// LoginOutput carries a token restricted to changing the password
+	// when PasswordChangeRequired is set.
 	LoginOutput struct {
-		Data  UserOutputData `json:"data"`
-		Token string         `json:"token"`
+		Data                   UserOutputData `json:"data"`
+		Token                  string         `json:"token"`
+		PasswordChangeRequired bool           `json:"password_change_required,omitempty"`
 	}
 
 	LoginInput struct {
@@ -45,6 +56,106 @@ func NewLoginUsecase(contextFactory appcontext.Factory) LoginUsecase {
 func (u *loginUsecase) Execute(ctx context.Context, input LoginInput) (*LoginOutput, error) {
 	app := u.contextFactory()
 
+	user, err := authenticate(ctx, app, input)
+	if err != nil {
+		if auditErr := recordAudit(ctx, app, domain.NewAuditEvent(
+			domain.AuditActionLoginFailed,
+			domain.AnonymousActor(),
+			domain.AuditTarget{},
+			map[string]any{
+				"email":    input.Email,
+				"sso_type": input.SsoType,
+				"reason":   err.Error(),
+			},
+		)); auditErr != nil {
+			log.Printf("failed to record failed login: %v", auditErr)
+		}
+
+		return nil, err
+	}
+
+	var expired bool
+	if input.SsoType != string(domain.SsoTypeGoogle) {
+		expired, err = passwordExpired(ctx, app, user)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	authn := auth.Authentication{
+		Time:    time.Now(),
+		Methods: []string{authenticationMethod(input.SsoType)},
+	}
+
+	var token string
+	if expired {
+		token, err = auth.GeneratePasswordChangeToken(user, authn, 15*time.Minute)
+	} else {
+		token, err = auth.GenerateToken(user, authn, sessionTokenTTL)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Device tracking is best effort: a failure is logged and the login
+	// goes ahead with what was learned so far.
+	assessment, err := assessLogin(ctx, app, user)
+	if err != nil {
+		log.Printf("failed to assess login for user %s: %v", user.ID, err)
+	}
+
+	metadata := map[string]any{
+		"auth_method": user.AuthMethod,
+	}
+	if expired {
+		metadata["password_expired"] = true
+	}
+	if assessment.Located {
+		metadata["location"] = assessment.Location.String()
+	}
+	if assessment.NewDevice {
+		metadata["new_device"] = true
+	}
+
+	if err := recordAudit(ctx, app, domain.NewAuditEvent(
+		domain.AuditActionLogin,
+		domain.UserActor(user.ID),
+		domain.UserTarget(user.ID),
+		metadata,
+	)); err != nil {
+		return nil, err
+	}
+
+	if len(assessment.Flags) > 0 {
+		if err := recordAudit(ctx, app, domain.NewAuditEvent(
+			domain.AuditActionSuspiciousLogin,
+			domain.UserActor(user.ID),
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"flags":    assessment.Flags,
+				"location": assessment.Location.String(),
+			},
+		)); err != nil {
+			return nil, err
+		}
+	}
+
+	return &LoginOutput{
+		Data:                   toUserOutputData(user),
+		Token:                  token,
+		PasswordChangeRequired: expired,
+	}, nil
+}
+
+// authenticationMethod maps the login's SSO type to its amr value.
+func authenticationMethod(ssoType string) string {
+	if ssoType == string(domain.SsoTypeGoogle) {
+		return auth.AMRGoogle
+	}
+	return auth.AMRPassword
+}
+
+func authenticate(ctx context.Context, app *appcontext.Context, input LoginInput) (*domain.User, error) {
 	var findUser *string
 	if input.SsoType == string(domain.SsoTypeGoogle) {
 		userID, err := googleLogin(ctx, app, input)
@@ -86,15 +197,7 @@ func (u *loginUsecase) Execute(ctx context.Context, input LoginInput) (*LoginOut
 		return nil, errors.New("user not found")
 	}
 
-	token, err := auth.GenerateToken(user, time.Hour*24*7)
-	if err != nil {
-		return nil, err
-	}
-
-	return &LoginOutput{
-		Data:  toUserOutputData(user),
-		Token: token,
-	}, nil
+	return user, nil
 }
 
 func googleLogin(ctx context.Context, app *appcontext.Context, input LoginInput) (*string, error) {
@@ -108,8 +211,11 @@ func googleLogin(ctx context.Context, app *appcontext.Context, input LoginInput)
 		return nil, err
 	}
 
+	// Deleted accounts are looked up too, so their email cannot be
+	// registered again through SSO.
 	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
-		Email: userInfo.Email,
+		Email:          userInfo.Email,
+		IncludeDeleted: true,
 	})
 	if err != nil {
 		return nil, err
@@ -121,7 +227,9 @@ func googleLogin(ctx context.Context, app *appcontext.Context, input LoginInput)
 			return nil, err
 		}
 
-		encodedString, err := utils.GetEncodedString()
+		// The verification link is never sent, as Google already
+		// verified the address, but the column is unique.
+		verificationToken, err := auth.NewOneTimeToken()
 		if err != nil {
 			return nil, err
 		}
@@ -136,17 +244,12 @@ func googleLogin(ctx context.Context, app *appcontext.Context, input LoginInput)
 			Picture:                  utils.ToPointer(userInfo.Picture),
 			IsActive:                 true,
 			VerifiedEmail:            userInfo.VerifiedEmail,
-			VerifiedEmailToken:       encodedString,
+			VerifiedEmailToken:       verificationToken.Hash,
 			VerifiedEmailTokenExpiry: time.Now().Add(time.Hour * 24 * 7),
 			AuthMethod:               string(domain.AuthMethodGoogle),
 			CreatedAt:                time.Now(),
 		}
 
-		createdUserID, err := app.Repositories.User.Create(ctx, userInsert)
-		if err != nil {
-			return nil, err
-		}
-
 		defaultRole, err := app.Repositories.Role.Get(ctx, role_repo.GetFilterOptions{
 			Name: string(domain.RoleUser),
 		})
@@ -154,16 +257,65 @@ func googleLogin(ctx context.Context, app *appcontext.Context, input LoginInput)
 			return nil, err
 		}
 
-		if _, err = app.Repositories.UserRole.Create(ctx, domain.UserRole{
-			UserID: createdUserID,
-			RoleID: defaultRole.ID,
-		}); err != nil {
+		var createdUserID string
+		err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+			createdUserID, err = repos.User.Create(ctx, userInsert)
+			if err != nil {
+				return err
+			}
+
+			if _, err = repos.UserRole.Create(ctx, domain.UserRole{
+				UserID: createdUserID,
+				RoleID: defaultRole.ID,
+			}); err != nil {
+				return err
+			}
+
+			userInsert.ID = createdUserID
+			actor := domain.UserActor(userInsert.Username)
+
+			if err := outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+				domain.EventUserRegistered,
+				actor,
+				domain.NewUserEventData(&userInsert),
+			)); err != nil {
+				return err
+			}
+
+			if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+				domain.AuditActionUserRegistered,
+				domain.UserActor(createdUserID),
+				domain.UserTarget(createdUserID),
+				map[string]any{
+					"auth_method": userInsert.AuthMethod,
+				},
+			)); err != nil {
+				return err
+			}
+
+			return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+				domain.EventUserRoleAssigned,
+				actor,
+				domain.UserRoleEventData{
+					UserID:   createdUserID,
+					RoleID:   defaultRole.ID,
+					RoleName: defaultRole.Name,
+				},
+			))
+		})
+		if err != nil {
 			return nil, err
 		}
 
 		return &createdUserID, nil
 	}
 
+	if user.DeletedAt != nil {
+		return nil, errors.New("user is not active")
+	}
+	if err := liftExpiredSuspension(ctx, app, user); err != nil {
+		return nil, err
+	}
 	if !user.IsActive {
 		return nil, errors.New("user is not active")
 	}
@@ -174,7 +326,10 @@ func googleLogin(ctx context.Context, app *appcontext.Context, input LoginInput)
 		user.Picture = utils.ToPointer(userInfo.Picture)
 	}
 
-	updatedUserID, err := app.Repositories.User.Update(ctx, user.ID, user)
+	updatedUserID, err := app.Repositories.User.Update(ctx, user.ID, user_repo.UpdateFields{
+		VerifiedEmail: &user.VerifiedEmail,
+		Picture:       user.Picture,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -190,6 +345,14 @@ func emailAndPasswordLogin(ctx context.Context, app *appcontext.Context, input L
 		return nil, err
 	}
 
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	if err := liftExpiredSuspension(ctx, app, user); err != nil {
+		return nil, err
+	}
+
 	if !user.IsActive {
 		return nil, errors.New("user is not active")
 	}
@@ -207,5 +370,26 @@ func emailAndPasswordLogin(ctx context.Context, app *appcontext.Context, input L
 		return nil, err
 	}
 
+	if auth.NeedsRehash(user.Password) {
+		rehashPassword(ctx, app, user, input.Password)
+	}
+
 	return &user.ID, nil
 }
+
+// rehashPassword upgrades a bcrypt or weak argon2id hash while the plain
+// password is at hand. A failure is logged and the login goes ahead.
+func rehashPassword(ctx context.Context, app *appcontext.Context, user *domain.User, password string) {
+	hashedPassword, err := auth.HashedPassword(password)
+	if err != nil {
+		log.Printf("failed to rehash password for user %s: %v", user.ID, err)
+		return
+	}
+
+	if err := app.Repositories.User.ChangePassword(ctx, user.ID, string(hashedPassword)); err != nil {
+		log.Printf("failed to store rehashed password for user %s: %v", user.ID, err)
+		return
+	}
+
+	user.Password = string(hashedPassword)
+}
@@ -0,0 +1,211 @@
+package user
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/notification"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+	"github.com/tapiaw38/auth-api-be/internal/platform/geoip"
+	"github.com/tapiaw38/auth-api-be/internal/platform/requestinfo"
+)
+
+// newDeviceRevokeTokenTTL is how long the "this wasn't me" link in a new
+// device email keeps working.
+const newDeviceRevokeTokenTTL = time.Hour * 24 * 7
+
+// impossibleTravelMinDistanceKm ignores jumps shorter than this, which
+// GeoIP inaccuracy alone can explain.
+const impossibleTravelMinDistanceKm = 500
+
+// Flags recorded on suspicious logins.
+const (
+	loginFlagBlockedIP        = "blocked_ip"
+	loginFlagImpossibleTravel = "impossible_travel"
+)
+
+// loginAssessment is what assessLogin learned about a successful login.
+type loginAssessment struct {
+	Location  geoip.Location
+	Located   bool
+	NewDevice bool
+	Flags     []string
+}
+
+// assessLogin fingerprints the device the user logged in from, remembers
+// it, and emails the user when it is new to an account that already has
+// devices. It flags logins from blocked IPs and logins too far from the
+// previous one for the time elapsed.
+func assessLogin(ctx context.Context, app *appcontext.Context, user *domain.User) (*loginAssessment, error) {
+	cfg := app.ConfigService.LoginSecurity
+	info := requestinfo.FromContext(ctx)
+
+	location, located := geoip.Lookup(info.IP)
+	assessment := &loginAssessment{
+		Location: location,
+		Located:  located,
+	}
+
+	if geoip.IsBlocked(info.IP) {
+		assessment.Flags = append(assessment.Flags, loginFlagBlockedIP)
+	}
+
+	latest, err := app.Repositories.Device.GetLatest(ctx, user.ID)
+	if err != nil {
+		return assessment, err
+	}
+
+	if latest != nil && located && impossibleTravel(latest, location, cfg.MaxTravelSpeedKmh) {
+		assessment.Flags = append(assessment.Flags, loginFlagImpossibleTravel)
+	}
+
+	device := domain.UserDevice{
+		UserID:      user.ID,
+		Fingerprint: deviceFingerprint(info.UserAgent, info.IP, location),
+		UserAgent:   info.UserAgent,
+		IP:          info.IP,
+		Country:     location.Country,
+		Region:      location.Region,
+		City:        location.City,
+	}
+	if location.HasCoordinates {
+		device.Latitude = &location.Latitude
+		device.Longitude = &location.Longitude
+	}
+
+	known, err := app.Repositories.Device.GetByFingerprint(ctx, user.ID, device.Fingerprint)
+	if err != nil {
+		return assessment, err
+	}
+
+	if known != nil {
+		device.ID = known.ID
+		return assessment, app.Repositories.Device.Touch(ctx, device)
+	}
+
+	assessment.NewDevice = true
+	device.ID = uuid.NewString()
+
+	// The first device is the one the account was created from, which the
+	// user already knows about.
+	if latest == nil || !cfg.NotifyNewDevice {
+		return assessment, app.Repositories.Device.Create(ctx, device)
+	}
+
+	token, err := auth.NewOneTimeToken()
+	if err != nil {
+		return assessment, err
+	}
+
+	expiresAt := time.Now().Add(newDeviceRevokeTokenTTL)
+	device.RevokeTokenHash = &token.Hash
+	device.RevokeTokenExpiresAt = &expiresAt
+
+	message, err := newDeviceLoginMessage(app, user, device, location, token.Plain)
+	if err != nil {
+		return assessment, err
+	}
+
+	return assessment, app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if err := repos.Device.Create(ctx, device); err != nil {
+			return err
+		}
+
+		_, err := repos.Outbox.Create(ctx, message)
+		return err
+	})
+}
+
+func newDeviceLoginMessage(app *appcontext.Context, user *domain.User, device domain.UserDevice, location geoip.Location, token string) (domain.OutboxMessage, error) {
+	userAgent := device.UserAgent
+	if userAgent == "" {
+		userAgent = "Dispositivo desconocido"
+	}
+
+	place := location.String()
+	if place == "" {
+		place = "Ubicación desconocida"
+	}
+
+	return outbox_repo.NewMessage(string(queue.TopicSendEmail), notification.SendEmailInput{
+		To:           user.Email,
+		Subject:      "Nuevo inicio de sesión en tu cuenta",
+		TemplateName: "new_device_login",
+		Variables: map[string]string{
+			"name":     user.FirstName + " " + user.LastName,
+			"device":   userAgent,
+			"ip":       device.IP,
+			"location": place,
+			"time":     time.Now().UTC().Format("02/01/2006 15:04 UTC"),
+			"link":     app.ConfigService.GCPConfig.OAuth2Config.FrontendURL + "/not-me?token=" + token,
+		},
+	})
+}
+
+// impossibleTravel reports whether getting from the previous device's
+// location to location since it was last seen needs a speed over
+// maxSpeedKmh. A maxSpeedKmh of zero disables the check.
+func impossibleTravel(previous *domain.UserDevice, location geoip.Location, maxSpeedKmh int) bool {
+	if maxSpeedKmh <= 0 || !location.HasCoordinates || previous.Latitude == nil || previous.Longitude == nil {
+		return false
+	}
+
+	distance := geoip.DistanceKm(geoip.Location{
+		Latitude:  *previous.Latitude,
+		Longitude: *previous.Longitude,
+	}, location)
+	if distance < impossibleTravelMinDistanceKm {
+		return false
+	}
+
+	hours := time.Since(previous.LastSeenAt).Hours()
+	if hours <= 0 {
+		return true
+	}
+
+	return distance/hours > float64(maxSpeedKmh)
+}
+
+// deviceFingerprint identifies a device by its user agent and where it
+// is: the region when the IP could be located, and its network otherwise,
+// so a new address from the same ISP doesn't count as a new device.
+func deviceFingerprint(userAgent, ip string, location geoip.Location) string {
+	place := location.Country + "/" + location.Region
+	if location.Country == "" {
+		place = ipNetwork(ip)
+	}
+
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(userAgent)) + "|" + place))
+	return hex.EncodeToString(sum[:])
+}
+
+// ipNetwork returns the /24 of an IPv4 address or the /48 of an IPv6 one.
+func ipNetwork(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ip
+	}
+	addr = addr.Unmap()
+
+	bits := 48
+	if addr.Is4() {
+		bits = 24
+	}
+
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return ip
+	}
+
+	return prefix.String()
+}
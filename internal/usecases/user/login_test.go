@@ -0,0 +1,225 @@
+package user_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user_device "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/device/mocks"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	"github.com/tapiaw38/auth-api-be/internal/platform/geoip"
+	"github.com/tapiaw38/auth-api-be/internal/platform/requestinfo"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLoginUsecase_DeviceTracking(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		device     *mock_user_device.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+	}
+
+	config.InitConfigService(&config.ConfigurationService{
+		ServerConfig: config.ServerConfig{JWTSecret: "secret"},
+	})
+
+	db, err := geoip.LoadDatabase(strings.NewReader(
+		"81.2.69.0,81.2.69.255,EU,GB,England,London,51.5142,-0.0931\n" +
+			"190.0.0.0,190.0.255.255,SA,AR,Buenos Aires,Buenos Aires,-34.6037,-58.3816\n",
+	))
+	assert.NoError(t, err)
+	geoip.SetDatabase(db)
+	blocklist, err := geoip.LoadBlocklist(strings.NewReader("190.0.66.0/24\n"))
+	assert.NoError(t, err)
+	geoip.SetBlocklist(blocklist)
+	t.Cleanup(func() {
+		geoip.SetDatabase(nil)
+		geoip.SetBlocklist(nil)
+	})
+
+	hash, err := auth.HashedPassword("Password123!")
+	assert.NoError(t, err)
+
+	user := &domain.User{
+		ID:         "user-123",
+		Username:   "jdoe",
+		Email:      "jane@example.com",
+		Password:   string(hash),
+		IsActive:   true,
+		AuthMethod: string(domain.AuthMethodPassword),
+	}
+
+	london := func(lastSeen time.Time) *domain.UserDevice {
+		latitude, longitude := 51.5142, -0.0931
+		return &domain.UserDevice{
+			ID:         "device-1",
+			UserID:     "user-123",
+			Latitude:   &latitude,
+			Longitude:  &longitude,
+			LastSeenAt: lastSeen,
+		}
+	}
+
+	expectLoginAudit := func(f *fields, check func(event domain.AuditEvent)) {
+		f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+				assert.Equal(t, domain.AuditActionLogin, event.Action)
+				check(event)
+				return 1, nil
+			},
+		)
+	}
+
+	tests := map[string]struct {
+		ip      string
+		prepare func(f *fields)
+	}{
+		"when the device is known": {
+			ip: "81.2.69.10",
+			prepare: func(f *fields) {
+				f.device.EXPECT().GetLatest(gomock.Any(), "user-123").Return(london(time.Now().Add(-time.Hour)), nil)
+				f.device.EXPECT().GetByFingerprint(gomock.Any(), "user-123", gomock.Any()).Return(london(time.Now().Add(-time.Hour)), nil)
+				f.device.EXPECT().Touch(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, device domain.UserDevice) error {
+						assert.Equal(t, "device-1", device.ID)
+						assert.Equal(t, "81.2.69.10", device.IP)
+						assert.Equal(t, "London", device.City)
+						return nil
+					},
+				)
+				expectLoginAudit(f, func(event domain.AuditEvent) {
+					assert.Equal(t, "London, England, GB", event.Metadata["location"])
+					assert.Nil(t, event.Metadata["new_device"])
+				})
+			},
+		},
+		"when the device is new": {
+			ip: "81.2.69.10",
+			prepare: func(f *fields) {
+				f.device.EXPECT().GetLatest(gomock.Any(), "user-123").Return(london(time.Now().Add(-time.Hour)), nil)
+				f.device.EXPECT().GetByFingerprint(gomock.Any(), "user-123", gomock.Any()).Return(nil, nil)
+				f.device.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, device domain.UserDevice) error {
+						assert.Len(t, *device.RevokeTokenHash, 64)
+						assert.True(t, device.RevokeTokenExpiresAt.After(time.Now()))
+						return nil
+					},
+				)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						payload := string(message.Payload)
+						assert.Contains(t, payload, `"new_device_login"`)
+						assert.Contains(t, payload, "https://app.example.com/not-me?token=")
+						assert.Contains(t, payload, "London, England, GB")
+						return message.ID, nil
+					},
+				)
+				expectLoginAudit(f, func(event domain.AuditEvent) {
+					assert.Equal(t, true, event.Metadata["new_device"])
+				})
+			},
+		},
+		"when it is the first device": {
+			ip: "81.2.69.10",
+			prepare: func(f *fields) {
+				f.device.EXPECT().GetLatest(gomock.Any(), "user-123").Return(nil, nil)
+				f.device.EXPECT().GetByFingerprint(gomock.Any(), "user-123", gomock.Any()).Return(nil, nil)
+				f.device.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, device domain.UserDevice) error {
+						assert.Nil(t, device.RevokeTokenHash)
+						return nil
+					},
+				)
+				expectLoginAudit(f, func(event domain.AuditEvent) {})
+			},
+		},
+		"when the login implies impossible travel from a blocked IP": {
+			ip: "190.0.66.1",
+			prepare: func(f *fields) {
+				f.device.EXPECT().GetLatest(gomock.Any(), "user-123").Return(london(time.Now().Add(-2*time.Hour)), nil)
+				f.device.EXPECT().GetByFingerprint(gomock.Any(), "user-123", gomock.Any()).Return(&domain.UserDevice{ID: "device-2"}, nil)
+				f.device.EXPECT().Touch(gomock.Any(), gomock.Any()).Return(nil)
+				expectLoginAudit(f, func(event domain.AuditEvent) {})
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, domain.AuditActionSuspiciousLogin, event.Action)
+						assert.Equal(t, []string{"blocked_ip", "impossible_travel"}, event.Metadata["flags"])
+						return 1, nil
+					},
+				)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				device:     mock_user_device.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{Device: f.device, Outbox: f.outbox, Audit: f.audit})
+				}).AnyTimes()
+
+			f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Email: "jane@example.com"}).Return(user, nil)
+			f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123"}).Return(user, nil)
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User:   f.repository,
+						Device: f.device,
+					},
+					Transactor: f.transactor,
+					ConfigService: &config.ConfigurationService{
+						GCPConfig: config.GCPConfig{
+							OAuth2Config: config.OAuth2Config{FrontendURL: "https://app.example.com"},
+						},
+						LoginSecurity: config.LoginSecurityConfig{
+							MaxTravelSpeedKmh: 1000,
+							NotifyNewDevice:   true,
+						},
+					},
+				}
+			}
+
+			ctx := requestinfo.WithInfo(context.Background(), requestinfo.Info{
+				IP:        tc.ip,
+				UserAgent: "Mozilla/5.0 (X11; Linux x86_64) Firefox/128.0",
+			})
+
+			uc := usecase.NewLoginUsecase(contextFactory)
+			output, err := uc.Execute(ctx, usecase.LoginInput{
+				Email:    "jane@example.com",
+				Password: "Password123!",
+			})
+
+			assert.NoError(t, err)
+			assert.NotEmpty(t, output.Token)
+		})
+	}
+}
@@ -1,10 +1,15 @@
 package user
 
-import "github.com/tapiaw38/auth-api-be/internal/domain"
+import (
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
 
 type (
 	UserOutputData struct {
 		ID            string           `json:"id"`
+		Username      string           `json:"username"`
 		FirstName     string           `json:"first_name"`
 		LastName      string           `json:"last_name"`
 		Email         string           `json:"email"`
@@ -13,9 +18,18 @@ type (
 		Address       *string          `json:"address"`
 		IsActive      bool             `json:"is_active"`
 		VerifiedEmail bool             `json:"verified_email"`
+		VerifiedPhone bool             `json:"verified_phone"`
 		TokenVersion  uint             `json:"token_version"`
 		AuthMethod    string           `json:"auth_method"`
 		Roles         []RoleOutputData `json:"roles"`
+		Suspension    *SuspensionData  `json:"suspension,omitempty"`
+		CreatedAt     time.Time        `json:"created_at"`
+	}
+
+	SuspensionData struct {
+		SuspendedAt time.Time  `json:"suspended_at"`
+		Until       *time.Time `json:"until"`
+		Reason      string     `json:"reason"`
 	}
 
 	RoleOutputData struct {
@@ -38,8 +52,20 @@ func toUserOutputData(user *domain.User) UserOutputData {
 		})
 	}
 
+	var suspension *SuspensionData
+	if user.SuspendedAt != nil {
+		suspension = &SuspensionData{
+			SuspendedAt: *user.SuspendedAt,
+			Until:       user.SuspendedUntil,
+		}
+		if user.SuspensionReason != nil {
+			suspension.Reason = *user.SuspensionReason
+		}
+	}
+
 	return UserOutputData{
 		ID:            user.ID,
+		Username:      user.Username,
 		FirstName:     user.FirstName,
 		LastName:      user.LastName,
 		Email:         user.Email,
@@ -48,8 +74,11 @@ func toUserOutputData(user *domain.User) UserOutputData {
 		Address:       user.Address,
 		IsActive:      user.IsActive,
 		VerifiedEmail: user.VerifiedEmail,
+		VerifiedPhone: user.VerifiedPhone,
 		TokenVersion:  user.TokenVersion,
 		AuthMethod:    user.AuthMethod,
 		Roles:         roles,
+		Suspension:    suspension,
+		CreatedAt:     user.CreatedAt,
 	}
 }
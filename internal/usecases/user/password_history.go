@@ -0,0 +1,96 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+// checkPasswordReuse rejects password when it matches the user's current
+// password or one of the last HistorySize ones. A HistorySize of zero
+// disables the check.
+func checkPasswordReuse(ctx context.Context, app *appcontext.Context, user *domain.User, password string) error {
+	size := app.ConfigService.PasswordPolicy.HistorySize
+	if size <= 0 {
+		return nil
+	}
+
+	entries, err := app.Repositories.PasswordHistory.ListRecent(ctx, user.ID, size)
+	if err != nil {
+		return err
+	}
+
+	hashes := make([]string, 0, len(entries)+1)
+	if user.Password != "" {
+		hashes = append(hashes, user.Password)
+	}
+	for _, entry := range entries {
+		hashes = append(hashes, entry.PasswordHash)
+	}
+
+	for _, hash := range hashes {
+		if auth.ComparePassword(password, hash) == nil {
+			return &auth.PasswordPolicyError{
+				Code:    auth.PasswordReused,
+				Message: fmt.Sprintf("password must not match any of your last %d passwords", size),
+			}
+		}
+	}
+
+	return nil
+}
+
+// recordPasswordHistory stores the new hash and drops entries beyond the
+// history size. The latest entry is always kept, as it dates the current
+// password for the expiry policy.
+func recordPasswordHistory(ctx context.Context, app *appcontext.Context, repos *repositories.Repositories, userID, hash string) error {
+	if err := repos.PasswordHistory.Create(ctx, domain.PasswordHistoryEntry{
+		ID:           uuid.NewString(),
+		UserID:       userID,
+		PasswordHash: hash,
+		CreatedAt:    time.Now(),
+	}); err != nil {
+		return err
+	}
+
+	return repos.PasswordHistory.Prune(ctx, userID, max(app.ConfigService.PasswordPolicy.HistorySize, 1))
+}
+
+// passwordExpired reports whether the user's password is older than the
+// strictest expiry configured for their roles. Users whose password was
+// never changed are measured from account creation.
+func passwordExpired(ctx context.Context, app *appcontext.Context, user *domain.User) (bool, error) {
+	maxAge := passwordMaxAge(app.ConfigService.PasswordPolicy.ExpiryDays, user.Roles)
+	if maxAge == 0 {
+		return false, nil
+	}
+
+	changedAt := user.CreatedAt
+	entries, err := app.Repositories.PasswordHistory.ListRecent(ctx, user.ID, 1)
+	if err != nil {
+		return false, err
+	}
+	if len(entries) > 0 {
+		changedAt = entries[0].CreatedAt
+	}
+
+	return time.Since(changedAt) > maxAge, nil
+}
+
+func passwordMaxAge(expiryDays map[string]int, roles []domain.Role) time.Duration {
+	var days int
+	for _, role := range roles {
+		roleDays := expiryDays[string(role.Name)]
+		if roleDays > 0 && (days == 0 || roleDays < days) {
+			days = roleDays
+		}
+	}
+
+	return time.Duration(days) * 24 * time.Hour
+}
@@ -0,0 +1,326 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	role_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/notification"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+// maxImportRowErrors caps how many row errors a job keeps; the counts
+// still cover every row.
+const maxImportRowErrors = 1000
+
+// errImportRowSkipped marks a row whose user already exists.
+var errImportRowSkipped = errors.New("row skipped")
+
+type (
+	// ProcessImportUsecase runs a pending import job. Each row is created
+	// in its own transaction, so a bad row is reported without undoing the
+	// rest. Rows whose email is already registered, or repeated earlier in
+	// the file, are skipped, which makes re-running the same file safe. A
+	// dry run validates every row and reports what would be created
+	// without writing any user.
+	ProcessImportUsecase interface {
+		Execute(context.Context, string) error
+	}
+
+	processImportUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	importRun struct {
+		app     *appcontext.Context
+		job     *domain.UserImportJob
+		roles   map[string]domain.Role
+		seen    map[string]bool
+		actor   domain.EventActor
+		creator domain.EventActor
+		// caller is the admin who created the job, or nil for jobs started
+		// by the system. Rows may only grant roles the caller outranks.
+		caller *domain.User
+		result domain.UserImportResult
+	}
+)
+
+func NewProcessImportUsecase(contextFactory appcontext.Factory) ProcessImportUsecase {
+	return &processImportUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *processImportUsecase) Execute(ctx context.Context, jobID string) error {
+	app := u.contextFactory()
+
+	job, err := app.Repositories.ImportJob.Get(ctx, jobID)
+	if err != nil {
+		return err
+	}
+
+	if job == nil || job.Status != domain.UserImportJobPending {
+		return nil
+	}
+
+	if err := app.Repositories.ImportJob.MarkRunning(ctx, job.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	run, err := newImportRun(ctx, app, job)
+	if err == nil {
+		err = decodeImportRecords(job.Format, job.Payload, func(row int, record ImportRecord, recordErr error) error {
+			run.process(ctx, row, record, recordErr)
+			return nil
+		})
+	}
+
+	if err != nil {
+		message := err.Error()
+		run.result.Error = &message
+	}
+
+	return app.Repositories.ImportJob.Finish(ctx, job.ID, run.result)
+}
+
+func newImportRun(ctx context.Context, app *appcontext.Context, job *domain.UserImportJob) (*importRun, error) {
+	run := &importRun{
+		app:     app,
+		job:     job,
+		roles:   map[string]domain.Role{},
+		seen:    map[string]bool{},
+		actor:   domain.SystemActor(),
+		creator: domain.SystemActor(),
+	}
+
+	if job.CreatedBy != "" {
+		run.creator = domain.UserActor(job.CreatedBy)
+
+		admin, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+			ID:             job.CreatedBy,
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return run, err
+		}
+		if admin == nil {
+			// An admin who is gone entirely keeps no privileges.
+			admin = &domain.User{ID: job.CreatedBy}
+		} else {
+			run.actor = domain.UserActor(admin.Username)
+		}
+		run.caller = admin
+	}
+
+	roles, err := app.Repositories.Role.List(ctx, role_repo.ListFilterOptions{})
+	if err != nil {
+		return run, err
+	}
+
+	for _, role := range roles {
+		run.roles[strings.ToLower(string(role.Name))] = role
+	}
+
+	return run, nil
+}
+
+func (r *importRun) process(ctx context.Context, row int, record ImportRecord, recordErr error) {
+	r.result.TotalRows++
+
+	if recordErr == nil {
+		recordErr = r.apply(ctx, record)
+	}
+
+	if errors.Is(recordErr, errImportRowSkipped) {
+		r.result.SkippedRows++
+		return
+	}
+
+	if recordErr != nil {
+		r.result.FailedRows++
+		if len(r.result.Errors) < maxImportRowErrors {
+			r.result.Errors = append(r.result.Errors, domain.UserImportRowError{
+				Row:   row,
+				Email: record.Email,
+				Error: recordErr.Error(),
+			})
+		}
+		return
+	}
+
+	r.result.CreatedRows++
+}
+
+func (r *importRun) apply(ctx context.Context, record ImportRecord) error {
+	record.FirstName = strings.TrimSpace(record.FirstName)
+	record.LastName = strings.TrimSpace(record.LastName)
+	record.Email = strings.TrimSpace(record.Email)
+
+	if record.FirstName == "" {
+		return errors.New("first name is required")
+	}
+	if record.LastName == "" {
+		return errors.New("last name is required")
+	}
+	if err := auth.ValidateEmail(record.Email); err != nil {
+		return err
+	}
+	if record.PasswordHash != "" {
+		if err := auth.ValidatePasswordHash(record.PasswordHash); err != nil {
+			return err
+		}
+	}
+
+	roles, err := r.resolveRoles(record.Roles)
+	if err != nil {
+		return err
+	}
+
+	key := strings.ToLower(record.Email)
+	if r.seen[key] {
+		return errImportRowSkipped
+	}
+
+	existing, err := r.app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Email:          record.Email,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		return errImportRowSkipped
+	}
+
+	if !r.job.DryRun {
+		if err := r.create(ctx, record, roles); err != nil {
+			return err
+		}
+	}
+
+	r.seen[key] = true
+	return nil
+}
+
+func (r *importRun) resolveRoles(names []string) ([]domain.Role, error) {
+	if len(names) == 0 {
+		names = []string{string(domain.RoleUser)}
+	}
+
+	var roles []domain.Role
+	added := map[string]bool{}
+	for _, name := range names {
+		role, ok := r.roles[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("role %q does not exist", name)
+		}
+
+		if r.caller != nil && !domain.Outranks(r.caller.Roles, role.Name.Rank()) {
+			return nil, fmt.Errorf("role %q: %w", name, domain.ErrInsufficientRole)
+		}
+
+		if !added[role.ID] {
+			added[role.ID] = true
+			roles = append(roles, role)
+		}
+	}
+
+	return roles, nil
+}
+
+func (r *importRun) create(ctx context.Context, record ImportRecord, roles []domain.Role) error {
+	user := domain.User{
+		ID:         uuid.NewString(),
+		FirstName:  record.FirstName,
+		LastName:   record.LastName,
+		Username:   auth.GenerateUsername(record.FirstName, record.LastName),
+		Email:      record.Email,
+		Password:   record.PasswordHash,
+		IsActive:   true,
+		AuthMethod: string(domain.AuthMethodPassword),
+	}
+
+	verificationToken, err := AddVerifiedEmailToken(&user)
+	if err != nil {
+		return err
+	}
+
+	return r.app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if _, err := repos.User.Create(ctx, user); err != nil {
+			return err
+		}
+
+		if err := outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserRegistered,
+			r.actor,
+			domain.NewUserEventData(&user),
+		)); err != nil {
+			return err
+		}
+
+		for _, role := range roles {
+			if _, err := repos.UserRole.Create(ctx, domain.UserRole{
+				UserID: user.ID,
+				RoleID: role.ID,
+			}); err != nil {
+				return err
+			}
+
+			if err := outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+				domain.EventUserRoleAssigned,
+				r.actor,
+				domain.UserRoleEventData{
+					UserID:   user.ID,
+					RoleID:   role.ID,
+					RoleName: role.Name,
+				},
+			)); err != nil {
+				return err
+			}
+		}
+
+		if r.job.SendInvites {
+			invitation, err := outbox_repo.NewMessage(string(queue.TopicSendEmail), notification.SendEmailInput{
+				To:           user.Email,
+				Subject:      "Invitación a tu nueva cuenta",
+				TemplateName: "user_invitation",
+				Variables: map[string]string{
+					"name": user.FirstName + " " + user.LastName,
+					"link": r.app.ConfigService.ServerConfig.Host + "/auth/verify-email?token=" + verificationToken,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			if _, err := repos.Outbox.Create(ctx, invitation); err != nil {
+				return err
+			}
+		}
+
+		return audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionUserRegistered,
+			r.creator,
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"source": "import",
+				"job_id": r.job.ID,
+			},
+		))
+	})
+}
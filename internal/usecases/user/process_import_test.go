@@ -0,0 +1,236 @@
+package user_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	role_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role"
+	mock_role "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_import_job "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/import_job/mocks"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	mock_user_role "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/role/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProcessImportUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		importJob  *mock_import_job.MockRepository
+		role       *mock_role.MockRepository
+		userRole   *mock_user_role.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+	}
+
+	hash, err := auth.HashedPassword("Password123!")
+	assert.NoError(t, err)
+
+	admin := &domain.User{ID: "admin-1", Username: "admin", Roles: []domain.Role{{Name: domain.RoleSuperAdmin}}}
+	roles := []domain.Role{
+		{ID: "role-admin", Name: domain.RoleAdmin},
+		{ID: "role-user", Name: domain.RoleUser},
+	}
+
+	csvJob := func() *domain.UserImportJob {
+		return &domain.UserImportJob{
+			ID:          "job-1",
+			Format:      domain.UserImportFormatCSV,
+			SendInvites: true,
+			Status:      domain.UserImportJobPending,
+			CreatedBy:   "admin-1",
+			Payload: []byte(strings.Join([]string{
+				"first_name,last_name,email,roles,password_hash",
+				"Jane,Doe,jane@example.com,admin;user,\"" + string(hash) + "\"",
+				"John,Roe,john@example.com,,",
+				"Jane,Doe,JANE@example.com,,",
+				",Smith,smith@example.com,,",
+				"Ann,Lee,ann@example.com,ghost,",
+				"Bob,Ray,bob@example.com,,plaintext",
+			}, "\n")),
+		}
+	}
+
+	tests := map[string]struct {
+		prepare func(f *fields)
+	}{
+		"when rows are created, skipped and rejected": {
+			prepare: func(f *fields) {
+				f.importJob.EXPECT().Get(gomock.Any(), "job-1").Return(csvJob(), nil)
+				f.importJob.EXPECT().MarkRunning(gomock.Any(), "job-1").Return(nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "admin-1", IncludeDeleted: true}).Return(admin, nil)
+				f.role.EXPECT().List(gomock.Any(), role_repo.ListFilterOptions{}).Return(roles, nil)
+
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Email: "jane@example.com", IncludeDeleted: true}).Return(nil, nil)
+				f.repository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, user domain.User) (string, error) {
+						assert.Equal(t, string(hash), user.Password)
+						assert.Equal(t, string(domain.AuthMethodPassword), user.AuthMethod)
+						assert.NotEmpty(t, user.VerifiedEmailToken)
+						return user.ID, nil
+					},
+				)
+				f.userRole.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&domain.UserRole{}, nil).Times(2)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						if message.Topic == "send_email" {
+							assert.Contains(t, string(message.Payload), "user_invitation")
+						}
+						return message.ID, nil
+					},
+				).Times(4)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, domain.AuditActionUserRegistered, event.Action)
+						assert.Equal(t, "admin-1", event.Actor.ID)
+						assert.Equal(t, "import", event.Metadata["source"])
+						return 1, nil
+					},
+				)
+
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Email: "john@example.com", IncludeDeleted: true}).Return(&domain.User{ID: "user-2"}, nil)
+
+				f.importJob.EXPECT().Finish(gomock.Any(), "job-1", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, id string, result domain.UserImportResult) error {
+						assert.Equal(t, 6, result.TotalRows)
+						assert.Equal(t, 1, result.CreatedRows)
+						assert.Equal(t, 2, result.SkippedRows)
+						assert.Equal(t, 3, result.FailedRows)
+						assert.Nil(t, result.Error)
+						assert.Equal(t, []domain.UserImportRowError{
+							{Row: 4, Email: "smith@example.com", Error: "first name is required"},
+							{Row: 5, Email: "ann@example.com", Error: `role "ghost" does not exist`},
+							{Row: 6, Email: "bob@example.com", Error: "unsupported password hash format, expected bcrypt or argon2id"},
+						}, result.Errors)
+						return nil
+					},
+				)
+			},
+		},
+		"when the job is a dry run": {
+			prepare: func(f *fields) {
+				f.importJob.EXPECT().Get(gomock.Any(), "job-1").Return(&domain.UserImportJob{
+					ID:      "job-1",
+					Format:  domain.UserImportFormatNDJSON,
+					DryRun:  true,
+					Status:  domain.UserImportJobPending,
+					Payload: []byte(`{"first_name":"Jane","last_name":"Doe","email":"jane@example.com"}` + "\nnot json\n"),
+				}, nil)
+				f.importJob.EXPECT().MarkRunning(gomock.Any(), "job-1").Return(nil)
+				f.role.EXPECT().List(gomock.Any(), role_repo.ListFilterOptions{}).Return(roles, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Email: "jane@example.com", IncludeDeleted: true}).Return(nil, nil)
+				f.importJob.EXPECT().Finish(gomock.Any(), "job-1", domain.UserImportResult{
+					TotalRows:   2,
+					CreatedRows: 1,
+					FailedRows:  1,
+					Errors:      []domain.UserImportRowError{{Row: 2, Error: "malformed json line"}},
+				}).Return(nil)
+			},
+		},
+		"when a row grants a role at or above the creator's": {
+			prepare: func(f *fields) {
+				f.importJob.EXPECT().Get(gomock.Any(), "job-1").Return(&domain.UserImportJob{
+					ID:        "job-1",
+					Format:    domain.UserImportFormatNDJSON,
+					DryRun:    true,
+					Status:    domain.UserImportJobPending,
+					CreatedBy: "admin-2",
+					Payload: []byte(`{"first_name":"Jane","last_name":"Doe","email":"jane@example.com","roles":["admin"]}` + "\n" +
+						`{"first_name":"John","last_name":"Roe","email":"john@example.com","roles":["user"]}`),
+				}, nil)
+				f.importJob.EXPECT().MarkRunning(gomock.Any(), "job-1").Return(nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "admin-2", IncludeDeleted: true}).Return(&domain.User{
+					ID:       "admin-2",
+					Username: "other-admin",
+					Roles:    []domain.Role{{Name: domain.RoleAdmin}},
+				}, nil)
+				f.role.EXPECT().List(gomock.Any(), role_repo.ListFilterOptions{}).Return(roles, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Email: "john@example.com", IncludeDeleted: true}).Return(nil, nil)
+				f.importJob.EXPECT().Finish(gomock.Any(), "job-1", domain.UserImportResult{
+					TotalRows:   2,
+					CreatedRows: 1,
+					FailedRows:  1,
+					Errors: []domain.UserImportRowError{{
+						Row:   1,
+						Email: "jane@example.com",
+						Error: `role "admin": ` + domain.ErrInsufficientRole.Error(),
+					}},
+				}).Return(nil)
+			},
+		},
+		"when another worker already claimed the job": {
+			prepare: func(f *fields) {
+				f.importJob.EXPECT().Get(gomock.Any(), "job-1").Return(csvJob(), nil)
+				f.importJob.EXPECT().MarkRunning(gomock.Any(), "job-1").Return(sql.ErrNoRows)
+			},
+		},
+		"when the job has already finished": {
+			prepare: func(f *fields) {
+				job := csvJob()
+				job.Status = domain.UserImportJobCompleted
+				f.importJob.EXPECT().Get(gomock.Any(), "job-1").Return(job, nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				importJob:  mock_import_job.NewMockRepository(ctrl),
+				role:       mock_role.NewMockRepository(ctrl),
+				userRole:   mock_user_role.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{
+						User:     f.repository,
+						UserRole: f.userRole,
+						Outbox:   f.outbox,
+						Audit:    f.audit,
+					})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User:      f.repository,
+						Role:      f.role,
+						ImportJob: f.importJob,
+					},
+					Transactor: f.transactor,
+					ConfigService: &config.ConfigurationService{
+						ServerConfig: config.ServerConfig{Host: "http://localhost:8080"},
+					},
+				}
+			}
+
+			uc := usecase.NewProcessImportUsecase(contextFactory)
+			err := uc.Execute(context.Background(), "job-1")
+
+			assert.NoError(t, err)
+		})
+	}
+}
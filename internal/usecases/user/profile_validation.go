@@ -0,0 +1,137 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+)
+
+const (
+	maxNameLength    = 100
+	maxAddressLength = 255
+	maxPictureLength = 255
+)
+
+var (
+	phoneSeparators = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "")
+	phonePattern    = regexp.MustCompile(`^\+?[1-9][0-9]{6,14}$`)
+)
+
+// validateProfile checks and normalises every field present in input. It
+// returns the repository fields to write and the JSON names of the fields
+// that were set, in a stable order.
+func validateProfile(input UpdateInput) (user_repo.UpdateFields, []string, error) {
+	var (
+		fields  user_repo.UpdateFields
+		changed []string
+	)
+
+	if input.FirstName != nil {
+		name, err := validateName("first name", *input.FirstName)
+		if err != nil {
+			return fields, nil, err
+		}
+		fields.FirstName = &name
+		changed = append(changed, "first_name")
+	}
+
+	if input.LastName != nil {
+		name, err := validateName("last name", *input.LastName)
+		if err != nil {
+			return fields, nil, err
+		}
+		fields.LastName = &name
+		changed = append(changed, "last_name")
+	}
+
+	if input.PhoneNumber != nil {
+		phoneNumber, err := normalizePhoneNumber(*input.PhoneNumber)
+		if err != nil {
+			return fields, nil, err
+		}
+		fields.PhoneNumber = &phoneNumber
+		changed = append(changed, "phone_number")
+	}
+
+	if input.Picture != nil {
+		picture := strings.TrimSpace(*input.Picture)
+		if err := validatePicture(picture); err != nil {
+			return fields, nil, err
+		}
+		fields.Picture = &picture
+		changed = append(changed, "picture")
+	}
+
+	if input.Address != nil {
+		address := strings.TrimSpace(*input.Address)
+		if utf8.RuneCountInString(address) > maxAddressLength {
+			return fields, nil, fmt.Errorf("address must be at most %d characters", maxAddressLength)
+		}
+		if hasControlCharacters(address) {
+			return fields, nil, errors.New("address contains invalid characters")
+		}
+		fields.Address = &address
+		changed = append(changed, "address")
+	}
+
+	return fields, changed, nil
+}
+
+func validateName(label, value string) (string, error) {
+	name := strings.TrimSpace(value)
+	if name == "" {
+		return "", fmt.Errorf("%s cannot be empty", label)
+	}
+
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return "", fmt.Errorf("%s must be at most %d characters", label, maxNameLength)
+	}
+
+	if hasControlCharacters(name) {
+		return "", fmt.Errorf("%s contains invalid characters", label)
+	}
+
+	return name, nil
+}
+
+// normalizePhoneNumber strips common separators and checks the result looks
+// like an E.164 number. An empty value clears the phone number.
+func normalizePhoneNumber(value string) (string, error) {
+	phoneNumber := phoneSeparators.Replace(strings.TrimSpace(value))
+	if phoneNumber == "" {
+		return "", nil
+	}
+
+	if !phonePattern.MatchString(phoneNumber) {
+		return "", errors.New("phone number must be in international format, e.g. +14155550123")
+	}
+
+	return phoneNumber, nil
+}
+
+func validatePicture(picture string) error {
+	if picture == "" {
+		return nil
+	}
+
+	if len(picture) > maxPictureLength {
+		return fmt.Errorf("picture URL must be at most %d characters", maxPictureLength)
+	}
+
+	parsed, err := url.Parse(picture)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return errors.New("picture must be an absolute http(s) URL")
+	}
+
+	return nil
+}
+
+func hasControlCharacters(value string) bool {
+	return strings.IndexFunc(value, unicode.IsControl) >= 0
+}
@@ -0,0 +1,141 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	ReactivateUsecase interface {
+		Execute(context.Context, ReactivateInput) (*ReactivateOutput, error)
+	}
+
+	reactivateUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	// ReactivateInput identifies the user to reactivate. Reason is optional
+	// and only recorded in the audit log.
+	ReactivateInput struct {
+		UserID string `json:"-"`
+		Reason string `json:"reason"`
+		Actor  string `json:"-"`
+	}
+
+	ReactivateOutput struct {
+		Data UserOutputData `json:"data"`
+	}
+)
+
+func NewReactivateUsecase(contextFactory appcontext.Factory) ReactivateUsecase {
+	return &reactivateUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *reactivateUsecase) Execute(ctx context.Context, input ReactivateInput) (*ReactivateOutput, error) {
+	app := u.contextFactory()
+
+	reason := strings.TrimSpace(input.Reason)
+	if len(reason) > maxSuspensionReasonLength {
+		return nil, errors.New("reason must be at most 500 characters")
+	}
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		ID: input.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	if user.IsActive {
+		return nil, errors.New("user is not suspended")
+	}
+
+	actor, caller, err := audit_repo.Actor(ctx, app.Repositories.User, input.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkOutranks(caller, user); err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]any{}
+	if reason != "" {
+		metadata["reason"] = reason
+	}
+
+	if err := reactivate(ctx, app, user, actor, eventActor(input.Actor), metadata); err != nil {
+		return nil, err
+	}
+
+	return &ReactivateOutput{
+		Data: toUserOutputData(user),
+	}, nil
+}
+
+// liftExpiredSuspension reactivates user when their suspension had an end
+// date that has passed. It is checked on login rather than by a background
+// job, since a suspended user cannot do anything else.
+func liftExpiredSuspension(ctx context.Context, app *appcontext.Context, user *domain.User) error {
+	if user.IsActive || user.SuspendedUntil == nil || time.Now().Before(*user.SuspendedUntil) {
+		return nil
+	}
+
+	return reactivate(ctx, app, user, domain.SystemActor(), domain.SystemActor(), map[string]any{
+		"reason": "suspension_expired",
+	})
+}
+
+func reactivate(
+	ctx context.Context,
+	app *appcontext.Context,
+	user *domain.User,
+	auditActor domain.EventActor,
+	eventActor domain.EventActor,
+	metadata map[string]any,
+) error {
+	return app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if err := repos.User.Reactivate(ctx, user.ID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.New("user not found")
+			}
+			return err
+		}
+
+		user.IsActive = true
+		user.SuspendedAt = nil
+		user.SuspendedUntil = nil
+		user.SuspensionReason = nil
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionUserReactivated,
+			auditActor,
+			domain.UserTarget(user.ID),
+			metadata,
+		)); err != nil {
+			return err
+		}
+
+		return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserReactivated,
+			eventActor,
+			domain.NewUserEventData(user),
+		))
+	})
+}
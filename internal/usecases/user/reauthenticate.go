@@ -0,0 +1,150 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+// ErrReauthenticationFailed is returned when the password or Google
+// account given does not belong to the signed in user.
+var ErrReauthenticationFailed = errors.New("reauthentication failed")
+
+type (
+	// ReauthenticateUsecase proves again who the signed in user is and
+	// issues a token with a fresh auth_time, for routes behind
+	// RequireRecentAuth.
+	ReauthenticateUsecase interface {
+		Execute(context.Context, ReauthenticateInput, string) (*ReauthenticateOutput, error)
+	}
+
+	reauthenticateUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	// ReauthenticateInput takes the current password or, with SsoType
+	// "google", an authorization code for the account's Google identity.
+	ReauthenticateInput struct {
+		Password string `json:"password"`
+		SsoType  string `json:"sso_type"`
+		Code     string `json:"code"`
+	}
+
+	ReauthenticateOutput struct {
+		Token    string    `json:"token"`
+		AuthTime time.Time `json:"auth_time"`
+		Methods  []string  `json:"amr"`
+	}
+)
+
+func NewReauthenticateUsecase(contextFactory appcontext.Factory) ReauthenticateUsecase {
+	return &reauthenticateUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *reauthenticateUsecase) Execute(ctx context.Context, input ReauthenticateInput, username string) (*ReauthenticateOutput, error) {
+	app := u.contextFactory()
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Username: username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	method, err := verifyIdentity(ctx, app, user, input)
+	if err != nil {
+		if auditErr := recordAudit(ctx, app, domain.NewAuditEvent(
+			domain.AuditActionReauthenticationFailed,
+			domain.UserActor(user.ID),
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"sso_type": input.SsoType,
+				"reason":   err.Error(),
+			},
+		)); auditErr != nil {
+			log.Printf("failed to record failed reauthentication: %v", auditErr)
+		}
+
+		return nil, err
+	}
+
+	authn := auth.Authentication{
+		Time:    time.Now(),
+		Methods: []string{method},
+	}
+
+	token, err := auth.GenerateToken(user, authn, sessionTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := recordAudit(ctx, app, domain.NewAuditEvent(
+		domain.AuditActionReauthenticated,
+		domain.UserActor(user.ID),
+		domain.UserTarget(user.ID),
+		map[string]any{
+			"method": method,
+		},
+	)); err != nil {
+		return nil, err
+	}
+
+	return &ReauthenticateOutput{
+		Token:    token,
+		AuthTime: authn.Time.UTC().Truncate(time.Second),
+		Methods:  authn.Methods,
+	}, nil
+}
+
+// verifyIdentity checks the credential in input against user and returns
+// the amr value it satisfies.
+func verifyIdentity(ctx context.Context, app *appcontext.Context, user *domain.User, input ReauthenticateInput) (string, error) {
+	if input.SsoType == string(domain.SsoTypeGoogle) {
+		if input.Code == "" {
+			return "", errors.New("code is required")
+		}
+
+		token, err := app.Integrations.SSO.ExchangeCode(ctx, input.Code)
+		if err != nil {
+			return "", err
+		}
+
+		userInfo, err := app.Integrations.SSO.GetUserInfo(ctx, token)
+		if err != nil {
+			return "", err
+		}
+
+		if !strings.EqualFold(userInfo.Email, user.Email) {
+			return "", ErrReauthenticationFailed
+		}
+
+		return auth.AMRGoogle, nil
+	}
+
+	if input.Password == "" {
+		return "", errors.New("password is required")
+	}
+
+	if user.Password == "" {
+		return "", errors.New("account has no password set")
+	}
+
+	if err := auth.ComparePassword(input.Password, user.Password); err != nil {
+		return "", ErrReauthenticationFailed
+	}
+
+	return auth.AMRPassword, nil
+}
@@ -0,0 +1,138 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReauthenticateUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		transactor *mock_repositories.MockTransactor
+		audit      *mock_audit.MockRepository
+	}
+
+	config.InitConfigService(&config.ConfigurationService{
+		ServerConfig: config.ServerConfig{JWTSecret: "secret"},
+	})
+
+	hash, err := auth.HashedPassword("Password123!")
+	assert.NoError(t, err)
+
+	existingUser := func() *domain.User {
+		return &domain.User{
+			ID:           "user-123",
+			Username:     "jdoe",
+			Password:     string(hash),
+			TokenVersion: 2,
+		}
+	}
+
+	tests := map[string]struct {
+		input       usecase.ReauthenticateInput
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when the password is correct": {
+			input: usecase.ReauthenticateInput{Password: "Password123!"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(existingUser(), nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, domain.AuditActionReauthenticated, event.Action)
+						assert.Equal(t, auth.AMRPassword, event.Metadata["method"])
+						return 1, nil
+					},
+				)
+			},
+		},
+		"when the password is wrong": {
+			input: usecase.ReauthenticateInput{Password: "WrongPassword1!"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(existingUser(), nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, domain.AuditActionReauthenticationFailed, event.Action)
+						return 1, nil
+					},
+				)
+			},
+			expectedErr: usecase.ErrReauthenticationFailed,
+		},
+		"when the account has no password": {
+			input: usecase.ReauthenticateInput{Password: "Password123!"},
+			prepare: func(f *fields) {
+				user := existingUser()
+				user.Password = ""
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(user, nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+			},
+			expectedErr: errors.New("account has no password set"),
+		},
+		"when the user does not exist": {
+			input: usecase.ReauthenticateInput{Password: "Password123!"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(nil, nil)
+			},
+			expectedErr: errors.New("user not found"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{Audit: f.audit})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User: f.repository,
+					},
+					Transactor: f.transactor,
+				}
+			}
+
+			uc := usecase.NewReauthenticateUsecase(contextFactory)
+			output, err := uc.Execute(context.Background(), tc.input, "jdoe")
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				claims, err := auth.ValidateToken(output.Token)
+				assert.NoError(t, err)
+				assert.Equal(t, "jdoe", claims.UserID)
+				assert.Equal(t, uint(2), claims.TokenVersion)
+				assert.Equal(t, []string{auth.AMRPassword}, claims.AMR)
+				assert.WithinDuration(t, time.Now(), claims.AuthenticatedAt(), 2*time.Second)
+				assert.Equal(t, []string{auth.AMRPassword}, output.Methods)
+			}
+		})
+	}
+}
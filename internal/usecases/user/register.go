@@ -6,6 +6,9 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
 	role_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/role"
 	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
@@ -13,7 +16,6 @@ import (
 	"github.com/tapiaw38/auth-api-be/internal/domain"
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
 	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
-	"github.com/tapiaw38/auth-api-be/internal/platform/utils"
 )
 
 type (
@@ -71,9 +73,10 @@ func (u *registerUsecase) Execute(ctx context.Context, input RegisterInput) (*Re
 		Password:  input.Password,
 	}
 
-	// Check if email already exists
+	// Check if email already exists, including on deleted accounts
 	existingUser, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
-		Email: user.Email,
+		Email:          user.Email,
+		IncludeDeleted: true,
 	})
 	if err != nil {
 		return nil, err
@@ -83,12 +86,12 @@ func (u *registerUsecase) Execute(ctx context.Context, input RegisterInput) (*Re
 		return nil, errors.New("email already in use")
 	}
 
-	err = AddVerifiedEmailToken(&user)
+	verificationToken, err := AddVerifiedEmailToken(&user)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := auth.ValidatePasswordStrength(user.Password); err != nil {
+	if err := auth.ValidatePassword(user.Password, user.FirstName, user.LastName, user.Email); err != nil {
 		return nil, err
 	}
 
@@ -108,11 +111,6 @@ func (u *registerUsecase) Execute(ctx context.Context, input RegisterInput) (*Re
 	user.VerifiedEmail = false
 	user.AuthMethod = string(domain.AuthMethodPassword)
 
-	userID, err := app.Repositories.User.Create(ctx, user)
-	if err != nil {
-		return nil, err
-	}
-
 	defaultRole, err := app.Repositories.Role.Get(ctx, role_repo.GetFilterOptions{
 		Name: string(domain.RoleUser),
 	})
@@ -120,31 +118,77 @@ func (u *registerUsecase) Execute(ctx context.Context, input RegisterInput) (*Re
 		return nil, err
 	}
 
-	if _, err = app.Repositories.UserRole.Create(ctx, domain.UserRole{
-		UserID: userID,
-		RoleID: defaultRole.ID,
-	}); err != nil {
-		return nil, err
-	}
-
-	createdUser, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
-		ID: userID,
-	})
-	if err != nil {
-		return nil, err
-	}
-
 	emailConfirmation := notification.SendEmailInput{
-		To:           createdUser.Email,
+		To:           user.Email,
 		Subject:      "Confirmación de registro",
 		TemplateName: "email_verification",
 		Variables: map[string]string{
 			"name": user.FirstName + " " + user.LastName,
-			"link": app.ConfigService.ServerConfig.Host + "/auth/verify-email?token=" + user.VerifiedEmailToken,
+			"link": app.ConfigService.ServerConfig.Host + "/auth/verify-email?token=" + verificationToken,
 		},
 	}
 
-	if err = app.Publisher.Publish(queue.TopicSendEmail, emailConfirmation); err != nil {
+	message, err := outbox_repo.NewMessage(string(queue.TopicSendEmail), emailConfirmation)
+	if err != nil {
+		return nil, err
+	}
+
+	var userID string
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		userID, err = repos.User.Create(ctx, user)
+		if err != nil {
+			return err
+		}
+
+		if _, err = repos.UserRole.Create(ctx, domain.UserRole{
+			UserID: userID,
+			RoleID: defaultRole.ID,
+		}); err != nil {
+			return err
+		}
+
+		if _, err = repos.Outbox.Create(ctx, message); err != nil {
+			return err
+		}
+
+		user.ID = userID
+		actor := domain.UserActor(user.Username)
+
+		if err := outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserRegistered,
+			actor,
+			domain.NewUserEventData(&user),
+		)); err != nil {
+			return err
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionUserRegistered,
+			domain.UserActor(userID),
+			domain.UserTarget(userID),
+			nil,
+		)); err != nil {
+			return err
+		}
+
+		return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserRoleAssigned,
+			actor,
+			domain.UserRoleEventData{
+				UserID:   userID,
+				RoleID:   defaultRole.ID,
+				RoleName: defaultRole.Name,
+			},
+		))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	createdUser, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		ID: userID,
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -153,14 +197,18 @@ func (u *registerUsecase) Execute(ctx context.Context, input RegisterInput) (*Re
 	}, nil
 }
 
-func AddVerifiedEmailToken(user *domain.User) error {
-	encodedString, err := utils.GetEncodedString()
+const verifiedEmailTokenTTL = time.Hour * 24 * 7
+
+// AddVerifiedEmailToken stores the hash of a new verification token on
+// user and returns the token to put in the emailed link.
+func AddVerifiedEmailToken(user *domain.User) (string, error) {
+	token, err := auth.NewOneTimeToken()
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	user.VerifiedEmailToken = encodedString
-	user.VerifiedEmailTokenExpiry = time.Now().Add(time.Hour * 24 * 7)
+	user.VerifiedEmailToken = token.Hash
+	user.VerifiedEmailTokenExpiry = time.Now().Add(verifiedEmailTokenTTL)
 
-	return nil
+	return token.Plain, nil
 }
@@ -0,0 +1,96 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+// ErrInvalidReportToken is returned when a "this wasn't me" token is
+// unknown, expired or already used.
+var ErrInvalidReportToken = errors.New("token expired or invalid")
+
+type (
+	// ReportUnrecognizedLoginUsecase handles the "this wasn't me" link of a
+	// new device email. The link opens a frontend page that asks the user
+	// to confirm and then posts the token, so mail scanners following the
+	// link change nothing.
+	ReportUnrecognizedLoginUsecase interface {
+		Execute(context.Context, ReportUnrecognizedLoginInput) error
+	}
+
+	reportUnrecognizedLoginUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	ReportUnrecognizedLoginInput struct {
+		Token string `json:"token"`
+	}
+)
+
+func NewReportUnrecognizedLoginUsecase(contextFactory appcontext.Factory) ReportUnrecognizedLoginUsecase {
+	return &reportUnrecognizedLoginUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+// Execute forgets the reported device, revokes every token issued to the
+// user and emails them a password reset link.
+func (u *reportUnrecognizedLoginUsecase) Execute(ctx context.Context, input ReportUnrecognizedLoginInput) error {
+	app := u.contextFactory()
+
+	var device *domain.UserDevice
+	err := app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		var err error
+		device, err = repos.Device.ConsumeRevokeToken(ctx, auth.HashOneTimeToken(input.Token))
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidReportToken
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := repos.User.IncrementTokenVersion(ctx, device.UserID); err != nil {
+			return err
+		}
+
+		return audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionLoginReported,
+			domain.UserActor(device.UserID),
+			domain.UserTarget(device.UserID),
+			map[string]any{
+				"device_id":  device.ID,
+				"ip":         device.IP,
+				"user_agent": device.UserAgent,
+			},
+		))
+	})
+	if err != nil {
+		return err
+	}
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		ID: device.UserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The sessions are already revoked, so a failure to send the reset
+	// link doesn't undo the report; the user can still ask for one.
+	if user != nil && user.IsActive {
+		if err := sendPasswordResetEmail(ctx, app, user); err != nil {
+			log.Printf("failed to send password reset after reported login for user %s: %v", user.ID, err)
+		}
+	}
+
+	return nil
+}
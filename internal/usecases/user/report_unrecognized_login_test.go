@@ -0,0 +1,123 @@
+package user_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user_device "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/device/mocks"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReportUnrecognizedLoginUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		device     *mock_user_device.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+	}
+
+	tests := map[string]struct {
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when the token is valid": {
+			prepare: func(f *fields) {
+				f.device.EXPECT().ConsumeRevokeToken(gomock.Any(), auth.HashOneTimeToken("plain-token")).Return(&domain.UserDevice{
+					ID:     "device-1",
+					UserID: "user-123",
+					IP:     "190.0.66.1",
+				}, nil)
+				f.repository.EXPECT().IncrementTokenVersion(gomock.Any(), "user-123").Return(nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, domain.AuditActionLoginReported, event.Action)
+						assert.Equal(t, "device-1", event.Metadata["device_id"])
+						return 1, nil
+					},
+				)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123"}).Return(&domain.User{
+					ID:         "user-123",
+					Email:      "jane@example.com",
+					IsActive:   true,
+					AuthMethod: string(domain.AuthMethodPassword),
+				}, nil)
+				f.repository.EXPECT().Update(gomock.Any(), "user-123", gomock.Any()).Return("user-123", nil)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						assert.Contains(t, string(message.Payload), `"reset_password"`)
+						return message.ID, nil
+					},
+				)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(2), nil)
+			},
+		},
+		"when the token was already used or expired": {
+			prepare: func(f *fields) {
+				f.device.EXPECT().ConsumeRevokeToken(gomock.Any(), gomock.Any()).Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: usecase.ErrInvalidReportToken,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				device:     mock_user_device.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{
+						User:   f.repository,
+						Device: f.device,
+						Outbox: f.outbox,
+						Audit:  f.audit,
+					})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User:   f.repository,
+						Device: f.device,
+						Outbox: f.outbox,
+					},
+					Transactor: f.transactor,
+					ConfigService: &config.ConfigurationService{
+						GCPConfig: config.GCPConfig{
+							OAuth2Config: config.OAuth2Config{FrontendURL: "https://app.example.com"},
+						},
+					},
+				}
+			}
+
+			uc := usecase.NewReportUnrecognizedLoginUsecase(contextFactory)
+			err := uc.Execute(context.Background(), usecase.ReportUnrecognizedLoginInput{Token: "plain-token"})
+
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
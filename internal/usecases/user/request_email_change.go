@@ -0,0 +1,171 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/notification"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+const emailChangeTokenTTL = 24 * time.Hour
+
+type (
+	RequestEmailChangeUsecase interface {
+		Execute(context.Context, RequestEmailChangeInput) (*RequestEmailChangeOutput, error)
+	}
+
+	requestEmailChangeUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	// RequestEmailChangeInput asks to move the account to NewEmail. The
+	// route requires a recent authentication, see RequireRecentAuth.
+	RequestEmailChangeInput struct {
+		NewEmail string `json:"new_email"`
+		Username string `json:"-"`
+	}
+
+	RequestEmailChangeOutput struct {
+		Data ResetPasswordOutputData `json:"data"`
+	}
+)
+
+func NewRequestEmailChangeUsecase(contextFactory appcontext.Factory) RequestEmailChangeUsecase {
+	return &requestEmailChangeUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *requestEmailChangeUsecase) Execute(ctx context.Context, input RequestEmailChangeInput) (*RequestEmailChangeOutput, error) {
+	app := u.contextFactory()
+
+	newEmail := strings.TrimSpace(input.NewEmail)
+	if err := auth.ValidateEmail(newEmail); err != nil {
+		return nil, err
+	}
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Username: input.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	if strings.EqualFold(newEmail, user.Email) {
+		return nil, errors.New("new email must be different from current email")
+	}
+
+	existingUser, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Email:          newEmail,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if existingUser != nil {
+		return nil, errors.New("email already in use")
+	}
+
+	token, err := auth.NewOneTimeToken()
+	if err != nil {
+		return nil, err
+	}
+
+	change := domain.EmailChange{
+		ID:        uuid.NewString(),
+		UserID:    user.ID,
+		NewEmail:  newEmail,
+		TokenHash: token.Hash,
+		ExpiresAt: time.Now().Add(emailChangeTokenTTL),
+	}
+
+	name := user.FirstName + " " + user.LastName
+
+	verification, err := outbox_repo.NewMessage(string(queue.TopicSendEmail), notification.SendEmailInput{
+		To:           newEmail,
+		Subject:      "Confirma tu nuevo correo electrónico",
+		TemplateName: "email_change_verification",
+		Variables: map[string]string{
+			"name": name,
+			"link": app.ConfigService.ServerConfig.Host + "/auth/confirm-email?token=" + token.Plain,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notice, err := outbox_repo.NewMessage(string(queue.TopicSendEmail), notification.SendEmailInput{
+		To:           user.Email,
+		Subject:      "Cambio de correo electrónico solicitado",
+		TemplateName: "email_change_notice",
+		Variables: map[string]string{
+			"name":      name,
+			"new_email": maskEmail(newEmail),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if err := repos.EmailChange.DeletePending(ctx, user.ID); err != nil {
+			return err
+		}
+
+		if _, err := repos.EmailChange.Create(ctx, change); err != nil {
+			return err
+		}
+
+		for _, message := range []domain.OutboxMessage{verification, notice} {
+			if _, err := repos.Outbox.Create(ctx, message); err != nil {
+				return err
+			}
+		}
+
+		return audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionEmailChangeRequested,
+			domain.UserActor(user.ID),
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"new_email": newEmail,
+			},
+		))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RequestEmailChangeOutput{
+		Data: ResetPasswordOutputData{
+			Email:   newEmail,
+			Message: "Verification link sent to the new email address",
+		},
+	}, nil
+}
+
+// maskEmail hides most of the local part, so the notice sent to the old
+// address does not disclose the new one in full.
+func maskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return email
+	}
+
+	return email[:1] + strings.Repeat("*", 3) + email[at:]
+}
@@ -0,0 +1,165 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user_email_change "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/email_change/mocks"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRequestEmailChangeUsecase(t *testing.T) {
+	type fields struct {
+		repository  *mock_user.MockRepository
+		emailChange *mock_user_email_change.MockRepository
+		transactor  *mock_repositories.MockTransactor
+		outbox      *mock_outbox.MockRepository
+		audit       *mock_audit.MockRepository
+	}
+
+	hash, err := auth.HashedPassword("Password123!")
+	assert.NoError(t, err)
+
+	currentUser := &domain.User{
+		ID:        "user-123",
+		Username:  "jdoe",
+		Email:     "john@example.com",
+		FirstName: "John",
+		LastName:  "Doe",
+		Password:  string(hash),
+	}
+
+	tests := map[string]struct {
+		input       usecase.RequestEmailChangeInput
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when the change is requested": {
+			input: usecase.RequestEmailChangeInput{NewEmail: " new@example.com "},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(currentUser, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Email: "new@example.com", IncludeDeleted: true}).Return(nil, nil)
+				f.emailChange.EXPECT().DeletePending(gomock.Any(), "user-123").Return(nil)
+				f.emailChange.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, change domain.EmailChange) (string, error) {
+						assert.Equal(t, "user-123", change.UserID)
+						assert.Equal(t, "new@example.com", change.NewEmail)
+						assert.Len(t, change.TokenHash, 64)
+						assert.True(t, change.ExpiresAt.After(time.Now()))
+						return change.ID, nil
+					},
+				)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						assert.Contains(t, string(message.Payload), `"to":"new@example.com"`)
+						assert.Contains(t, string(message.Payload), "email_change_verification")
+						return message.ID, nil
+					},
+				)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						assert.Contains(t, string(message.Payload), `"to":"john@example.com"`)
+						assert.Contains(t, string(message.Payload), "n***@example.com")
+						return message.ID, nil
+					},
+				)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, domain.AuditActionEmailChangeRequested, event.Action)
+						return 1, nil
+					},
+				)
+			},
+		},
+
+		"when the email is the current one": {
+			input: usecase.RequestEmailChangeInput{NewEmail: "John@Example.com"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(currentUser, nil)
+			},
+			expectedErr: errors.New("new email must be different from current email"),
+		},
+		"when the email is already in use": {
+			input: usecase.RequestEmailChangeInput{NewEmail: "taken@example.com"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(currentUser, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Email: "taken@example.com", IncludeDeleted: true}).
+					Return(&domain.User{ID: "user-456"}, nil)
+			},
+			expectedErr: errors.New("email already in use"),
+		},
+		"when the user does not exist": {
+			input: usecase.RequestEmailChangeInput{NewEmail: "new@example.com"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(nil, nil)
+			},
+			expectedErr: errors.New("user not found"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository:  mock_user.NewMockRepository(ctrl),
+				emailChange: mock_user_email_change.NewMockRepository(ctrl),
+				transactor:  mock_repositories.NewMockTransactor(ctrl),
+				outbox:      mock_outbox.NewMockRepository(ctrl),
+				audit:       mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{
+						User:        f.repository,
+						EmailChange: f.emailChange,
+						Outbox:      f.outbox,
+						Audit:       f.audit,
+					})
+				}).AnyTimes()
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User:        f.repository,
+						EmailChange: f.emailChange,
+					},
+					Transactor: f.transactor,
+					ConfigService: &config.ConfigurationService{
+						ServerConfig: config.ServerConfig{Host: "http://localhost:8080"},
+					},
+				}
+			}
+
+			tc.input.Username = "jdoe"
+
+			uc := usecase.NewRequestEmailChangeUsecase(contextFactory)
+			output, err := uc.Execute(context.Background(), tc.input)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, "new@example.com", output.Data.Email)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+)
+
+const (
+	defaultExportTTL     = 24 * time.Hour
+	exportAuditPageLimit = 200
+)
+
+type (
+	// RequestExportUsecase builds an archive of everything stored about the
+	// user and returns a link to download it. Only the hash of the link
+	// token is kept, and a new request replaces any earlier archive.
+	RequestExportUsecase interface {
+		Execute(context.Context, string) (*RequestExportOutput, error)
+	}
+
+	requestExportUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	RequestExportOutput struct {
+		Data RequestExportOutputData `json:"data"`
+	}
+
+	RequestExportOutputData struct {
+		DownloadURL string    `json:"download_url"`
+		ExpiresAt   time.Time `json:"expires_at"`
+	}
+
+	// ExportArchive is the JSON document handed to the user.
+	ExportArchive struct {
+		GeneratedAt      time.Time              `json:"generated_at"`
+		Profile          ExportProfileData      `json:"profile"`
+		Roles            []RoleOutputData       `json:"roles"`
+		Sessions         ExportSessionsData     `json:"sessions"`
+		LinkedIdentities []ExportIdentityData   `json:"linked_identities"`
+		AuditHistory     []ExportAuditEventData `json:"audit_history"`
+	}
+
+	ExportProfileData struct {
+		ID            string    `json:"id"`
+		Username      string    `json:"username"`
+		FirstName     string    `json:"first_name"`
+		LastName      string    `json:"last_name"`
+		Email         string    `json:"email"`
+		PhoneNumber   *string   `json:"phone_number"`
+		Picture       *string   `json:"picture"`
+		Address       *string   `json:"address"`
+		IsActive      bool      `json:"is_active"`
+		VerifiedEmail bool      `json:"verified_email"`
+		VerifiedPhone bool      `json:"verified_phone"`
+		AuthMethod    string    `json:"auth_method"`
+		CreatedAt     time.Time `json:"created_at"`
+		UpdatedAt     time.Time `json:"updated_at"`
+	}
+
+	// ExportSessionsData describes sign-ins. Tokens are stateless, so the
+	// only record of a session is the login it started with.
+	ExportSessionsData struct {
+		TokenVersion uint              `json:"token_version"`
+		Logins       []ExportLoginData `json:"logins"`
+	}
+
+	ExportLoginData struct {
+		IP        string    `json:"ip"`
+		UserAgent string    `json:"user_agent"`
+		LoggedAt  time.Time `json:"logged_in_at"`
+	}
+
+	ExportIdentityData struct {
+		Provider string `json:"provider"`
+		Email    string `json:"email"`
+	}
+
+	ExportAuditEventData struct {
+		Action     string         `json:"action"`
+		ActorType  string         `json:"actor_type"`
+		ActorID    string         `json:"actor_id,omitempty"`
+		TargetType string         `json:"target_type,omitempty"`
+		TargetID   string         `json:"target_id,omitempty"`
+		IP         string         `json:"ip"`
+		UserAgent  string         `json:"user_agent"`
+		Metadata   map[string]any `json:"metadata"`
+		CreatedAt  time.Time      `json:"created_at"`
+	}
+)
+
+func NewRequestExportUsecase(contextFactory appcontext.Factory) RequestExportUsecase {
+	return &requestExportUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *requestExportUsecase) Execute(ctx context.Context, username string) (*RequestExportOutput, error) {
+	app := u.contextFactory()
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Username: username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	events, err := listAuditHistory(ctx, app.Repositories.Audit, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.MarshalIndent(buildExportArchive(user, events), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := auth.NewOneTimeToken()
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := app.ConfigService.Privacy.ExportTTL
+	if ttl <= 0 {
+		ttl = defaultExportTTL
+	}
+
+	export := domain.DataExport{
+		ID:        uuid.NewString(),
+		UserID:    user.ID,
+		TokenHash: token.Hash,
+		Payload:   payload,
+		ExpiresAt: time.Now().Add(ttl).UTC(),
+	}
+
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if err := repos.DataExport.DeleteByUser(ctx, user.ID); err != nil {
+			return err
+		}
+
+		if _, err := repos.DataExport.Create(ctx, export); err != nil {
+			return err
+		}
+
+		return audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionDataExported,
+			domain.UserActor(user.ID),
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"audit_events": len(events),
+			},
+		))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RequestExportOutput{
+		Data: RequestExportOutputData{
+			DownloadURL: app.ConfigService.ServerConfig.Host + "/user/export/download?token=" + token.Plain,
+			ExpiresAt:   export.ExpiresAt,
+		},
+	}, nil
+}
+
+// listAuditHistory pages through every event where the user is the actor
+// or the target, newest first.
+func listAuditHistory(ctx context.Context, repository audit_repo.Repository, userID string) ([]domain.AuditEvent, error) {
+	var events []domain.AuditEvent
+	for {
+		page, err := repository.List(ctx, audit_repo.ListFilterOptions{
+			Subject: userID,
+			Limit:   exportAuditPageLimit,
+			Offset:  len(events),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, page...)
+
+		if len(page) < exportAuditPageLimit {
+			return events, nil
+		}
+	}
+}
+
+func buildExportArchive(user *domain.User, events []domain.AuditEvent) ExportArchive {
+	archive := ExportArchive{
+		GeneratedAt: time.Now().UTC(),
+		Profile: ExportProfileData{
+			ID:            user.ID,
+			Username:      user.Username,
+			FirstName:     user.FirstName,
+			LastName:      user.LastName,
+			Email:         user.Email,
+			PhoneNumber:   user.PhoneNumber,
+			Picture:       user.Picture,
+			Address:       user.Address,
+			IsActive:      user.IsActive,
+			VerifiedEmail: user.VerifiedEmail,
+			VerifiedPhone: user.VerifiedPhone,
+			AuthMethod:    user.AuthMethod,
+			CreatedAt:     user.CreatedAt,
+			UpdatedAt:     user.UpdatedAt,
+		},
+		Roles: toUserOutputData(user).Roles,
+		Sessions: ExportSessionsData{
+			TokenVersion: user.TokenVersion,
+			Logins:       []ExportLoginData{},
+		},
+		LinkedIdentities: []ExportIdentityData{},
+		AuditHistory:     make([]ExportAuditEventData, 0, len(events)),
+	}
+
+	if archive.Roles == nil {
+		archive.Roles = []RoleOutputData{}
+	}
+
+	switch domain.AuthMethod(user.AuthMethod) {
+	case domain.AuthMethodGoogle, domain.AuthMethodHybrid:
+		archive.LinkedIdentities = append(archive.LinkedIdentities, ExportIdentityData{
+			Provider: string(domain.SsoTypeGoogle),
+			Email:    user.Email,
+		})
+	}
+
+	for _, event := range events {
+		if event.Action == domain.AuditActionLogin && event.Actor.ID == user.ID {
+			archive.Sessions.Logins = append(archive.Sessions.Logins, ExportLoginData{
+				IP:        event.IP,
+				UserAgent: event.UserAgent,
+				LoggedAt:  event.CreatedAt,
+			})
+		}
+
+		archive.AuditHistory = append(archive.AuditHistory, ExportAuditEventData{
+			Action:     string(event.Action),
+			ActorType:  string(event.Actor.Type),
+			ActorID:    event.Actor.ID,
+			TargetType: string(event.Target.Type),
+			TargetID:   event.Target.ID,
+			IP:         event.IP,
+			UserAgent:  event.UserAgent,
+			Metadata:   event.Metadata,
+			CreatedAt:  event.CreatedAt,
+		})
+	}
+
+	return archive
+}
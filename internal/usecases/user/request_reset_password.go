@@ -3,15 +3,24 @@ package user
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
 	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/notification"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
-	"github.com/tapiaw38/auth-api-be/internal/platform/utils"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+	"github.com/tapiaw38/auth-api-be/internal/platform/requestinfo"
 )
 
+const passwordResetRequestedMessage = "If an account exists for this email, we have sent instructions to reset its password"
+
 type (
 	RequestResetPasswordUsecase interface {
 		Execute(context.Context, string) (*RequestResetPasswordOutput, error)
@@ -32,51 +41,167 @@ func NewRequestResetPasswordUsecase(contextFactory appcontext.Factory) RequestRe
 	}
 }
 
+// Execute emails a reset link when email belongs to an account that signs
+// in with a password, and a reminder to use Google when it belongs to an
+// SSO-only account. Nothing is sent once the email or the client IP is
+// over its hourly limit. The output is the same in every case and the call
+// takes at least the configured MinResponseTime, so neither reveals
+// whether the account exists or the caller is being throttled.
 func (u *requestResetPasswordUsecase) Execute(ctx context.Context, email string) (*RequestResetPasswordOutput, error) {
 	app := u.contextFactory()
-	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
-		Email: email,
-	})
+
+	defer waitUntil(ctx, time.Now().Add(app.ConfigService.PasswordReset.MinResponseTime))
+
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return nil, errors.New("email is required")
+	}
+
+	allowed, err := throttlePasswordReset(ctx, app, strings.ToLower(email))
 	if err != nil {
 		return nil, err
 	}
 
-	if user == nil {
-		return nil, errors.New("user email not found")
+	if allowed {
+		user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+			Email: email,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if user != nil && user.IsActive {
+			if err := sendPasswordResetEmail(ctx, app, user); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &RequestResetPasswordOutput{
+		Data: ResetPasswordOutputData{
+			Email:   email,
+			Message: passwordResetRequestedMessage,
+		},
+	}, nil
+}
+
+// throttlePasswordReset records the request and reports false once the
+// email or the client IP is over its hourly limit. Unknown emails count
+// too. A limit of zero disables it.
+func throttlePasswordReset(ctx context.Context, app *appcontext.Context, email string) (bool, error) {
+	limits := app.ConfigService.PasswordReset
+	ip := requestinfo.FromContext(ctx).IP
+	since := time.Now().Add(-time.Hour)
+
+	if limits.EmailHourlyLimit > 0 {
+		count, err := app.Repositories.PasswordReset.CountByEmailSince(ctx, email, since)
+		if err != nil {
+			return false, err
+		}
+		if count >= limits.EmailHourlyLimit {
+			return false, nil
+		}
+	}
+
+	if limits.IPHourlyLimit > 0 && ip != "" {
+		count, err := app.Repositories.PasswordReset.CountByIPSince(ctx, ip, since)
+		if err != nil {
+			return false, err
+		}
+		if count >= limits.IPHourlyLimit {
+			return false, nil
+		}
+	}
+
+	err := app.Repositories.PasswordReset.Create(ctx, domain.PasswordResetRequest{
+		ID:    uuid.NewString(),
+		Email: email,
+		IP:    ip,
+	})
+
+	return err == nil, err
+}
+
+func sendPasswordResetEmail(ctx context.Context, app *appcontext.Context, user *domain.User) error {
+	frontendURL := app.ConfigService.GCPConfig.OAuth2Config.FrontendURL
+	name := user.FirstName + " " + user.LastName
+
+	if user.AuthMethod == string(domain.AuthMethodGoogle) {
+		message, err := outbox_repo.NewMessage(string(queue.TopicSendEmail), notification.SendEmailInput{
+			To:           user.Email,
+			Subject:      "Inicia sesión con Google",
+			TemplateName: "sso_password_reset",
+			Variables: map[string]string{
+				"name": name,
+				"link": frontendURL + "/login",
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = app.Repositories.Outbox.Create(ctx, message)
+		return err
 	}
 
-	token, err := utils.GetEncodedString()
+	// Only one reset can be outstanding: a new request overwrites the
+	// previous token, whose link stops working.
+	token, err := auth.NewOneTimeToken()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	tokenExpiry := time.Now().Add(time.Hour * 24)
 
-	user.PasswordResetToken = &token
+	user.PasswordResetToken = &token.Hash
 	user.PasswordResetTokenExpiry = &tokenExpiry
 
-	if _, err = app.Repositories.User.Update(ctx, user.ID, user); err != nil {
-		return nil, err
-	}
-
-	emailResetPassword := notification.SendEmailInput{
+	message, err := outbox_repo.NewMessage(string(queue.TopicSendEmail), notification.SendEmailInput{
 		To:           user.Email,
 		Subject:      "Restablecer contraseña",
 		TemplateName: "reset_password",
 		Variables: map[string]string{
-			"name": user.FirstName + " " + user.LastName,
-			"link": app.ConfigService.ServerConfig.Host + "/auth/reset-password?token=" + token,
+			"name": name,
+			"link": frontendURL + "/reset-password?token=" + token.Plain,
 		},
+	})
+	if err != nil {
+		return err
 	}
 
-	if err = app.Publisher.Publish(queue.TopicSendEmail, emailResetPassword); err != nil {
-		return nil, err
+	return app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if _, err := repos.User.Update(ctx, user.ID, user_repo.UpdateFields{
+			PasswordResetToken:       user.PasswordResetToken,
+			PasswordResetTokenExpiry: user.PasswordResetTokenExpiry,
+		}); err != nil {
+			return err
+		}
+
+		if _, err := repos.Outbox.Create(ctx, message); err != nil {
+			return err
+		}
+
+		return audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionPasswordResetRequested,
+			domain.AnonymousActor(),
+			domain.UserTarget(user.ID),
+			nil,
+		))
+	})
+}
+
+// waitUntil blocks until deadline or until ctx is done.
+func waitUntil(ctx context.Context, deadline time.Time) {
+	wait := time.Until(deadline)
+	if wait <= 0 {
+		return
 	}
 
-	return &RequestResetPasswordOutput{
-		Data: ResetPasswordOutputData{
-			Email:   user.Email,
-			Message: "Password reset token sent",
-		},
-	}, nil
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
 }
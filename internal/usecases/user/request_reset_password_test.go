@@ -0,0 +1,177 @@
+package user_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	mock_user_password_reset_request "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/password_reset_request/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	"github.com/tapiaw38/auth-api-be/internal/platform/requestinfo"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRequestResetPasswordUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		requests   *mock_user_password_reset_request.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+	}
+
+	allowRequest := func(f *fields, email string) {
+		f.requests.EXPECT().CountByEmailSince(gomock.Any(), email, gomock.Any()).Return(0, nil)
+		f.requests.EXPECT().CountByIPSince(gomock.Any(), "203.0.113.7", gomock.Any()).Return(0, nil)
+		f.requests.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, request domain.PasswordResetRequest) error {
+				assert.Equal(t, email, request.Email)
+				assert.Equal(t, "203.0.113.7", request.IP)
+				return nil
+			},
+		)
+	}
+
+	tests := map[string]struct {
+		email       string
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when the account signs in with a password": {
+			email: "Jane@example.com",
+			prepare: func(f *fields) {
+				allowRequest(f, "jane@example.com")
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Email: "Jane@example.com"}).Return(&domain.User{
+					ID:         "user-123",
+					Email:      "Jane@example.com",
+					IsActive:   true,
+					AuthMethod: string(domain.AuthMethodPassword),
+				}, nil)
+				f.repository.EXPECT().Update(gomock.Any(), "user-123", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, id string, fields user_repo.UpdateFields) (string, error) {
+						assert.Len(t, *fields.PasswordResetToken, 64)
+						assert.NotNil(t, fields.PasswordResetTokenExpiry)
+						assert.Nil(t, fields.Password)
+						return id, nil
+					},
+				)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						payload := string(message.Payload)
+						assert.Contains(t, payload, `"reset_password"`)
+						assert.Contains(t, payload, "https://app.example.com/reset-password?token=")
+						return message.ID, nil
+					},
+				)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+			},
+		},
+		"when the account only signs in with Google": {
+			email: "jane@example.com",
+			prepare: func(f *fields) {
+				allowRequest(f, "jane@example.com")
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Email: "jane@example.com"}).Return(&domain.User{
+					ID:         "user-123",
+					Email:      "jane@example.com",
+					IsActive:   true,
+					AuthMethod: string(domain.AuthMethodGoogle),
+				}, nil)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						payload := string(message.Payload)
+						assert.Contains(t, payload, `"sso_password_reset"`)
+						assert.False(t, strings.Contains(payload, "token="))
+						return message.ID, nil
+					},
+				)
+			},
+		},
+		"when no account has the email": {
+			email: "nobody@example.com",
+			prepare: func(f *fields) {
+				allowRequest(f, "nobody@example.com")
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Email: "nobody@example.com"}).Return(nil, nil)
+			},
+		},
+		"when the email asked too many times": {
+			email: "jane@example.com",
+			prepare: func(f *fields) {
+				f.requests.EXPECT().CountByEmailSince(gomock.Any(), "jane@example.com", gomock.Any()).Return(3, nil)
+			},
+		},
+		"when the IP asked too many times": {
+			email: "jane@example.com",
+			prepare: func(f *fields) {
+				f.requests.EXPECT().CountByEmailSince(gomock.Any(), "jane@example.com", gomock.Any()).Return(0, nil)
+				f.requests.EXPECT().CountByIPSince(gomock.Any(), "203.0.113.7", gomock.Any()).Return(20, nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				requests:   mock_user_password_reset_request.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{User: f.repository, Outbox: f.outbox, Audit: f.audit})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User:          f.repository,
+						PasswordReset: f.requests,
+						Outbox:        f.outbox,
+					},
+					Transactor: f.transactor,
+					ConfigService: &config.ConfigurationService{
+						GCPConfig: config.GCPConfig{
+							OAuth2Config: config.OAuth2Config{FrontendURL: "https://app.example.com"},
+						},
+						PasswordReset: config.PasswordResetConfig{
+							EmailHourlyLimit: 3,
+							IPHourlyLimit:    20,
+							MinResponseTime:  20 * time.Millisecond,
+						},
+					},
+				}
+			}
+
+			ctx := requestinfo.WithInfo(context.Background(), requestinfo.Info{IP: "203.0.113.7"})
+
+			start := time.Now()
+			uc := usecase.NewRequestResetPasswordUsecase(contextFactory)
+			output, err := uc.Execute(ctx, tc.email)
+
+			assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, tc.email, output.Data.Email)
+				assert.Equal(t, "If an account exists for this email, we have sent instructions to reset its password", output.Data.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/notification"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+const defaultVerificationResendInterval = 5 * time.Minute
+
+// ErrVerificationResendTooSoon is returned when the previous verification
+// email was sent less than the configured interval ago.
+var ErrVerificationResendTooSoon = errors.New("a verification email was sent recently, try again later")
+
+type (
+	ResendVerificationUsecase interface {
+		Execute(context.Context, string) (*ResendVerificationOutput, error)
+	}
+
+	resendVerificationUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	ResendVerificationOutput struct {
+		Data ResendVerificationOutputData `json:"data"`
+	}
+
+	ResendVerificationOutputData struct {
+		Email             string    `json:"email"`
+		ExpiresAt         time.Time `json:"expires_at"`
+		RetryAfterSeconds int       `json:"retry_after_seconds"`
+	}
+)
+
+func NewResendVerificationUsecase(contextFactory appcontext.Factory) ResendVerificationUsecase {
+	return &resendVerificationUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+// Execute replaces the user's verification token, which invalidates the
+// link sent before, and emails a new one. The time the previous token was
+// issued throttles how often this can be done.
+func (u *resendVerificationUsecase) Execute(ctx context.Context, username string) (*ResendVerificationOutput, error) {
+	app := u.contextFactory()
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Username: username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	if user.VerifiedEmail {
+		return nil, errors.New("email already verified")
+	}
+
+	interval := app.ConfigService.EmailVerification.ResendInterval
+	if interval <= 0 {
+		interval = defaultVerificationResendInterval
+	}
+
+	if !user.VerifiedEmailTokenExpiry.IsZero() {
+		issuedAt := user.VerifiedEmailTokenExpiry.Add(-verifiedEmailTokenTTL)
+		if time.Since(issuedAt) < interval {
+			return nil, ErrVerificationResendTooSoon
+		}
+	}
+
+	verificationToken, err := AddVerifiedEmailToken(user)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := outbox_repo.NewMessage(string(queue.TopicSendEmail), notification.SendEmailInput{
+		To:           user.Email,
+		Subject:      "Confirmación de registro",
+		TemplateName: "email_verification",
+		Variables: map[string]string{
+			"name": user.FirstName + " " + user.LastName,
+			"link": app.ConfigService.ServerConfig.Host + "/auth/verify-email?token=" + verificationToken,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if _, err := repos.User.Update(ctx, user.ID, user_repo.UpdateFields{
+			VerifiedEmailToken:       &user.VerifiedEmailToken,
+			VerifiedEmailTokenExpiry: &user.VerifiedEmailTokenExpiry,
+		}); err != nil {
+			return err
+		}
+
+		_, err := repos.Outbox.Create(ctx, message)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResendVerificationOutput{
+		Data: ResendVerificationOutputData{
+			Email:             user.Email,
+			ExpiresAt:         user.VerifiedEmailTokenExpiry,
+			RetryAfterSeconds: int(math.Ceil(interval.Seconds())),
+		},
+	}, nil
+}
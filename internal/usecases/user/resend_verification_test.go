@@ -0,0 +1,130 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResendVerificationUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+	}
+
+	week := 7 * 24 * time.Hour
+
+	tests := map[string]struct {
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when the previous email is old enough": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(&domain.User{
+					ID:                       "user-123",
+					Email:                    "jane@example.com",
+					VerifiedEmailToken:       "old-hash",
+					VerifiedEmailTokenExpiry: time.Now().Add(week - 10*time.Minute),
+				}, nil)
+				f.repository.EXPECT().Update(gomock.Any(), "user-123", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, id string, fields user_repo.UpdateFields) (string, error) {
+						assert.Len(t, *fields.VerifiedEmailToken, 64)
+						assert.NotEqual(t, "old-hash", *fields.VerifiedEmailToken)
+						assert.WithinDuration(t, time.Now().Add(week), *fields.VerifiedEmailTokenExpiry, time.Minute)
+						assert.Nil(t, fields.VerifiedEmail)
+						return id, nil
+					},
+				)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						payload := string(message.Payload)
+						assert.Contains(t, payload, `"email_verification"`)
+						assert.Contains(t, payload, "http://localhost:8080/auth/verify-email?token=")
+						return message.ID, nil
+					},
+				)
+			},
+		},
+		"when the previous email was sent recently": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(&domain.User{
+					ID:                       "user-123",
+					VerifiedEmailToken:       "old-hash",
+					VerifiedEmailTokenExpiry: time.Now().Add(week - time.Minute),
+				}, nil)
+			},
+			expectedErr: usecase.ErrVerificationResendTooSoon,
+		},
+		"when the email is already verified": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(&domain.User{
+					ID:            "user-123",
+					VerifiedEmail: true,
+				}, nil)
+			},
+			expectedErr: errors.New("email already verified"),
+		},
+		"when the user does not exist": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(nil, nil)
+			},
+			expectedErr: errors.New("user not found"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{User: f.repository, Outbox: f.outbox})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User: f.repository,
+					},
+					Transactor: f.transactor,
+					ConfigService: &config.ConfigurationService{
+						ServerConfig:      config.ServerConfig{Host: "http://localhost:8080"},
+						EmailVerification: config.EmailVerificationConfig{ResendInterval: 5 * time.Minute},
+					},
+				}
+			}
+
+			uc := usecase.NewResendVerificationUsecase(contextFactory)
+			output, err := uc.Execute(context.Background(), "jdoe")
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, "jane@example.com", output.Data.Email)
+				assert.Equal(t, 300, output.Data.RetryAfterSeconds)
+			}
+		})
+	}
+}
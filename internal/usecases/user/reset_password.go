@@ -2,10 +2,15 @@ package user
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"time"
 
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
 	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
 	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
 )
@@ -38,25 +43,31 @@ func NewResetPasswordUsecase(contextFactory appcontext.Factory) ResetPasswordUse
 func (u *resetPasswordUsecase) Execute(ctx context.Context, input ResetPasswordInput) (*ResetPasswordOutput, error) {
 	app := u.contextFactory()
 
+	tokenHash := auth.HashOneTimeToken(input.Token)
+
 	user, err := app.Repositories.User.Get(
 		ctx,
 		user_repo.GetFilterOptions{
-			PasswordResetToken: input.Token,
+			PasswordResetToken: tokenHash,
 		},
 	)
 	if err != nil {
 		return nil, errors.New("token expired or invalid")
 	}
 
-	if user == nil {
+	if user == nil || user.PasswordResetToken == nil || !auth.OneTimeTokenMatches(input.Token, *user.PasswordResetToken) {
 		return nil, errors.New("token expired or invalid")
 	}
 
-	if time.Now().After(*user.PasswordResetTokenExpiry) {
+	if user.PasswordResetTokenExpiry == nil || time.Now().After(*user.PasswordResetTokenExpiry) {
 		return nil, errors.New("token expired or invalid")
 	}
 
-	if err := auth.ValidatePasswordStrength(input.Password); err != nil {
+	if err := auth.ValidatePassword(input.Password, user.FirstName, user.LastName, user.Email); err != nil {
+		return nil, err
+	}
+
+	if err := checkPasswordReuse(ctx, app, user, input.Password); err != nil {
 		return nil, err
 	}
 
@@ -67,15 +78,40 @@ func (u *resetPasswordUsecase) Execute(ctx context.Context, input ResetPasswordI
 
 	user.Password = string(hashedPassword)
 
-	if _, err = app.Repositories.User.Update(
-		ctx,
-		user.ID,
-		user,
-	); err != nil {
-		return nil, err
-	}
-
-	if err := app.Repositories.User.InvalidatePasswordResetToken(ctx, user.ID); err != nil {
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if _, err := repos.User.Update(ctx, user.ID, user_repo.UpdateFields{
+			Password: &user.Password,
+		}); err != nil {
+			return err
+		}
+
+		if err := repos.User.ConsumePasswordResetToken(ctx, user.ID, tokenHash); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.New("token expired or invalid")
+			}
+			return err
+		}
+
+		if err := recordPasswordHistory(ctx, app, repos, user.ID, user.Password); err != nil {
+			return err
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionPasswordReset,
+			domain.UserActor(user.ID),
+			domain.UserTarget(user.ID),
+			nil,
+		)); err != nil {
+			return err
+		}
+
+		return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserPasswordChanged,
+			domain.UserActor(user.Username),
+			domain.NewUserEventData(user),
+		))
+	})
+	if err != nil {
 		return nil, err
 	}
 
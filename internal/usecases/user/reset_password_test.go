@@ -2,17 +2,23 @@ package user_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
 	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
 	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	mock_user_password_history "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/password_history/mocks"
 	"github.com/tapiaw38/auth-api-be/internal/domain"
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
 	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
 	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
 	"go.uber.org/mock/gomock"
 )
@@ -20,12 +26,20 @@ import (
 func TestResetPasswordUsecase(t *testing.T) {
 	type fields struct {
 		repository *mock_user.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+		history    *mock_user_password_history.MockRepository
 	}
 
 	now := time.Now()
 	expiredTime := now.Add(-time.Hour)
 	validTime := now.Add(time.Hour)
 	hashedPassword, _ := auth.HashedPassword("oldpassword")
+	hashedToken := func(token string) *string {
+		hash := auth.HashOneTimeToken(token)
+		return &hash
+	}
 
 	tests := map[string]struct {
 		token       string
@@ -41,12 +55,17 @@ func TestResetPasswordUsecase(t *testing.T) {
 					ID:                       "user-123",
 					Email:                    "test@example.com",
 					Password:                 string(hashedPassword),
-					PasswordResetToken:       &(&struct{s string}{"valid-token"}).s,
+					PasswordResetToken:       hashedToken("valid-token"),
 					PasswordResetTokenExpiry: &validTime,
 				}
-				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{PasswordResetToken: "valid-token"}).Return(user, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{PasswordResetToken: auth.HashOneTimeToken("valid-token")}).Return(user, nil)
+				f.history.EXPECT().ListRecent(gomock.Any(), "user-123", 5).Return(nil, nil)
 				f.repository.EXPECT().Update(gomock.Any(), "user-123", gomock.Any()).Return("user-123", nil)
-				f.repository.EXPECT().InvalidatePasswordResetToken(gomock.Any(), "user-123").Return(nil)
+				f.repository.EXPECT().ConsumePasswordResetToken(gomock.Any(), "user-123", auth.HashOneTimeToken("valid-token")).Return(nil)
+				f.history.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				f.history.EXPECT().Prune(gomock.Any(), "user-123", 5).Return(nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).Return("event-1", nil)
 			},
 			expectedErr: nil,
 		},
@@ -54,7 +73,7 @@ func TestResetPasswordUsecase(t *testing.T) {
 			token:    "invalid-token",
 			password: "NewPassword123!",
 			prepare: func(f *fields) {
-				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{PasswordResetToken: "invalid-token"}).Return(nil, errors.New("user not found"))
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{PasswordResetToken: auth.HashOneTimeToken("invalid-token")}).Return(nil, errors.New("user not found"))
 			},
 			expectedErr: errors.New("token expired or invalid"),
 		},
@@ -62,7 +81,7 @@ func TestResetPasswordUsecase(t *testing.T) {
 			token:    "invalid-token2",
 			password: "NewPassword123!",
 			prepare: func(f *fields) {
-				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{PasswordResetToken: "invalid-token2"}).Return(nil, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{PasswordResetToken: auth.HashOneTimeToken("invalid-token2")}).Return(nil, nil)
 			},
 			expectedErr: errors.New("token expired or invalid"),
 		},
@@ -72,10 +91,10 @@ func TestResetPasswordUsecase(t *testing.T) {
 			prepare: func(f *fields) {
 				user := &domain.User{
 					ID:                       "user-123",
-					PasswordResetToken:       &(&struct{s string}{"expired-token"}).s,
+					PasswordResetToken:       hashedToken("expired-token"),
 					PasswordResetTokenExpiry: &expiredTime,
 				}
-				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{PasswordResetToken: "expired-token"}).Return(user, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{PasswordResetToken: auth.HashOneTimeToken("expired-token")}).Return(user, nil)
 			},
 			expectedErr: errors.New("token expired or invalid"),
 		},
@@ -87,10 +106,11 @@ func TestResetPasswordUsecase(t *testing.T) {
 					ID:                       "user-123",
 					Email:                    "test@example.com",
 					Password:                 string(hashedPassword),
-					PasswordResetToken:       &(&struct{s string}{"valid-token-update-fail"}).s,
+					PasswordResetToken:       hashedToken("valid-token-update-fail"),
 					PasswordResetTokenExpiry: &validTime,
 				}
-				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{PasswordResetToken: "valid-token-update-fail"}).Return(user, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{PasswordResetToken: auth.HashOneTimeToken("valid-token-update-fail")}).Return(user, nil)
+				f.history.EXPECT().ListRecent(gomock.Any(), "user-123", 5).Return(nil, nil)
 				f.repository.EXPECT().Update(gomock.Any(), "user-123", gomock.Any()).Return("", errors.New("update failed"))
 			},
 			expectedErr: errors.New("update failed"),
@@ -103,15 +123,34 @@ func TestResetPasswordUsecase(t *testing.T) {
 					ID:                       "user-123",
 					Email:                    "test@example.com",
 					Password:                 string(hashedPassword),
-					PasswordResetToken:       &(&struct{s string}{"valid-token-invalidate-fail"}).s,
+					PasswordResetToken:       hashedToken("valid-token-invalidate-fail"),
 					PasswordResetTokenExpiry: &validTime,
 				}
-				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{PasswordResetToken: "valid-token-invalidate-fail"}).Return(user, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{PasswordResetToken: auth.HashOneTimeToken("valid-token-invalidate-fail")}).Return(user, nil)
+				f.history.EXPECT().ListRecent(gomock.Any(), "user-123", 5).Return(nil, nil)
 				f.repository.EXPECT().Update(gomock.Any(), "user-123", gomock.Any()).Return("user-123", nil)
-				f.repository.EXPECT().InvalidatePasswordResetToken(gomock.Any(), "user-123").Return(errors.New("invalidation failed"))
+				f.repository.EXPECT().ConsumePasswordResetToken(gomock.Any(), "user-123", auth.HashOneTimeToken("valid-token-invalidate-fail")).Return(errors.New("invalidation failed"))
 			},
 			expectedErr: errors.New("invalidation failed"),
 		},
+		"token already used": {
+			token:    "valid-token",
+			password: "NewPassword123!",
+			prepare: func(f *fields) {
+				user := &domain.User{
+					ID:                       "user-123",
+					Email:                    "test@example.com",
+					Password:                 string(hashedPassword),
+					PasswordResetToken:       hashedToken("valid-token"),
+					PasswordResetTokenExpiry: &validTime,
+				}
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{PasswordResetToken: auth.HashOneTimeToken("valid-token")}).Return(user, nil)
+				f.history.EXPECT().ListRecent(gomock.Any(), "user-123", 5).Return(nil, nil)
+				f.repository.EXPECT().Update(gomock.Any(), "user-123", gomock.Any()).Return("user-123", nil)
+				f.repository.EXPECT().ConsumePasswordResetToken(gomock.Any(), "user-123", auth.HashOneTimeToken("valid-token")).Return(sql.ErrNoRows)
+			},
+			expectedErr: errors.New("token expired or invalid"),
+		},
 	}
 
 	for name, tc := range tests {
@@ -121,8 +160,17 @@ func TestResetPasswordUsecase(t *testing.T) {
 
 			f := fields{
 				repository: mock_user.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+				history:    mock_user_password_history.NewMockRepository(ctrl),
 			}
 
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{User: f.repository, Outbox: f.outbox, Audit: f.audit, PasswordHistory: f.history})
+				}).AnyTimes()
+
 			if tc.prepare != nil {
 				tc.prepare(&f)
 			}
@@ -130,7 +178,12 @@ func TestResetPasswordUsecase(t *testing.T) {
 			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
 				return &appcontext.Context{
 					Repositories: &repositories.Repositories{
-						User: f.repository,
+						User:            f.repository,
+						PasswordHistory: f.history,
+					},
+					Transactor: f.transactor,
+					ConfigService: &config.ConfigurationService{
+						PasswordPolicy: config.PasswordPolicyConfig{HistorySize: 5},
 					},
 				}
 			}
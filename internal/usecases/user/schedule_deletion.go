@@ -0,0 +1,116 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/queue"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/notification"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+const defaultDeletionGracePeriod = 30 * 24 * time.Hour
+
+type (
+	// ScheduleDeletionUsecase queues the account for erasure once the grace
+	// period ends. The account keeps working until then and the request can
+	// be cancelled.
+	ScheduleDeletionUsecase interface {
+		Execute(context.Context, ScheduleDeletionInput) (*ScheduleDeletionOutput, error)
+	}
+
+	scheduleDeletionUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	// ScheduleDeletionInput identifies the account to delete. The route
+	// requires a recent authentication, see RequireRecentAuth.
+	ScheduleDeletionInput struct {
+		Username string
+	}
+
+	ScheduleDeletionOutput struct {
+		Data DeletionOutputData `json:"data"`
+	}
+
+	DeletionOutputData struct {
+		RequestedAt  time.Time `json:"requested_at"`
+		ScheduledFor time.Time `json:"scheduled_for"`
+	}
+)
+
+func NewScheduleDeletionUsecase(contextFactory appcontext.Factory) ScheduleDeletionUsecase {
+	return &scheduleDeletionUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *scheduleDeletionUsecase) Execute(ctx context.Context, input ScheduleDeletionInput) (*ScheduleDeletionOutput, error) {
+	app := u.contextFactory()
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Username: input.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	gracePeriod := app.ConfigService.Privacy.DeletionGracePeriod
+	if gracePeriod <= 0 {
+		gracePeriod = defaultDeletionGracePeriod
+	}
+
+	var deletion *domain.AccountDeletion
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		deletion, err = repos.Deletion.Schedule(ctx, user.ID, time.Now().Add(gracePeriod))
+		if err != nil {
+			return err
+		}
+
+		notice, err := outbox_repo.NewMessage(string(queue.TopicSendEmail), notification.SendEmailInput{
+			To:           user.Email,
+			Subject:      "Eliminación de cuenta programada",
+			TemplateName: "account_deletion_scheduled",
+			Variables: map[string]string{
+				"name": user.FirstName + " " + user.LastName,
+				"date": deletion.ScheduledFor.UTC().Format("02/01/2006 15:04 MST"),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, err := repos.Outbox.Create(ctx, notice); err != nil {
+			return err
+		}
+
+		return audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionDeletionScheduled,
+			domain.UserActor(user.ID),
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"scheduled_for": deletion.ScheduledFor.UTC().Format(time.RFC3339),
+			},
+		))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScheduleDeletionOutput{
+		Data: DeletionOutputData{
+			RequestedAt:  deletion.RequestedAt,
+			ScheduledFor: deletion.ScheduledFor,
+		},
+	}, nil
+}
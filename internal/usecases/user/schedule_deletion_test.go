@@ -0,0 +1,143 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user_deletion "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/deletion/mocks"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestScheduleDeletionUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		deletion   *mock_user_deletion.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+	}
+
+	hash, err := auth.HashedPassword("Password123!")
+	assert.NoError(t, err)
+
+	currentUser := &domain.User{
+		ID:        "user-123",
+		Username:  "jdoe",
+		Email:     "john@example.com",
+		FirstName: "John",
+		LastName:  "Doe",
+		Password:  string(hash),
+	}
+
+	gracePeriod := 72 * time.Hour
+
+	tests := map[string]struct {
+		input       usecase.ScheduleDeletionInput
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when the deletion is scheduled after the grace period": {
+			input: usecase.ScheduleDeletionInput{},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(currentUser, nil)
+				f.deletion.EXPECT().Schedule(gomock.Any(), "user-123", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, userID string, scheduledFor time.Time) (*domain.AccountDeletion, error) {
+						assert.WithinDuration(t, time.Now().Add(gracePeriod), scheduledFor, time.Minute)
+						return &domain.AccountDeletion{UserID: userID, RequestedAt: time.Now(), ScheduledFor: scheduledFor}, nil
+					},
+				)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						assert.Contains(t, string(message.Payload), `"to":"john@example.com"`)
+						assert.Contains(t, string(message.Payload), "account_deletion_scheduled")
+						return message.ID, nil
+					},
+				)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, domain.AuditActionDeletionScheduled, event.Action)
+						return 1, nil
+					},
+				)
+			},
+		},
+		"when scheduling fails": {
+			input: usecase.ScheduleDeletionInput{},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(currentUser, nil)
+				f.deletion.EXPECT().Schedule(gomock.Any(), "user-123", gomock.Any()).Return(nil, errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+		"when the user does not exist": {
+			input: usecase.ScheduleDeletionInput{},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(nil, nil)
+			},
+			expectedErr: errors.New("user not found"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				deletion:   mock_user_deletion.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{
+						User:     f.repository,
+						Deletion: f.deletion,
+						Outbox:   f.outbox,
+						Audit:    f.audit,
+					})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User: f.repository,
+					},
+					Transactor: f.transactor,
+					ConfigService: &config.ConfigurationService{
+						Privacy: config.PrivacyConfig{DeletionGracePeriod: gracePeriod},
+					},
+				}
+			}
+
+			tc.input.Username = "jdoe"
+
+			uc := usecase.NewScheduleDeletionUsecase(contextFactory)
+			output, err := uc.Execute(context.Background(), tc.input)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.WithinDuration(t, time.Now().Add(gracePeriod), output.Data.ScheduledFor, time.Minute)
+			}
+		})
+	}
+}
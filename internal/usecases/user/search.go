@@ -0,0 +1,93 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	// minSearchQueryLength keeps one or two letter queries, which match
+	// nearly everyone by prefix, from scanning the whole table.
+	minSearchQueryLength = 3
+	maxSearchQueryLength = 100
+)
+
+// ErrInvalidSearchQuery is returned for a query that is too short or too
+// long to search for.
+var ErrInvalidSearchQuery = errors.New("search query must be between 3 and 100 characters")
+
+type (
+	SearchUsecase interface {
+		Execute(context.Context, SearchInput) (*SearchOutput, error)
+	}
+
+	searchUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	SearchInput struct {
+		Query string
+		Limit int
+	}
+
+	// SearchOutput lists the matches best first. Highlight is the user's
+	// name and email as escaped HTML, with the matched words in <mark>.
+	SearchOutput struct {
+		Data []SearchResultData `json:"data"`
+	}
+
+	SearchResultData struct {
+		User      UserOutputData `json:"user"`
+		Rank      float64        `json:"rank"`
+		Highlight string         `json:"highlight"`
+	}
+)
+
+func NewSearchUsecase(contextFactory appcontext.Factory) SearchUsecase {
+	return &searchUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *searchUsecase) Execute(ctx context.Context, input SearchInput) (*SearchOutput, error) {
+	app := u.contextFactory()
+
+	query := strings.TrimSpace(input.Query)
+	if n := utf8.RuneCountInString(query); n < minSearchQueryLength || n > maxSearchQueryLength {
+		return nil, ErrInvalidSearchQuery
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	results, err := app.Repositories.User.Search(ctx, user.SearchOptions{
+		Query: query,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]SearchResultData, 0, len(results))
+	for _, result := range results {
+		data = append(data, SearchResultData{
+			User:      toUserOutputData(result.User),
+			Rank:      result.Rank,
+			Highlight: result.Highlight,
+		})
+	}
+
+	return &SearchOutput{Data: data}, nil
+}
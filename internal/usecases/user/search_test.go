@@ -0,0 +1,118 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSearchUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+	}
+
+	tests := map[string]struct {
+		input       usecase.SearchInput
+		prepare     func(f *fields)
+		expected    *usecase.SearchOutput
+		expectedErr error
+	}{
+		"when users match": {
+			input: usecase.SearchInput{Query: "  jane  "},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Search(gomock.Any(), user_repo.SearchOptions{
+					Query: "jane",
+					Limit: 20,
+				}).Return([]*user_repo.SearchResult{
+					{
+						User:      &domain.User{ID: "user-1", FirstName: "Jane", Email: "jane@example.com"},
+						Rank:      0.9,
+						Highlight: "<mark>Jane</mark> jane@example.com",
+					},
+				}, nil)
+			},
+			expected: &usecase.SearchOutput{
+				Data: []usecase.SearchResultData{
+					{
+						User: usecase.UserOutputData{
+							ID:        "user-1",
+							FirstName: "Jane",
+							Email:     "jane@example.com",
+						},
+						Rank:      0.9,
+						Highlight: "<mark>Jane</mark> jane@example.com",
+					},
+				},
+			},
+		},
+		"when nothing matches the limit is capped": {
+			input: usecase.SearchInput{Query: "555", Limit: 1000},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Search(gomock.Any(), user_repo.SearchOptions{
+					Query: "555",
+					Limit: 100,
+				}).Return(nil, nil)
+			},
+			expected: &usecase.SearchOutput{Data: []usecase.SearchResultData{}},
+		},
+		"when the query is too short": {
+			input:       usecase.SearchInput{Query: " ja "},
+			expectedErr: usecase.ErrInvalidSearchQuery,
+		},
+		"when the query is too long": {
+			input:       usecase.SearchInput{Query: strings.Repeat("a", 101)},
+			expectedErr: usecase.ErrInvalidSearchQuery,
+		},
+		"when the repository returns an error": {
+			input: usecase.SearchInput{Query: "jane"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+			}
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User: f.repository,
+					},
+				}
+			}
+
+			uc := usecase.NewSearchUsecase(contextFactory)
+			result, err := uc.Execute(context.Background(), tc.input)
+
+			if tc.expectedErr != nil {
+				assert.EqualError(t, err, tc.expectedErr.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
 	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
 	"github.com/tapiaw38/auth-api-be/internal/domain"
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
@@ -49,7 +52,11 @@ func (u *setPasswordUsecase) Execute(ctx context.Context, input SetPasswordInput
 		return errors.New("only SSO users can set initial password")
 	}
 
-	if err := auth.ValidatePasswordStrength(input.NewPassword); err != nil {
+	if err := auth.ValidatePassword(input.NewPassword, user.FirstName, user.LastName, user.Email); err != nil {
+		return err
+	}
+
+	if err := checkPasswordReuse(ctx, app, user, input.NewPassword); err != nil {
 		return err
 	}
 
@@ -61,6 +68,31 @@ func (u *setPasswordUsecase) Execute(ctx context.Context, input SetPasswordInput
 	user.Password = string(hashedPassword)
 	user.AuthMethod = string(domain.AuthMethodHybrid)
 
-	_, err = app.Repositories.User.Update(ctx, user.ID, user)
-	return err
+	return app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if _, err := repos.User.Update(ctx, user.ID, user_repo.UpdateFields{
+			Password:   &user.Password,
+			AuthMethod: &user.AuthMethod,
+		}); err != nil {
+			return err
+		}
+
+		if err := recordPasswordHistory(ctx, app, repos, user.ID, user.Password); err != nil {
+			return err
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionPasswordSet,
+			domain.UserActor(user.ID),
+			domain.UserTarget(user.ID),
+			nil,
+		)); err != nil {
+			return err
+		}
+
+		return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserPasswordChanged,
+			domain.UserActor(user.Username),
+			domain.NewUserEventData(user),
+		))
+	})
 }
@@ -0,0 +1,128 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/sms"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	StartPhoneVerificationUsecase interface {
+		Execute(context.Context, string) (*StartPhoneVerificationOutput, error)
+	}
+
+	startPhoneVerificationUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	StartPhoneVerificationOutput struct {
+		Data PhoneVerificationOutputData `json:"data"`
+	}
+
+	PhoneVerificationOutputData struct {
+		PhoneNumber       string    `json:"phone_number"`
+		ExpiresAt         time.Time `json:"expires_at"`
+		RetryAfterSeconds int       `json:"retry_after_seconds"`
+	}
+)
+
+func NewStartPhoneVerificationUsecase(contextFactory appcontext.Factory) StartPhoneVerificationUsecase {
+	return &startPhoneVerificationUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+// Execute sends a new code to the phone number on the profile. Requests are
+// throttled per user, both between sends and per hour.
+func (u *startPhoneVerificationUsecase) Execute(ctx context.Context, username string) (*StartPhoneVerificationOutput, error) {
+	app := u.contextFactory()
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Username: username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	if user.PhoneNumber == nil || *user.PhoneNumber == "" {
+		return nil, errors.New("phone number is not set")
+	}
+
+	if user.VerifiedPhone {
+		return nil, errors.New("phone number already verified")
+	}
+
+	latest, err := app.Repositories.PhoneVerification.GetLatest(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if latest != nil {
+		if wait := phoneCodeResendInterval - time.Since(latest.CreatedAt); wait > 0 {
+			return nil, fmt.Errorf("please wait %d seconds before requesting a new code", int(math.Ceil(wait.Seconds())))
+		}
+	}
+
+	sent, err := app.Repositories.PhoneVerification.CountSince(ctx, user.ID, time.Now().Add(-time.Hour))
+	if err != nil {
+		return nil, err
+	}
+
+	if sent >= phoneCodeHourlyLimit {
+		return nil, errors.New("too many codes requested, try again later")
+	}
+
+	code, err := generatePhoneCode()
+	if err != nil {
+		return nil, err
+	}
+
+	verification := domain.PhoneVerification{
+		ID:          uuid.NewString(),
+		UserID:      user.ID,
+		PhoneNumber: *user.PhoneNumber,
+		ExpiresAt:   time.Now().Add(phoneCodeTTL),
+	}
+	verification.CodeHash = hashPhoneCode(app.ConfigService.ServerConfig.JWTSecret, verification.ID, code)
+
+	if _, err := app.Repositories.PhoneVerification.Create(ctx, verification); err != nil {
+		return nil, err
+	}
+
+	err = app.Integrations.SMS.Send(ctx, sms.SendInput{
+		To: verification.PhoneNumber,
+		Message: fmt.Sprintf(
+			"Tu código de verificación es %s. Vence en %d minutos.",
+			code,
+			int(phoneCodeTTL.Minutes()),
+		),
+	})
+	if err != nil {
+		// A code that never arrived must not count against the throttle.
+		if deleteErr := app.Repositories.PhoneVerification.Delete(ctx, verification.ID); deleteErr != nil {
+			log.Printf("failed to delete unsent phone verification %s: %v", verification.ID, deleteErr)
+		}
+		return nil, err
+	}
+
+	return &StartPhoneVerificationOutput{
+		Data: PhoneVerificationOutputData{
+			PhoneNumber:       maskPhoneNumber(verification.PhoneNumber),
+			ExpiresAt:         verification.ExpiresAt,
+			RetryAfterSeconds: int(phoneCodeResendInterval.Seconds()),
+		},
+	}, nil
+}
@@ -0,0 +1,138 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	mock_user_phone_verification "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/phone_verification/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/sms"
+	mock_sms "github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/sms/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStartPhoneVerificationUsecase(t *testing.T) {
+	type fields struct {
+		repository   *mock_user.MockRepository
+		verification *mock_user_phone_verification.MockRepository
+		sms          *mock_sms.MockIntegration
+	}
+
+	phone := "+14155550123"
+	userWithPhone := &domain.User{ID: "user-123", Username: "jdoe", PhoneNumber: &phone}
+
+	tests := map[string]struct {
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when the code is sent": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(userWithPhone, nil)
+				f.verification.EXPECT().GetLatest(gomock.Any(), "user-123").
+					Return(&domain.PhoneVerification{CreatedAt: time.Now().Add(-2 * time.Minute)}, nil)
+				f.verification.EXPECT().CountSince(gomock.Any(), "user-123", gomock.Any()).Return(1, nil)
+				f.verification.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, verification domain.PhoneVerification) (string, error) {
+						assert.Equal(t, phone, verification.PhoneNumber)
+						assert.Len(t, verification.CodeHash, 64)
+						return verification.ID, nil
+					},
+				)
+				f.sms.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, input sms.SendInput) error {
+						assert.Equal(t, phone, input.To)
+						assert.Regexp(t, regexp.MustCompile(`\b\d{6}\b`), input.Message)
+						return nil
+					},
+				)
+			},
+		},
+		"when the phone number is not set": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&domain.User{ID: "user-123"}, nil)
+			},
+			expectedErr: errors.New("phone number is not set"),
+		},
+		"when the phone number is already verified": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(&domain.User{ID: "user-123", PhoneNumber: &phone, VerifiedPhone: true}, nil)
+			},
+			expectedErr: errors.New("phone number already verified"),
+		},
+		"when a code was sent moments ago": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(userWithPhone, nil)
+				f.verification.EXPECT().GetLatest(gomock.Any(), "user-123").
+					Return(&domain.PhoneVerification{CreatedAt: time.Now().Add(-30 * time.Second)}, nil)
+			},
+			expectedErr: errors.New("please wait 30 seconds before requesting a new code"),
+		},
+		"when the hourly limit is reached": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(userWithPhone, nil)
+				f.verification.EXPECT().GetLatest(gomock.Any(), "user-123").Return(nil, nil)
+				f.verification.EXPECT().CountSince(gomock.Any(), "user-123", gomock.Any()).Return(5, nil)
+			},
+			expectedErr: errors.New("too many codes requested, try again later"),
+		},
+		"when the SMS cannot be sent": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(userWithPhone, nil)
+				f.verification.EXPECT().GetLatest(gomock.Any(), "user-123").Return(nil, nil)
+				f.verification.EXPECT().CountSince(gomock.Any(), "user-123", gomock.Any()).Return(0, nil)
+				f.verification.EXPECT().Create(gomock.Any(), gomock.Any()).Return("verification-1", nil)
+				f.sms.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("gateway down"))
+				f.verification.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedErr: errors.New("gateway down"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository:   mock_user.NewMockRepository(ctrl),
+				verification: mock_user_phone_verification.NewMockRepository(ctrl),
+				sms:          mock_sms.NewMockIntegration(ctrl),
+			}
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User:              f.repository,
+						PhoneVerification: f.verification,
+					},
+					Integrations: &integrations.Integrations{SMS: f.sms},
+					ConfigService: &config.ConfigurationService{
+						ServerConfig: config.ServerConfig{JWTSecret: "secret"},
+					},
+				}
+			}
+
+			uc := usecase.NewStartPhoneVerificationUsecase(contextFactory)
+			output, err := uc.Execute(context.Background(), "jdoe")
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, "********0123", output.Data.PhoneNumber)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+const maxSuspensionReasonLength = 500
+
+type (
+	// SuspendUsecase deactivates an account and invalidates its tokens.
+	// A suspension with an end date is lifted on the first login after it.
+	SuspendUsecase interface {
+		Execute(context.Context, SuspendInput) (*SuspendOutput, error)
+	}
+
+	suspendUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	// SuspendInput identifies the user to suspend. Until is optional; Actor
+	// is the username of the admin making the change.
+	SuspendInput struct {
+		UserID string     `json:"-"`
+		Reason string     `json:"reason"`
+		Until  *time.Time `json:"until"`
+		Actor  string     `json:"-"`
+	}
+
+	SuspendOutput struct {
+		Data UserOutputData `json:"data"`
+	}
+)
+
+func NewSuspendUsecase(contextFactory appcontext.Factory) SuspendUsecase {
+	return &suspendUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *suspendUsecase) Execute(ctx context.Context, input SuspendInput) (*SuspendOutput, error) {
+	app := u.contextFactory()
+
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" {
+		return nil, errors.New("reason is required")
+	}
+	if len(reason) > maxSuspensionReasonLength {
+		return nil, errors.New("reason must be at most 500 characters")
+	}
+	if input.Until != nil && !input.Until.After(time.Now()) {
+		return nil, errors.New("suspension end date must be in the future")
+	}
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		ID: input.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	actor, caller, err := audit_repo.Actor(ctx, app.Repositories.User, input.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	if actor.ID == user.ID {
+		return nil, errors.New("you cannot suspend your own account")
+	}
+
+	if err := checkOutranks(caller, user); err != nil {
+		return nil, err
+	}
+
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if err := repos.User.Suspend(ctx, user.ID, reason, input.Until); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.New("user not found")
+			}
+			return err
+		}
+
+		now := time.Now().UTC()
+		user.IsActive = false
+		user.SuspendedAt = &now
+		user.SuspendedUntil = input.Until
+		user.SuspensionReason = &reason
+		user.TokenVersion++
+
+		metadata := map[string]any{
+			"reason": reason,
+		}
+		if input.Until != nil {
+			metadata["until"] = input.Until.UTC().Format(time.RFC3339)
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionUserSuspended,
+			actor,
+			domain.UserTarget(user.ID),
+			metadata,
+		)); err != nil {
+			return err
+		}
+
+		return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserDeactivated,
+			eventActor(input.Actor),
+			domain.NewUserEventData(user),
+		))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SuspendOutput{
+		Data: toUserOutputData(user),
+	}, nil
+}
+
+// checkOutranks allows caller to manage user only when caller holds a
+// strictly higher role. A nil caller is the system and is always allowed.
+func checkOutranks(caller *domain.User, user *domain.User) error {
+	if caller != nil && !domain.Outranks(caller.Roles, domain.HighestRank(user.Roles)) {
+		return domain.ErrInsufficientRole
+	}
+
+	return nil
+}
+
+func eventActor(username string) domain.EventActor {
+	if username == "" {
+		return domain.SystemActor()
+	}
+
+	return domain.UserActor(username)
+}
@@ -0,0 +1,155 @@
+package user_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSuspendUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+	}
+
+	until := time.Now().Add(48 * time.Hour)
+
+	target := func() *domain.User {
+		return &domain.User{ID: "user-123", Username: "jdoe", IsActive: true, TokenVersion: 3}
+	}
+	admin := &domain.User{ID: "admin-1", Username: "admin", Roles: []domain.Role{{Name: domain.RoleAdmin}}}
+
+	tests := map[string]struct {
+		input       usecase.SuspendInput
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when the user is suspended until a date": {
+			input: usecase.SuspendInput{UserID: "user-123", Reason: " spam ", Until: &until, Actor: "admin"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123"}).Return(target(), nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "admin"}).Return(admin, nil)
+				f.repository.EXPECT().Suspend(gomock.Any(), "user-123", "spam", &until).Return(nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, domain.AuditActionUserSuspended, event.Action)
+						assert.Equal(t, "admin-1", event.Actor.ID)
+						assert.Equal(t, "spam", event.Metadata["reason"])
+						assert.NotEmpty(t, event.Metadata["until"])
+						return 1, nil
+					},
+				)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, message domain.OutboxMessage) (string, error) {
+						assert.Equal(t, string(domain.EventUserDeactivated), message.Topic)
+						assert.Contains(t, string(message.Payload), `"is_active":false`)
+						return message.ID, nil
+					},
+				)
+			},
+		},
+		"when no reason is given": {
+			input:       usecase.SuspendInput{UserID: "user-123", Actor: "admin"},
+			prepare:     func(f *fields) {},
+			expectedErr: errors.New("reason is required"),
+		},
+		"when the end date is in the past": {
+			input:       usecase.SuspendInput{UserID: "user-123", Reason: "spam", Until: func() *time.Time { v := time.Now().Add(-time.Hour); return &v }(), Actor: "admin"},
+			prepare:     func(f *fields) {},
+			expectedErr: errors.New("suspension end date must be in the future"),
+		},
+		"when admins try to suspend themselves": {
+			input: usecase.SuspendInput{UserID: "admin-1", Reason: "test", Actor: "admin"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "admin-1"}).Return(admin, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "admin"}).Return(admin, nil)
+			},
+			expectedErr: errors.New("you cannot suspend your own account"),
+		},
+		"when the user is another admin": {
+			input: usecase.SuspendInput{UserID: "admin-2", Reason: "spam", Actor: "admin"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "admin-2"}).Return(&domain.User{
+					ID:       "admin-2",
+					IsActive: true,
+					Roles:    []domain.Role{{Name: domain.RoleAdmin}},
+				}, nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "admin"}).Return(admin, nil)
+			},
+			expectedErr: domain.ErrInsufficientRole,
+		},
+		"when the user was deleted meanwhile": {
+			input: usecase.SuspendInput{UserID: "user-123", Reason: "spam", Actor: "admin"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123"}).Return(target(), nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "admin"}).Return(admin, nil)
+				f.repository.EXPECT().Suspend(gomock.Any(), "user-123", "spam", nil).Return(sql.ErrNoRows)
+			},
+			expectedErr: errors.New("user not found"),
+		},
+		"when the user does not exist": {
+			input: usecase.SuspendInput{UserID: "user-404", Reason: "spam", Actor: "admin"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-404"}).Return(nil, nil)
+			},
+			expectedErr: errors.New("user not found"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{User: f.repository, Outbox: f.outbox, Audit: f.audit})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User: f.repository,
+					},
+					Transactor: f.transactor,
+				}
+			}
+
+			uc := usecase.NewSuspendUsecase(contextFactory)
+			output, err := uc.Execute(context.Background(), tc.input)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.False(t, output.Data.IsActive)
+				assert.Equal(t, uint(4), output.Data.TokenVersion)
+				assert.Equal(t, "spam", output.Data.Suspension.Reason)
+				assert.Equal(t, &until, output.Data.Suspension.Until)
+			}
+		})
+	}
+}
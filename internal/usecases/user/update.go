@@ -2,7 +2,11 @@ package user
 
 import (
 	"context"
+	"errors"
 
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
 	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
 	"github.com/tapiaw38/auth-api-be/internal/domain"
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
@@ -10,13 +14,25 @@ import (
 
 type (
 	UpdateUsecase interface {
-		Execute(context.Context, string, *domain.User) (*UpdateOutput, error)
+		Execute(context.Context, string, UpdateInput) (*UpdateOutput, error)
 	}
 
 	updateUsecase struct {
 		contextFactory appcontext.Factory
 	}
 
+	// UpdateInput holds the profile fields a user may change on their own
+	// account. Omitted fields are left as they are; an empty phone number,
+	// picture or address clears it. Email, password and activation status
+	// have dedicated flows and cannot be changed here.
+	UpdateInput struct {
+		FirstName   *string `json:"first_name"`
+		LastName    *string `json:"last_name"`
+		PhoneNumber *string `json:"phone_number"`
+		Picture     *string `json:"picture"`
+		Address     *string `json:"address"`
+	}
+
 	UpdateOutput struct {
 		Data UserOutputData `json:"data"`
 	}
@@ -28,16 +44,58 @@ func NewUpdateUsecase(contextFactory appcontext.Factory) UpdateUsecase {
 	}
 }
 
-func (u *updateUsecase) Execute(ctx context.Context, id string, user *domain.User) (*UpdateOutput, error) {
+func (u *updateUsecase) Execute(ctx context.Context, username string, input UpdateInput) (*UpdateOutput, error) {
 	app := u.contextFactory()
 
-	updatedID, err := app.Repositories.User.Update(ctx, id, user)
+	fields, changed, err := validateProfile(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(changed) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Username: username,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	updatedUser, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
-		ID: updatedID,
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	var updatedUser *domain.User
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if _, err := repos.User.Update(ctx, user.ID, fields); err != nil {
+			return err
+		}
+
+		updatedUser, err = repos.User.Get(ctx, user_repo.GetFilterOptions{
+			ID: user.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionUserUpdated,
+			domain.UserActor(user.ID),
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"fields": changed,
+			},
+		)); err != nil {
+			return err
+		}
+
+		return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserUpdated,
+			domain.UserActor(updatedUser.Username),
+			domain.NewUserEventData(updatedUser),
+		))
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,136 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdateUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+	}
+
+	str := func(s string) *string { return &s }
+
+	tests := map[string]struct {
+		input       usecase.UpdateInput
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when only the given fields are updated": {
+			input: usecase.UpdateInput{
+				FirstName:   str("  Jane "),
+				PhoneNumber: str("+1 (415) 555-0123"),
+				Address:     str(""),
+			},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).
+					Return(&domain.User{ID: "user-123", Username: "jdoe"}, nil)
+				f.repository.EXPECT().Update(gomock.Any(), "user-123", user_repo.UpdateFields{
+					FirstName:   str("Jane"),
+					PhoneNumber: str("+14155550123"),
+					Address:     str(""),
+				}).Return("user-123", nil)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123"}).
+					Return(&domain.User{ID: "user-123", Username: "jdoe", FirstName: "Jane"}, nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, event domain.AuditEvent) (int64, error) {
+						assert.Equal(t, domain.AuditActionUserUpdated, event.Action)
+						assert.Equal(t, []string{"first_name", "phone_number", "address"}, event.Metadata["fields"])
+						return 1, nil
+					},
+				)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).Return("event-1", nil)
+			},
+		},
+		"when no fields are given": {
+			input:       usecase.UpdateInput{},
+			expectedErr: errors.New("no fields to update"),
+		},
+		"when the first name is blank": {
+			input:       usecase.UpdateInput{FirstName: str("   ")},
+			expectedErr: errors.New("first name cannot be empty"),
+		},
+		"when the phone number is invalid": {
+			input:       usecase.UpdateInput{PhoneNumber: str("call me")},
+			expectedErr: errors.New("phone number must be in international format, e.g. +14155550123"),
+		},
+		"when the picture is not a URL": {
+			input:       usecase.UpdateInput{Picture: str("avatar.png")},
+			expectedErr: errors.New("picture must be an absolute http(s) URL"),
+		},
+		"when the user does not exist": {
+			input: usecase.UpdateInput{LastName: str("Doe")},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).Return(nil, nil)
+			},
+			expectedErr: errors.New("user not found"),
+		},
+		"when the update fails": {
+			input: usecase.UpdateInput{LastName: str("Doe")},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).
+					Return(&domain.User{ID: "user-123"}, nil)
+				f.repository.EXPECT().Update(gomock.Any(), "user-123", gomock.Any()).
+					Return("", errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{User: f.repository, Outbox: f.outbox, Audit: f.audit})
+				}).AnyTimes()
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User: f.repository,
+					},
+					Transactor: f.transactor,
+				}
+			}
+
+			uc := usecase.NewUpdateUsecase(contextFactory)
+			output, err := uc.Execute(context.Background(), "jdoe", tc.input)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, "Jane", output.Data.FirstName)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/storage"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/imaging"
+)
+
+const (
+	defaultMaxAvatarSize = 5 << 20
+	avatarKeyPrefix      = "avatars"
+
+	// pictureVariant is the variant stored in User.Picture. The others sit
+	// next to it and only differ by file name.
+	pictureVariant = "large"
+)
+
+var avatarVariants = []struct {
+	Name string
+	Size int
+}{
+	{Name: "large", Size: 512},
+	{Name: "medium", Size: 256},
+	{Name: "small", Size: 64},
+}
+
+type (
+	UploadAvatarUsecase interface {
+		Execute(context.Context, UploadAvatarInput) (*UploadAvatarOutput, error)
+	}
+
+	uploadAvatarUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	UploadAvatarInput struct {
+		Username string
+		Data     []byte
+	}
+
+	UploadAvatarOutput struct {
+		Data     UserOutputData    `json:"data"`
+		Variants map[string]string `json:"variants"`
+	}
+)
+
+func NewUploadAvatarUsecase(contextFactory appcontext.Factory) UploadAvatarUsecase {
+	return &uploadAvatarUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *uploadAvatarUsecase) Execute(ctx context.Context, input UploadAvatarInput) (*UploadAvatarOutput, error) {
+	app := u.contextFactory()
+
+	maxSize := app.ConfigService.Storage.MaxAvatarSize
+	if maxSize <= 0 {
+		maxSize = defaultMaxAvatarSize
+	}
+
+	if len(input.Data) == 0 {
+		return nil, errors.New("avatar file is required")
+	}
+
+	if int64(len(input.Data)) > maxSize {
+		return nil, fmt.Errorf("avatar must be at most %d bytes", maxSize)
+	}
+
+	img, format, err := imaging.Decode(input.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := app.Repositories.User.Get(ctx, user_repo.GetFilterOptions{
+		Username: input.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	store := app.Integrations.BlobStore
+	dir := path.Join(avatarKeyPrefix, user.ID, uuid.NewString())
+	extension := imaging.Extension(format)
+
+	variants := make(map[string]string, len(avatarVariants))
+	var uploaded []string
+	for _, variant := range avatarVariants {
+		data, contentType, err := imaging.Encode(imaging.Square(img, variant.Size), format)
+		if err != nil {
+			return nil, err
+		}
+
+		key := path.Join(dir, variant.Name+"."+extension)
+		url, err := store.Put(ctx, key, data, contentType)
+		if err != nil {
+			deleteAvatarObjects(ctx, store, uploaded)
+			return nil, err
+		}
+
+		uploaded = append(uploaded, key)
+		variants[variant.Name] = url
+	}
+
+	picture := variants[pictureVariant]
+	previousPicture := user.Picture
+
+	var updatedUser *domain.User
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if _, err := repos.User.Update(ctx, user.ID, user_repo.UpdateFields{
+			Picture: &picture,
+		}); err != nil {
+			return err
+		}
+
+		updatedUser, err = repos.User.Get(ctx, user_repo.GetFilterOptions{
+			ID: user.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionUserUpdated,
+			domain.UserActor(user.ID),
+			domain.UserTarget(user.ID),
+			map[string]any{
+				"fields": []string{"picture"},
+			},
+		)); err != nil {
+			return err
+		}
+
+		return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserUpdated,
+			domain.UserActor(updatedUser.Username),
+			domain.NewUserEventData(updatedUser),
+		))
+	})
+	if err != nil {
+		deleteAvatarObjects(ctx, store, uploaded)
+		return nil, err
+	}
+
+	if previousPicture != nil {
+		deleteAvatarObjects(ctx, store, previousAvatarKeys(store, user.ID, *previousPicture))
+	}
+
+	return &UploadAvatarOutput{
+		Data:     toUserOutputData(updatedUser),
+		Variants: variants,
+	}, nil
+}
+
+// previousAvatarKeys returns the keys of an avatar uploaded earlier, or
+// nothing when picture points elsewhere, e.g. to a Google profile photo.
+func previousAvatarKeys(store storage.BlobStore, userID, picture string) []string {
+	prefix := store.URL(path.Join(avatarKeyPrefix, userID)) + "/"
+	if !strings.HasPrefix(picture, prefix) {
+		return nil
+	}
+
+	version, file, ok := strings.Cut(strings.TrimPrefix(picture, prefix), "/")
+	if !ok || version == "" || strings.Contains(file, "/") {
+		return nil
+	}
+
+	keys := make([]string, 0, len(avatarVariants))
+	for _, variant := range avatarVariants {
+		keys = append(keys, path.Join(avatarKeyPrefix, userID, version, variant.Name+path.Ext(file)))
+	}
+
+	return keys
+}
+
+// deleteAvatarObjects is best effort: a leftover object is harmless and
+// must not fail a request whose outcome is already decided.
+func deleteAvatarObjects(ctx context.Context, store storage.BlobStore, keys []string) {
+	for _, key := range keys {
+		if err := store.Delete(ctx, key); err != nil {
+			log.Printf("failed to delete avatar object %s: %v", key, err)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package user_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/jpeg"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations"
+	mock_storage "github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/storage/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	"github.com/tapiaw38/auth-api-be/internal/platform/imaging"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUploadAvatarUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+		store      *mock_storage.MockBlobStore
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 600, 400)), nil))
+	photo := buf.Bytes()
+
+	const cdn = "https://cdn.example.com/"
+	oldPicture := cdn + "avatars/user-123/old-version/large.jpg"
+
+	putVariants := func(f *fields) {
+		f.store.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), "image/jpeg").DoAndReturn(
+			func(ctx context.Context, key string, body []byte, contentType string) (string, error) {
+				assert.True(t, strings.HasPrefix(key, "avatars/user-123/"))
+				img, format, err := imaging.Decode(body)
+				assert.NoError(t, err)
+				assert.Equal(t, imaging.FormatJPEG, format)
+				assert.Equal(t, img.Bounds().Dx(), img.Bounds().Dy())
+				return cdn + key, nil
+			},
+		).Times(3)
+	}
+
+	tests := map[string]struct {
+		data          []byte
+		maxAvatarSize int64
+		prepare       func(f *fields)
+		expectedErr   error
+	}{
+		"when the avatar replaces a previous upload": {
+			data: photo,
+			prepare: func(f *fields) {
+				f.store.EXPECT().URL(gomock.Any()).DoAndReturn(func(key string) string { return cdn + key }).AnyTimes()
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).
+					Return(&domain.User{ID: "user-123", Username: "jdoe", Picture: &oldPicture}, nil)
+				putVariants(f)
+				f.repository.EXPECT().Update(gomock.Any(), "user-123", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, id string, fields user_repo.UpdateFields) (string, error) {
+						assert.Regexp(t, `^https://cdn\.example\.com/avatars/user-123/[0-9a-f-]{36}/large\.jpg$`, *fields.Picture)
+						return id, nil
+					},
+				)
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{ID: "user-123"}).
+					Return(&domain.User{ID: "user-123", Username: "jdoe"}, nil)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).Return("event-1", nil)
+				f.store.EXPECT().Delete(gomock.Any(), "avatars/user-123/old-version/large.jpg").Return(nil)
+				f.store.EXPECT().Delete(gomock.Any(), "avatars/user-123/old-version/medium.jpg").Return(nil)
+				f.store.EXPECT().Delete(gomock.Any(), "avatars/user-123/old-version/small.jpg").Return(nil)
+			},
+		},
+		"when the file is not an image": {
+			data:        []byte("#!/bin/sh\necho hello\n"),
+			expectedErr: imaging.ErrUnsupportedFormat,
+		},
+		"when the file is too big": {
+			data:          make([]byte, 2048),
+			maxAvatarSize: 1024,
+			expectedErr:   errors.New("avatar must be at most 1024 bytes"),
+		},
+		"when the profile cannot be updated": {
+			data: photo,
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{Username: "jdoe"}).
+					Return(&domain.User{ID: "user-123", Username: "jdoe"}, nil)
+				putVariants(f)
+				f.repository.EXPECT().Update(gomock.Any(), "user-123", gomock.Any()).
+					Return("", errors.New("database error"))
+				f.store.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+				store:      mock_storage.NewMockBlobStore(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{User: f.repository, Outbox: f.outbox, Audit: f.audit})
+				}).AnyTimes()
+
+			if tc.prepare != nil {
+				tc.prepare(&f)
+			}
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User: f.repository,
+					},
+					Transactor:   f.transactor,
+					Integrations: &integrations.Integrations{BlobStore: f.store},
+					ConfigService: &config.ConfigurationService{
+						Storage: config.StorageConfig{MaxAvatarSize: tc.maxAvatarSize},
+					},
+				}
+			}
+
+			uc := usecase.NewUploadAvatarUsecase(contextFactory)
+			output, err := uc.Execute(context.Background(), usecase.UploadAvatarInput{
+				Username: "jdoe",
+				Data:     tc.data,
+			})
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Len(t, output.Variants, 3)
+			}
+		})
+	}
+}
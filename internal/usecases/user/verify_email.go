@@ -2,12 +2,18 @@ package user
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"time"
 
-	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	audit_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit"
+	outbox_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
 	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
 )
 
 type (
@@ -29,27 +35,58 @@ func NewVerifyEmailUsecase(contextFactory appcontext.Factory) VerifyEmailUsecase
 func (u *verifyEmailUsecase) Execute(ctx context.Context, token string) (string, error) {
 	app := u.contextFactory()
 
+	tokenHash := auth.HashOneTimeToken(token)
+
 	user, err := app.Repositories.User.Get(
 		ctx,
-		user.GetFilterOptions{
-			VerifiedEmailToken: token,
+		user_repo.GetFilterOptions{
+			VerifiedEmailToken: tokenHash,
 		},
 	)
 	if err != nil {
 		return "", err
 	}
 
+	if user == nil || !auth.OneTimeTokenMatches(token, user.VerifiedEmailToken) {
+		return "", errors.New("token expired or invalid")
+	}
+
 	if time.Now().After(user.VerifiedEmailTokenExpiry) {
 		return "", errors.New("token expired")
 	}
 
 	user.VerifiedEmail = true
 
-	if _, err = app.Repositories.User.Update(
-		ctx,
-		user.ID,
-		user,
-	); err != nil {
+	err = app.Transactor.WithTransaction(ctx, func(repos *repositories.Repositories) error {
+		if err := repos.User.ConsumeVerifiedEmailToken(ctx, user.ID, tokenHash); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.New("token expired or invalid")
+			}
+			return err
+		}
+
+		if _, err := repos.User.Update(ctx, user.ID, user_repo.UpdateFields{
+			VerifiedEmail: &user.VerifiedEmail,
+		}); err != nil {
+			return err
+		}
+
+		if err := audit_repo.Record(ctx, repos.Audit, domain.NewAuditEvent(
+			domain.AuditActionEmailVerified,
+			domain.UserActor(user.ID),
+			domain.UserTarget(user.ID),
+			nil,
+		)); err != nil {
+			return err
+		}
+
+		return outbox_repo.CreateEvent(ctx, repos.Outbox, domain.NewEvent(
+			domain.EventUserEmailVerified,
+			domain.UserActor(user.Username),
+			domain.NewUserEventData(user),
+		))
+	})
+	if err != nil {
 		return "", err
 	}
 
@@ -0,0 +1,117 @@
+package user_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_audit "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/audit/mocks"
+	mock_repositories "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/mocks"
+	mock_outbox "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/outbox/mocks"
+	user_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user"
+	mock_user "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/user/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/auth"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestVerifyEmailUsecase(t *testing.T) {
+	type fields struct {
+		repository *mock_user.MockRepository
+		transactor *mock_repositories.MockTransactor
+		outbox     *mock_outbox.MockRepository
+		audit      *mock_audit.MockRepository
+	}
+
+	tokenHash := auth.HashOneTimeToken("token")
+
+	tests := map[string]struct {
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when the token is valid only the verification flag is written": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), user_repo.GetFilterOptions{VerifiedEmailToken: tokenHash}).Return(&domain.User{
+					ID:                       "user-123",
+					FirstName:                "Jane",
+					VerifiedEmailToken:       tokenHash,
+					VerifiedEmailTokenExpiry: time.Now().Add(time.Hour),
+				}, nil)
+				f.repository.EXPECT().ConsumeVerifiedEmailToken(gomock.Any(), "user-123", tokenHash).Return(nil)
+				f.repository.EXPECT().Update(gomock.Any(), "user-123", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, id string, fields user_repo.UpdateFields) (string, error) {
+						verified := true
+						assert.Equal(t, user_repo.UpdateFields{VerifiedEmail: &verified}, fields)
+						return id, nil
+					},
+				)
+				f.audit.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				f.outbox.EXPECT().Create(gomock.Any(), gomock.Any()).Return("message-1", nil)
+			},
+		},
+		"when the token was already used": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&domain.User{
+					ID:                       "user-123",
+					VerifiedEmailToken:       tokenHash,
+					VerifiedEmailTokenExpiry: time.Now().Add(time.Hour),
+				}, nil)
+				f.repository.EXPECT().ConsumeVerifiedEmailToken(gomock.Any(), "user-123", tokenHash).Return(sql.ErrNoRows)
+			},
+			expectedErr: errors.New("token expired or invalid"),
+		},
+		"when the token has expired": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&domain.User{
+					ID:                       "user-123",
+					VerifiedEmailToken:       tokenHash,
+					VerifiedEmailTokenExpiry: time.Now().Add(-time.Minute),
+				}, nil)
+			},
+			expectedErr: errors.New("token expired"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_user.NewMockRepository(ctrl),
+				transactor: mock_repositories.NewMockTransactor(ctrl),
+				outbox:     mock_outbox.NewMockRepository(ctrl),
+				audit:      mock_audit.NewMockRepository(ctrl),
+			}
+
+			f.transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(*repositories.Repositories) error) error {
+					return fn(&repositories.Repositories{User: f.repository, Outbox: f.outbox, Audit: f.audit})
+				}).AnyTimes()
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						User: f.repository,
+					},
+					Transactor:    f.transactor,
+					ConfigService: &config.ConfigurationService{},
+				}
+			}
+
+			uc := usecase.NewVerifyEmailUsecase(contextFactory)
+			_, err := uc.Execute(context.Background(), "token")
+
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/utils"
+)
+
+type (
+	CreateUsecase interface {
+		Execute(context.Context, CreateInput) (*CreateOutput, error)
+	}
+
+	createUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	// CreateInput registers a subscription. An empty EventTypes list
+	// subscribes to every event and an empty Secret is generated.
+	CreateInput struct {
+		URL        string   `json:"url"`
+		EventTypes []string `json:"event_types"`
+		Secret     string   `json:"secret"`
+		IsActive   *bool    `json:"is_active"`
+	}
+
+	// CreateOutput is the only response that includes the signing secret.
+	CreateOutput struct {
+		Data   WebhookOutputData `json:"data"`
+		Secret string            `json:"secret"`
+	}
+)
+
+func NewCreateUsecase(contextFactory appcontext.Factory) CreateUsecase {
+	return &createUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *createUsecase) Execute(ctx context.Context, input CreateInput) (*CreateOutput, error) {
+	app := u.contextFactory()
+
+	if err := validateURL(input.URL, app.ConfigService.Webhook.AllowPrivateNetworks); err != nil {
+		return nil, err
+	}
+
+	eventTypes, err := parseEventTypes(input.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := input.Secret
+	if secret == "" {
+		secret, err = utils.GetEncodedString()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	isActive := true
+	if input.IsActive != nil {
+		isActive = *input.IsActive
+	}
+
+	webhook := domain.Webhook{
+		ID:         uuid.NewString(),
+		URL:        input.URL,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		IsActive:   isActive,
+	}
+
+	id, err := app.Repositories.Webhook.Create(ctx, webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := app.Repositories.Webhook.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateOutput{
+		Data:   toWebhookOutputData(created),
+		Secret: secret,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_webhook "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook/mocks"
+	webhook_integration "github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/webhook"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/webhook"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateUsecase_Execute(t *testing.T) {
+	type fields struct {
+		repository *mock_webhook.MockRepository
+	}
+
+	tests := map[string]struct {
+		input          usecase.CreateInput
+		prepare        func(f *fields)
+		expectedSecret string
+		expectedErr    error
+	}{
+		"when the webhook is created with a secret": {
+			input: usecase.CreateInput{
+				URL:        "https://example.com/hook",
+				EventTypes: []string{"user.registered"},
+				Secret:     "my-secret",
+			},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, webhook domain.Webhook) (string, error) {
+						assert.Equal(t, "my-secret", webhook.Secret)
+						assert.True(t, webhook.IsActive)
+						assert.Equal(t, []domain.EventType{domain.EventUserRegistered}, webhook.EventTypes)
+						return "webhook-1", nil
+					},
+				)
+				f.repository.EXPECT().Get(gomock.Any(), "webhook-1").Return(&domain.Webhook{
+					ID:         "webhook-1",
+					URL:        "https://example.com/hook",
+					EventTypes: []domain.EventType{domain.EventUserRegistered},
+					IsActive:   true,
+				}, nil)
+			},
+			expectedSecret: "my-secret",
+		},
+		"when the URL is not absolute": {
+			input:       usecase.CreateInput{URL: "/hook"},
+			prepare:     func(f *fields) {},
+			expectedErr: errors.New("webhook URL must be an absolute http(s) URL"),
+		},
+		"when the URL points at a private address": {
+			input:       usecase.CreateInput{URL: "http://169.254.169.254/latest/meta-data"},
+			prepare:     func(f *fields) {},
+			expectedErr: webhook_integration.ErrPrivateDestination,
+		},
+		"when the URL points at localhost": {
+			input:       usecase.CreateInput{URL: "http://localhost:8080/hook"},
+			prepare:     func(f *fields) {},
+			expectedErr: webhook_integration.ErrPrivateDestination,
+		},
+		"when an event type is unknown": {
+			input: usecase.CreateInput{
+				URL:        "https://example.com/hook",
+				EventTypes: []string{"user.exploded"},
+			},
+			prepare:     func(f *fields) {},
+			expectedErr: errors.New("unknown event type: user.exploded"),
+		},
+		"when the repository fails": {
+			input: usecase.CreateInput{URL: "https://example.com/hook", Secret: "my-secret"},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Create(gomock.Any(), gomock.Any()).Return("", errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_webhook.NewMockRepository(ctrl),
+			}
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						Webhook: f.repository,
+					},
+					ConfigService: &config.ConfigurationService{},
+				}
+			}
+
+			uc := usecase.NewCreateUsecase(contextFactory)
+			output, err := uc.Execute(context.Background(), tc.input)
+
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, tc.expectedSecret, output.Secret)
+				assert.Equal(t, "webhook-1", output.Data.ID)
+			}
+		})
+	}
+}
+
+func TestCreateUsecase_GeneratesSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock_webhook.NewMockRepository(ctrl)
+	repository.EXPECT().Create(gomock.Any(), gomock.Any()).Return("webhook-1", nil)
+	repository.EXPECT().Get(gomock.Any(), "webhook-1").Return(&domain.Webhook{ID: "webhook-1"}, nil)
+
+	contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+		return &appcontext.Context{
+			Repositories: &repositories.Repositories{
+				Webhook: repository,
+			},
+			ConfigService: &config.ConfigurationService{},
+		}
+	}
+
+	output, err := usecase.NewCreateUsecase(contextFactory).Execute(context.Background(), usecase.CreateInput{
+		URL: "https://example.com/hook",
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, output.Secret, 32)
+}
@@ -0,0 +1,38 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	DeleteUsecase interface {
+		Execute(context.Context, string) error
+	}
+
+	deleteUsecase struct {
+		contextFactory appcontext.Factory
+	}
+)
+
+func NewDeleteUsecase(contextFactory appcontext.Factory) DeleteUsecase {
+	return &deleteUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *deleteUsecase) Execute(ctx context.Context, id string) error {
+	app := u.contextFactory()
+
+	if err := app.Repositories.Webhook.Delete(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("webhook not found")
+		}
+		return err
+	}
+
+	return nil
+}
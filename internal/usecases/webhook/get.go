@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	GetUsecase interface {
+		Execute(context.Context, string) (*GetOutput, error)
+	}
+
+	getUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	GetOutput struct {
+		Data WebhookOutputData `json:"data"`
+	}
+)
+
+func NewGetUsecase(contextFactory appcontext.Factory) GetUsecase {
+	return &getUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *getUsecase) Execute(ctx context.Context, id string) (*GetOutput, error) {
+	app := u.contextFactory()
+
+	webhook, err := app.Repositories.Webhook.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if webhook == nil {
+		return nil, errors.New("webhook not found")
+	}
+
+	return &GetOutput{
+		Data: toWebhookOutputData(webhook),
+	}, nil
+}
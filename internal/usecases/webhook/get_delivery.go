@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+
+	webhook_delivery "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook/delivery"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	GetDeliveryUsecase interface {
+		Execute(context.Context, string, string) (*GetDeliveryOutput, error)
+	}
+
+	getDeliveryUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	GetDeliveryOutput struct {
+		Data DeliveryOutputData `json:"data"`
+	}
+)
+
+func NewGetDeliveryUsecase(contextFactory appcontext.Factory) GetDeliveryUsecase {
+	return &getDeliveryUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+// Execute returns the delivery with its payload and every recorded attempt.
+func (u *getDeliveryUsecase) Execute(ctx context.Context, webhookID, deliveryID string) (*GetDeliveryOutput, error) {
+	app := u.contextFactory()
+
+	delivery, err := app.Repositories.WebhookDelivery.Get(ctx, webhook_delivery.GetFilterOptions{
+		ID:        deliveryID,
+		WebhookID: webhookID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery == nil {
+		return nil, errors.New("delivery not found")
+	}
+
+	attempts, err := app.Repositories.WebhookDelivery.ListAttempts(ctx, delivery.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := toDeliveryOutputData(delivery)
+	data.Payload = delivery.Payload
+	for _, attempt := range attempts {
+		data.AttemptLog = append(data.AttemptLog, toAttemptOutputData(attempt))
+	}
+
+	return &GetDeliveryOutput{
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	webhook_delivery "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook/delivery"
+	mock_webhook_delivery "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook/delivery/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/utils"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/webhook"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetDeliveryUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock_webhook_delivery.NewMockRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), webhook_delivery.GetFilterOptions{ID: "delivery-1", WebhookID: "webhook-1"}).
+		Return(&domain.WebhookDelivery{
+			ID:        "delivery-1",
+			WebhookID: "webhook-1",
+			EventType: domain.EventUserDeleted,
+			Payload:   []byte(`{"id":"event-1"}`),
+			Status:    domain.WebhookDeliveryPending,
+			Attempts:  1,
+		}, nil)
+	repository.EXPECT().ListAttempts(gomock.Any(), "delivery-1").Return([]domain.WebhookDeliveryAttempt{
+		{
+			Attempt:        1,
+			ResponseStatus: utils.ToPointer(500),
+			Error:          utils.ToPointer("receiver responded with status 500"),
+			Duration:       120 * time.Millisecond,
+		},
+	}, nil)
+
+	contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+		return &appcontext.Context{
+			Repositories: &repositories.Repositories{
+				WebhookDelivery: repository,
+			},
+		}
+	}
+
+	output, err := usecase.NewGetDeliveryUsecase(contextFactory).Execute(context.Background(), "webhook-1", "delivery-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "user.deleted", output.Data.EventType)
+	assert.JSONEq(t, `{"id":"event-1"}`, string(output.Data.Payload))
+	assert.Len(t, output.Data.AttemptLog, 1)
+	assert.Equal(t, int64(120), output.Data.AttemptLog[0].DurationMs)
+	assert.Equal(t, 500, *output.Data.AttemptLog[0].ResponseStatus)
+}
@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"context"
+
+	webhook_repo "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	ListUsecase interface {
+		Execute(context.Context) (*ListOutput, error)
+	}
+
+	listUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	ListOutput struct {
+		Data []WebhookOutputData `json:"data"`
+	}
+)
+
+func NewListUsecase(contextFactory appcontext.Factory) ListUsecase {
+	return &listUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *listUsecase) Execute(ctx context.Context) (*ListOutput, error) {
+	app := u.contextFactory()
+
+	webhooks, err := app.Repositories.Webhook.List(ctx, webhook_repo.ListFilterOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]WebhookOutputData, 0, len(webhooks))
+	for i := range webhooks {
+		data = append(data, toWebhookOutputData(&webhooks[i]))
+	}
+
+	return &ListOutput{
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+
+	webhook_delivery "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook/delivery"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	ListDeliveriesUsecase interface {
+		Execute(context.Context, string, ListDeliveriesFilterOptions) (*ListDeliveriesOutput, error)
+	}
+
+	listDeliveriesUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	ListDeliveriesFilterOptions struct {
+		Status string
+		Limit  int
+	}
+
+	ListDeliveriesOutput struct {
+		Data []DeliveryOutputData `json:"data"`
+	}
+)
+
+func NewListDeliveriesUsecase(contextFactory appcontext.Factory) ListDeliveriesUsecase {
+	return &listDeliveriesUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *listDeliveriesUsecase) Execute(ctx context.Context, webhookID string, filters ListDeliveriesFilterOptions) (*ListDeliveriesOutput, error) {
+	app := u.contextFactory()
+
+	webhook, err := app.Repositories.Webhook.Get(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if webhook == nil {
+		return nil, errors.New("webhook not found")
+	}
+
+	deliveries, err := app.Repositories.WebhookDelivery.List(ctx, webhook_delivery.ListFilterOptions{
+		WebhookID: webhookID,
+		Status:    domain.WebhookDeliveryStatus(filters.Status),
+		Limit:     filters.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]DeliveryOutputData, 0, len(deliveries))
+	for i := range deliveries {
+		data = append(data, toDeliveryOutputData(&deliveries[i]))
+	}
+
+	return &ListDeliveriesOutput{
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+type (
+	WebhookOutputData struct {
+		ID         string    `json:"id"`
+		URL        string    `json:"url"`
+		EventTypes []string  `json:"event_types"`
+		IsActive   bool      `json:"is_active"`
+		CreatedAt  time.Time `json:"created_at"`
+		UpdatedAt  time.Time `json:"updated_at"`
+	}
+
+	DeliveryOutputData struct {
+		ID            string              `json:"id"`
+		WebhookID     string              `json:"webhook_id"`
+		EventID       string              `json:"event_id"`
+		EventType     string              `json:"event_type"`
+		Status        string              `json:"status"`
+		Attempts      int                 `json:"attempts"`
+		NextAttemptAt time.Time           `json:"next_attempt_at"`
+		CreatedAt     time.Time           `json:"created_at"`
+		UpdatedAt     time.Time           `json:"updated_at"`
+		Payload       json.RawMessage     `json:"payload,omitempty"`
+		AttemptLog    []AttemptOutputData `json:"attempt_log,omitempty"`
+	}
+
+	AttemptOutputData struct {
+		Attempt        int       `json:"attempt"`
+		ResponseStatus *int      `json:"response_status"`
+		ResponseBody   *string   `json:"response_body"`
+		Error          *string   `json:"error"`
+		DurationMs     int64     `json:"duration_ms"`
+		CreatedAt      time.Time `json:"created_at"`
+	}
+)
+
+func toWebhookOutputData(webhook *domain.Webhook) WebhookOutputData {
+	eventTypes := make([]string, 0, len(webhook.EventTypes))
+	for _, eventType := range webhook.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	return WebhookOutputData{
+		ID:         webhook.ID,
+		URL:        webhook.URL,
+		EventTypes: eventTypes,
+		IsActive:   webhook.IsActive,
+		CreatedAt:  webhook.CreatedAt,
+		UpdatedAt:  webhook.UpdatedAt,
+	}
+}
+
+func toDeliveryOutputData(delivery *domain.WebhookDelivery) DeliveryOutputData {
+	return DeliveryOutputData{
+		ID:            delivery.ID,
+		WebhookID:     delivery.WebhookID,
+		EventID:       delivery.EventID,
+		EventType:     string(delivery.EventType),
+		Status:        string(delivery.Status),
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		CreatedAt:     delivery.CreatedAt,
+		UpdatedAt:     delivery.UpdatedAt,
+	}
+}
+
+func toAttemptOutputData(attempt domain.WebhookDeliveryAttempt) AttemptOutputData {
+	return AttemptOutputData{
+		Attempt:        attempt.Attempt,
+		ResponseStatus: attempt.ResponseStatus,
+		ResponseBody:   attempt.ResponseBody,
+		Error:          attempt.Error,
+		DurationMs:     attempt.Duration.Milliseconds(),
+		CreatedAt:      attempt.CreatedAt,
+	}
+}
@@ -0,0 +1,44 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+
+	webhook_delivery "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook/delivery"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	RedeliverUsecase interface {
+		Execute(context.Context, string, string) error
+	}
+
+	redeliverUsecase struct {
+		contextFactory appcontext.Factory
+	}
+)
+
+func NewRedeliverUsecase(contextFactory appcontext.Factory) RedeliverUsecase {
+	return &redeliverUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+// Execute queues the delivery for an immediate attempt by the dispatcher.
+func (u *redeliverUsecase) Execute(ctx context.Context, webhookID, deliveryID string) error {
+	app := u.contextFactory()
+
+	delivery, err := app.Repositories.WebhookDelivery.Get(ctx, webhook_delivery.GetFilterOptions{
+		ID:        deliveryID,
+		WebhookID: webhookID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if delivery == nil {
+		return errors.New("delivery not found")
+	}
+
+	return app.Repositories.WebhookDelivery.Redeliver(ctx, delivery.ID)
+}
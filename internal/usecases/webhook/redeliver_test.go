@@ -0,0 +1,77 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	webhook_delivery "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook/delivery"
+	mock_webhook_delivery "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook/delivery/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/webhook"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRedeliverUsecase_Execute(t *testing.T) {
+	type fields struct {
+		repository *mock_webhook_delivery.MockRepository
+	}
+
+	filters := webhook_delivery.GetFilterOptions{ID: "delivery-1", WebhookID: "webhook-1"}
+
+	tests := map[string]struct {
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when the delivery is queued again": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), filters).Return(&domain.WebhookDelivery{
+					ID:     "delivery-1",
+					Status: domain.WebhookDeliveryFailed,
+				}, nil)
+				f.repository.EXPECT().Redeliver(gomock.Any(), "delivery-1").Return(nil)
+			},
+		},
+		"when the delivery belongs to another webhook": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), filters).Return(nil, nil)
+			},
+			expectedErr: errors.New("delivery not found"),
+		},
+		"when the repository fails": {
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), filters).Return(nil, errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_webhook_delivery.NewMockRepository(ctrl),
+			}
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						WebhookDelivery: f.repository,
+					},
+				}
+			}
+
+			uc := usecase.NewRedeliverUsecase(contextFactory)
+			err := uc.Execute(context.Background(), "webhook-1", "delivery-1")
+
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+)
+
+type (
+	UpdateUsecase interface {
+		Execute(context.Context, string, UpdateInput) (*UpdateOutput, error)
+	}
+
+	updateUsecase struct {
+		contextFactory appcontext.Factory
+	}
+
+	// UpdateInput only changes the fields that are set.
+	UpdateInput struct {
+		URL        *string   `json:"url"`
+		EventTypes *[]string `json:"event_types"`
+		Secret     *string   `json:"secret"`
+		IsActive   *bool     `json:"is_active"`
+	}
+
+	UpdateOutput struct {
+		Data WebhookOutputData `json:"data"`
+	}
+)
+
+func NewUpdateUsecase(contextFactory appcontext.Factory) UpdateUsecase {
+	return &updateUsecase{
+		contextFactory: contextFactory,
+	}
+}
+
+func (u *updateUsecase) Execute(ctx context.Context, id string, input UpdateInput) (*UpdateOutput, error) {
+	app := u.contextFactory()
+
+	webhook, err := app.Repositories.Webhook.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if webhook == nil {
+		return nil, errors.New("webhook not found")
+	}
+
+	if input.URL != nil {
+		if err := validateURL(*input.URL, app.ConfigService.Webhook.AllowPrivateNetworks); err != nil {
+			return nil, err
+		}
+		webhook.URL = *input.URL
+	}
+
+	if input.EventTypes != nil {
+		eventTypes, err := parseEventTypes(*input.EventTypes)
+		if err != nil {
+			return nil, err
+		}
+		webhook.EventTypes = eventTypes
+	}
+
+	if input.Secret != nil {
+		if *input.Secret == "" {
+			return nil, errors.New("webhook secret cannot be empty")
+		}
+		webhook.Secret = *input.Secret
+	}
+
+	if input.IsActive != nil {
+		webhook.IsActive = *input.IsActive
+	}
+
+	if _, err := app.Repositories.Webhook.Update(ctx, id, webhook); err != nil {
+		return nil, err
+	}
+
+	updated, err := app.Repositories.Webhook.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateOutput{
+		Data: toWebhookOutputData(updated),
+	}, nil
+}
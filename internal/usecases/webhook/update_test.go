@@ -0,0 +1,97 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories"
+	mock_webhook "github.com/tapiaw38/auth-api-be/internal/adapters/datasources/repositories/webhook/mocks"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+	"github.com/tapiaw38/auth-api-be/internal/platform/appcontext"
+	"github.com/tapiaw38/auth-api-be/internal/platform/config"
+	"github.com/tapiaw38/auth-api-be/internal/platform/utils"
+	usecase "github.com/tapiaw38/auth-api-be/internal/usecases/webhook"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdateUsecase_Execute(t *testing.T) {
+	type fields struct {
+		repository *mock_webhook.MockRepository
+	}
+
+	existing := func() *domain.Webhook {
+		return &domain.Webhook{
+			ID:         "webhook-1",
+			URL:        "https://example.com/hook",
+			Secret:     "secret",
+			EventTypes: []domain.EventType{domain.EventUserRegistered},
+			IsActive:   true,
+		}
+	}
+
+	tests := map[string]struct {
+		input       usecase.UpdateInput
+		prepare     func(f *fields)
+		expectedErr error
+	}{
+		"when only the active flag changes": {
+			input: usecase.UpdateInput{IsActive: utils.ToPointer(false)},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), "webhook-1").Return(existing(), nil)
+				f.repository.EXPECT().Update(gomock.Any(), "webhook-1", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, id string, webhook *domain.Webhook) (string, error) {
+						assert.False(t, webhook.IsActive)
+						assert.Equal(t, "https://example.com/hook", webhook.URL)
+						assert.Equal(t, "secret", webhook.Secret)
+						assert.Equal(t, []domain.EventType{domain.EventUserRegistered}, webhook.EventTypes)
+						return id, nil
+					},
+				)
+				f.repository.EXPECT().Get(gomock.Any(), "webhook-1").Return(existing(), nil)
+			},
+		},
+		"when the webhook does not exist": {
+			input: usecase.UpdateInput{IsActive: utils.ToPointer(false)},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), "webhook-1").Return(nil, nil)
+			},
+			expectedErr: errors.New("webhook not found"),
+		},
+		"when the secret is cleared": {
+			input: usecase.UpdateInput{Secret: utils.ToPointer("")},
+			prepare: func(f *fields) {
+				f.repository.EXPECT().Get(gomock.Any(), "webhook-1").Return(existing(), nil)
+			},
+			expectedErr: errors.New("webhook secret cannot be empty"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repository: mock_webhook.NewMockRepository(ctrl),
+			}
+
+			tc.prepare(&f)
+
+			contextFactory := func(opts ...appcontext.Option) *appcontext.Context {
+				return &appcontext.Context{
+					Repositories: &repositories.Repositories{
+						Webhook: f.repository,
+					},
+					ConfigService: &config.ConfigurationService{},
+				}
+			}
+
+			uc := usecase.NewUpdateUsecase(contextFactory)
+			_, err := uc.Execute(context.Background(), "webhook-1", tc.input)
+
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	webhook_integration "github.com/tapiaw38/auth-api-be/internal/adapters/web/integrations/webhook"
+	"github.com/tapiaw38/auth-api-be/internal/domain"
+)
+
+func validateURL(rawURL string, allowPrivateNetworks bool) error {
+	if rawURL == "" {
+		return errors.New("webhook URL is required")
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return errors.New("webhook URL must be an absolute http(s) URL")
+	}
+
+	if allowPrivateNetworks {
+		return nil
+	}
+
+	return webhook_integration.CheckHost(parsed.Hostname())
+}
+
+func parseEventTypes(values []string) ([]domain.EventType, error) {
+	eventTypes := make([]domain.EventType, 0, len(values))
+	for _, value := range values {
+		eventType := domain.EventType(value)
+		if !eventType.IsValid() {
+			return nil, fmt.Errorf("unknown event type: %s", value)
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	return eventTypes, nil
+}
@@ -0,0 +1 @@
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS kind VARCHAR(20) NOT NULL DEFAULT 'job';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS phone_verifications;
+
+ALTER TABLE users DROP COLUMN IF EXISTS verified_phone;
@@ -0,0 +1,10 @@
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE audit_events DROP COLUMN IF EXISTS redacted_at;
+
+DROP TABLE IF EXISTS account_deletions;
+DROP TABLE IF EXISTS data_exports;
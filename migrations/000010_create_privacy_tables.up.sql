@@ -0,0 +1,53 @@
+CREATE TABLE IF NOT EXISTS data_exports (
+    id VARCHAR(255) PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    payload BYTEA NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS data_exports_user_idx ON data_exports (user_id);
+CREATE INDEX IF NOT EXISTS data_exports_expires_idx ON data_exports (expires_at);
+
+CREATE TABLE IF NOT EXISTS account_deletions (
+    user_id VARCHAR(255) PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    requested_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    scheduled_for TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS account_deletions_scheduled_idx ON account_deletions (scheduled_for);
+
+-- Erasure replaces the personal data of an audit row exactly once: the
+-- actor and target IDs may only become an "erased:" pseudonym, and the IP,
+-- user agent and metadata may only be emptied. Every other column, the
+-- hash and chain included, never changes, so the chain stays verifiable
+-- and a redaction can remove content but never forge it; redacted rows
+-- are simply no longer checked against their contents.
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS redacted_at TIMESTAMP;
+
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND OLD.redacted_at IS NULL
+        AND NEW.redacted_at IS NOT NULL
+        AND NEW.id = OLD.id
+        AND NEW.action = OLD.action
+        AND NEW.actor_type = OLD.actor_type
+        AND (NEW.actor_id = OLD.actor_id OR NEW.actor_id LIKE 'erased:%')
+        AND NEW.target_type = OLD.target_type
+        AND (NEW.target_id = OLD.target_id OR NEW.target_id LIKE 'erased:%')
+        AND NEW.ip = ''
+        AND NEW.user_agent = ''
+        AND NEW.metadata = '{}'::jsonb
+        AND NEW.request_id = OLD.request_id
+        AND NEW.prev_hash = OLD.prev_hash
+        AND NEW.hash = OLD.hash
+        AND NEW.created_at = OLD.created_at
+    THEN
+        RETURN NEW;
+    END IF;
+
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS roles_scim_tenant_idx;
+ALTER TABLE roles DROP COLUMN IF EXISTS scim_tenant;
+DROP INDEX IF EXISTS users_scim_tenant_idx;
+ALTER TABLE users DROP COLUMN IF EXISTS scim_tenant;
+DROP TABLE IF EXISTS scim_tokens;
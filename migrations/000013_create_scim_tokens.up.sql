@@ -0,0 +1,25 @@
+-- Each token belongs to one identity provider connection of a tenant. A
+-- tenant may hold several tokens so they can be rotated. Only the SHA-256
+-- of the token is stored.
+CREATE TABLE IF NOT EXISTS scim_tokens (
+    id VARCHAR(255) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    tenant VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP
+);
+
+-- Users created through SCIM belong to the tenant whose token created
+-- them. A token only sees and changes the users of its own tenant.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS scim_tenant VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS users_scim_tenant_idx ON users (scim_tenant) WHERE scim_tenant IS NOT NULL;
+
+-- Roles created through SCIM belong to the tenant whose token created
+-- them in the same way. Other roles are not visible through SCIM.
+ALTER TABLE roles ADD COLUMN IF NOT EXISTS scim_tenant VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS roles_scim_tenant_idx ON roles (scim_tenant) WHERE scim_tenant IS NOT NULL;
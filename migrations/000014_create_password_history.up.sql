@@ -0,0 +1,11 @@
+-- Previous password hashes, kept so users can't cycle back to a recent
+-- password. Rows older than the configured history size are pruned.
+CREATE TABLE IF NOT EXISTS password_history (
+    id VARCHAR(255) PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    password_hash TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_password_history_user_id_created_at
+    ON password_history (user_id, created_at DESC);
@@ -0,0 +1,10 @@
+-- This rollback is lossy. Hashes can't be reversed, so outstanding
+-- verification, reset and email change links stop working; users have to
+-- request new ones.
+UPDATE users
+SET verified_email_token = NULL, verified_email_token_expiry = NULL,
+    password_reset_token = NULL, password_reset_token_expiry = NULL;
+
+DELETE FROM email_changes WHERE confirmed_at IS NULL;
+
+ALTER TABLE email_changes RENAME COLUMN token_hash TO token;
@@ -0,0 +1,14 @@
+-- Emailed tokens are now stored as the hex SHA-256 of the token. Tokens
+-- already sent are hashed in place so their links keep working.
+UPDATE users
+SET verified_email_token = encode(sha256(verified_email_token::bytea), 'hex')
+WHERE verified_email_token IS NOT NULL;
+
+UPDATE users
+SET password_reset_token = encode(sha256(password_reset_token::bytea), 'hex')
+WHERE password_reset_token IS NOT NULL;
+
+ALTER TABLE email_changes RENAME COLUMN token TO token_hash;
+
+UPDATE email_changes
+SET token_hash = encode(sha256(token_hash::bytea), 'hex');
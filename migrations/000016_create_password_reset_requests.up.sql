@@ -0,0 +1,11 @@
+-- Every forgot-password request, whether or not the email belongs to an
+-- account, so requests can be throttled per email and per IP.
+CREATE TABLE IF NOT EXISTS password_reset_requests (
+    id VARCHAR(255) PRIMARY KEY,
+    email VARCHAR(255) NOT NULL,
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS password_reset_requests_email_idx ON password_reset_requests (email, created_at);
+CREATE INDEX IF NOT EXISTS password_reset_requests_ip_idx ON password_reset_requests (ip, created_at);
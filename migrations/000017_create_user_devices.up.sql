@@ -0,0 +1,24 @@
+-- Devices each user has logged in from, keyed by a fingerprint of the user
+-- agent and the approximate location. A login from an unknown fingerprint
+-- is a new device; revoke_token_hash backs the "this wasn't me" link sent
+-- for it.
+CREATE TABLE IF NOT EXISTS user_devices (
+    id VARCHAR(255) PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    fingerprint VARCHAR(64) NOT NULL,
+    user_agent TEXT NOT NULL DEFAULT '',
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    country VARCHAR(255) NOT NULL DEFAULT '',
+    region VARCHAR(255) NOT NULL DEFAULT '',
+    city VARCHAR(255) NOT NULL DEFAULT '',
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    revoke_token_hash VARCHAR(64) UNIQUE,
+    revoke_token_expires_at TIMESTAMP,
+    first_seen_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, fingerprint)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_devices_user_id_last_seen_at
+    ON user_devices (user_id, last_seen_at DESC);
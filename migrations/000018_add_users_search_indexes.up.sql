@@ -0,0 +1,16 @@
+-- Indexes behind the admin user search. search_vector serves whole and
+-- prefix word matches and their ranking; the trigram indexes serve
+-- misspelt names and emails and fragments of phone numbers, compared on
+-- their digits only.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(first_name, '') || ' ' || coalesce(last_name, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(username, '') || ' ' || coalesce(email, '')), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS users_search_vector_idx ON users USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS users_name_trgm_idx ON users USING GIN ((first_name || ' ' || coalesce(last_name, '')) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS users_email_trgm_idx ON users USING GIN (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS users_phone_digits_trgm_idx ON users USING GIN ((regexp_replace(phone_number, '[^0-9]', '', 'g')) gin_trgm_ops);